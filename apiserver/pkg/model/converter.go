@@ -135,9 +135,35 @@ func FromCrdToApiCluster(cluster *rayv1api.RayCluster, events []corev1.Event) *a
 	for name, port := range cluster.Status.Endpoints {
 		pbCluster.ServiceEndpoint[name] = port
 	}
+	pbCluster.WorkerGroupStatuses = PopulateWorkerGroupStatuses(cluster.Status.WorkerGroupStatuses)
 	return pbCluster
 }
 
+func PopulateWorkerGroupStatuses(statuses []rayv1api.WorkerGroupStatus) []*api.WorkerGroupStatus {
+	var pbStatuses []*api.WorkerGroupStatus
+	for _, status := range statuses {
+		pbStatus := &api.WorkerGroupStatus{
+			GroupName:         status.GroupName,
+			DesiredReplicas:   status.DesiredReplicas,
+			ReadyReplicas:     status.ReadyReplicas,
+			AvailableReplicas: status.AvailableReplicas,
+			PendingReplicas:   status.PendingReplicas,
+			FailedReplicas:    status.FailedReplicas,
+			UpdatedReplicas:   status.UpdatedReplicas,
+			OutdatedReplicas:  status.OutdatedReplicas,
+			DesiredCpu:        status.DesiredCPU.String(),
+			DesiredMemory:     status.DesiredMemory.String(),
+			DesiredGpu:        status.DesiredGPU.String(),
+			DesiredTpu:        status.DesiredTPU.String(),
+		}
+		if status.LastScaleTime != nil {
+			pbStatus.LastScaleTime = &timestamppb.Timestamp{Seconds: status.LastScaleTime.Unix()}
+		}
+		pbStatuses = append(pbStatuses, pbStatus)
+	}
+	return pbStatuses
+}
+
 func PopulateRayClusterSpec(spec rayv1api.RayClusterSpec) *api.ClusterSpec {
 	clusterSpec := &api.ClusterSpec{}
 	clusterSpec.HeadGroupSpec = PopulateHeadNodeSpec(spec.HeadGroupSpec)
@@ -518,10 +544,33 @@ func FromCrdToApiJob(job *rayv1api.RayJob) (pbJob *api.RayJob) {
 	if jclustername := job.Status.RayClusterName; jclustername != "" {
 		pbJob.RayClusterName = jclustername
 	}
+	pbJob.Attempts = PopulateRayJobAttempts(job.Status.Attempts)
 
 	return pbJob
 }
 
+func PopulateRayJobAttempts(attempts []rayv1api.RayJobAttempt) []*api.RayJobAttempt {
+	var pbAttempts []*api.RayJobAttempt
+	for _, attempt := range attempts {
+		pbAttempt := &api.RayJobAttempt{
+			JobId:          attempt.JobId,
+			RayClusterName: attempt.RayClusterName,
+			SubmissionMode: string(attempt.SubmissionMode),
+			JobStatus:      string(attempt.JobStatus),
+			Reason:         string(attempt.Reason),
+			Message:        attempt.Message,
+		}
+		if attempt.StartTime != nil {
+			pbAttempt.StartTime = timestamppb.New(attempt.StartTime.Time)
+		}
+		if attempt.EndTime != nil {
+			pbAttempt.EndTime = timestamppb.New(attempt.EndTime.Time)
+		}
+		pbAttempts = append(pbAttempts, pbAttempt)
+	}
+	return pbAttempts
+}
+
 func FromCrdToApiServices(
 	services []*rayv1api.RayService,
 	serviceEventsMap map[string][]corev1.Event,
@@ -599,6 +648,10 @@ func PopulateServeApplicationStatus(
 			Status:                appStatus.Status,
 			Message:               appStatus.Message,
 			ServeDeploymentStatus: PopulateServeDeploymentStatus(appStatus.Deployments),
+			RoutePrefix:           appStatus.RoutePrefix,
+		}
+		if appStatus.LastDeployedTime != nil {
+			ds.LastDeployedTime = timestamppb.New(appStatus.LastDeployedTime.Time)
 		}
 		appStatuses = append(appStatuses, ds)
 	}
@@ -611,9 +664,18 @@ func PopulateServeDeploymentStatus(
 	deploymentStatuses := make([]*api.ServeDeploymentStatus, 0)
 	for deploymentName, deploymentStatus := range serveDeploymentStatuses {
 		ds := &api.ServeDeploymentStatus{
-			DeploymentName: deploymentName,
-			Status:         deploymentStatus.Status,
-			Message:        deploymentStatus.Message,
+			DeploymentName:  deploymentName,
+			Status:          deploymentStatus.Status,
+			Message:         deploymentStatus.Message,
+			TargetReplicas:  deploymentStatus.TargetReplicas,
+			RunningReplicas: deploymentStatus.RunningReplicas,
+			ReplicaStates:   deploymentStatus.ReplicaStates,
+		}
+		if deploymentStatus.Autoscaling != nil {
+			ds.Autoscaling = &api.ServeDeploymentAutoscalingStatus{
+				MinReplicas: deploymentStatus.Autoscaling.MinReplicas,
+				MaxReplicas: deploymentStatus.Autoscaling.MaxReplicas,
+			}
 		}
 		deploymentStatuses = append(deploymentStatuses, ds)
 	}
@@ -386,6 +386,18 @@ var JobWithOutputTest = rayv1api.RayJob{
 		RayClusterName:      "raycluster-sample-xxxxx",
 		StartTime:           &metav1.Time{Time: time.Date(2024, 0o7, 25, 0, 0, 0, 0, time.UTC)},
 		EndTime:             nil,
+		Attempts: []rayv1api.RayJobAttempt{
+			{
+				JobId:          "test-job-id",
+				RayClusterName: "raycluster-sample-xxxxx",
+				SubmissionMode: rayv1api.K8sJobMode,
+				JobStatus:      rayv1api.JobStatusFailed,
+				Reason:         rayv1api.AppFailed,
+				Message:        "Job failed",
+				StartTime:      &metav1.Time{Time: time.Date(2024, 0o7, 24, 0, 0, 0, 0, time.UTC)},
+				EndTime:        &metav1.Time{Time: time.Date(2024, 0o7, 24, 1, 0, 0, 0, time.UTC)},
+			},
+		},
 	},
 }
 
@@ -610,6 +622,70 @@ func TestPopulateRayClusterSpec(t *testing.T) {
 	}
 }
 
+func TestPopulateWorkerGroupStatuses(t *testing.T) {
+	lastScaleTime := metav1.NewTime(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC))
+	statuses := PopulateWorkerGroupStatuses([]rayv1api.WorkerGroupStatus{
+		{
+			GroupName:         "small-wg",
+			DesiredReplicas:   3,
+			ReadyReplicas:     2,
+			AvailableReplicas: 2,
+			PendingReplicas:   1,
+			UpdatedReplicas:   3,
+			DesiredCPU:        resource.MustParse("3"),
+			DesiredMemory:     resource.MustParse("6Gi"),
+			LastScaleTime:     &lastScaleTime,
+		},
+	})
+	assert.Equal(t, 1, len(statuses))
+	assert.Equal(t, "small-wg", statuses[0].GroupName)
+	assert.Equal(t, int32(3), statuses[0].DesiredReplicas)
+	assert.Equal(t, int32(2), statuses[0].ReadyReplicas)
+	assert.Equal(t, int32(2), statuses[0].AvailableReplicas)
+	assert.Equal(t, int32(1), statuses[0].PendingReplicas)
+	assert.Equal(t, int32(0), statuses[0].FailedReplicas)
+	assert.Equal(t, int32(3), statuses[0].UpdatedReplicas)
+	assert.Equal(t, "3", statuses[0].DesiredCpu)
+	assert.Equal(t, "6Gi", statuses[0].DesiredMemory)
+	assert.Equal(t, "0", statuses[0].DesiredGpu)
+	assert.Equal(t, lastScaleTime.Unix(), statuses[0].LastScaleTime.Seconds)
+
+	assert.Nil(t, PopulateWorkerGroupStatuses(nil))
+}
+
+func TestPopulateServeApplicationStatus(t *testing.T) {
+	lastDeployedTime := metav1.NewTime(time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC))
+	statuses := PopulateServeApplicationStatus(map[string]rayv1api.AppStatus{
+		"app": {
+			Status:           rayv1api.ApplicationStatusEnum.RUNNING,
+			RoutePrefix:      "/app",
+			LastDeployedTime: &lastDeployedTime,
+			Deployments: map[string]rayv1api.ServeDeploymentStatus{
+				"model": {
+					Status:          rayv1api.DeploymentStatusEnum.HEALTHY,
+					TargetReplicas:  ptr.To[int32](3),
+					RunningReplicas: ptr.To[int32](3),
+					ReplicaStates:   map[string]int32{"RUNNING": 3},
+					Autoscaling:     &rayv1api.ServeDeploymentAutoscalingStatus{MinReplicas: 1, MaxReplicas: 3},
+				},
+			},
+		},
+	})
+	assert.Equal(t, 1, len(statuses))
+	assert.Equal(t, "app", statuses[0].Name)
+	assert.Equal(t, "/app", statuses[0].RoutePrefix)
+	assert.Equal(t, lastDeployedTime.Unix(), statuses[0].LastDeployedTime.Seconds)
+	assert.Equal(t, 1, len(statuses[0].ServeDeploymentStatus))
+
+	deployment := statuses[0].ServeDeploymentStatus[0]
+	assert.Equal(t, "model", deployment.DeploymentName)
+	assert.Equal(t, int32(3), deployment.GetTargetReplicas())
+	assert.Equal(t, int32(3), deployment.GetRunningReplicas())
+	assert.Equal(t, map[string]int32{"RUNNING": 3}, deployment.ReplicaStates)
+	assert.Equal(t, int32(1), deployment.Autoscaling.MinReplicas)
+	assert.Equal(t, int32(3), deployment.Autoscaling.MaxReplicas)
+}
+
 func TestPopulateTemplate(t *testing.T) {
 	template := FromKubeToAPIComputeTemplate(&configMapWithoutTolerations)
 	if len(template.Tolerations) != 0 {
@@ -684,4 +760,13 @@ func TestPopulateJob(t *testing.T) {
 	assert.Equal(t, "Initializing", job.JobDeploymentStatus)
 	assert.Equal(t, "Job is currently running", job.Message)
 	assert.Equal(t, "raycluster-sample-xxxxx", job.RayClusterName)
+	assert.Len(t, job.Attempts, 1)
+	assert.Equal(t, "test-job-id", job.Attempts[0].JobId)
+	assert.Equal(t, "raycluster-sample-xxxxx", job.Attempts[0].RayClusterName)
+	assert.Equal(t, "K8sJobMode", job.Attempts[0].SubmissionMode)
+	assert.Equal(t, "FAILED", job.Attempts[0].JobStatus)
+	assert.Equal(t, "AppFailed", job.Attempts[0].Reason)
+	assert.Equal(t, "Job failed", job.Attempts[0].Message)
+	assert.Equal(t, time.Date(2024, 0o7, 24, 0, 0, 0, 0, time.UTC), job.Attempts[0].StartTime.AsTime())
+	assert.Equal(t, time.Date(2024, 0o7, 24, 1, 0, 0, 0, time.UTC), job.Attempts[0].EndTime.AsTime())
 }
@@ -18,10 +18,11 @@ import (
 )
 
 type GetClusterOptions struct {
-	configFlags   *genericclioptions.ConfigFlags
-	ioStreams     *genericclioptions.IOStreams
-	args          []string
-	AllNamespaces bool
+	configFlags      *genericclioptions.ConfigFlags
+	ioStreams        *genericclioptions.IOStreams
+	args             []string
+	AllNamespaces    bool
+	ShowWorkerGroups bool
 }
 
 func NewGetClusterOptions(streams genericclioptions.IOStreams) *GetClusterOptions {
@@ -53,6 +54,7 @@ func NewGetClusterCommand(streams genericclioptions.IOStreams) *cobra.Command {
 		},
 	}
 	cmd.Flags().BoolVarP(&options.AllNamespaces, "all-namespaces", "A", options.AllNamespaces, "If present, list the requested clusters across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().BoolVar(&options.ShowWorkerGroups, "worker-groups", options.ShowWorkerGroups, "If present, also print the status of each worker group of the requested clusters.")
 	options.configFlags.AddFlags(cmd.Flags())
 	return cmd
 }
@@ -115,7 +117,14 @@ func (options *GetClusterOptions) Run(ctx context.Context, factory cmdutil.Facto
 		}
 	}
 
-	return printClusters(rayclustersList, options.ioStreams.Out)
+	if err := printClusters(rayclustersList, options.ioStreams.Out); err != nil {
+		return err
+	}
+	if !options.ShowWorkerGroups {
+		return nil
+	}
+	fmt.Fprintln(options.ioStreams.Out)
+	return printWorkerGroups(rayclustersList, options.ioStreams.Out)
 }
 
 func printClusters(rayclustersList *unstructured.UnstructuredList, output io.Writer) error {
@@ -157,3 +166,71 @@ func printClusters(rayclustersList *unstructured.UnstructuredList, output io.Wri
 
 	return resultTablePrinter.PrintObj(resTable, output)
 }
+
+func printWorkerGroups(rayclustersList *unstructured.UnstructuredList, output io.Writer) error {
+	resultTablePrinter := printers.NewTablePrinter(printers.PrintOptions{})
+
+	resTable := &v1.Table{
+		ColumnDefinitions: []v1.TableColumnDefinition{
+			{Name: "Cluster", Type: "string"},
+			{Name: "Namespace", Type: "string"},
+			{Name: "Worker Group", Type: "string"},
+			{Name: "Desired", Type: "string"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Available", Type: "string"},
+			{Name: "Pending", Type: "string"},
+			{Name: "Failed", Type: "string"},
+			{Name: "CPUs", Type: "string"},
+			{Name: "GPUs", Type: "string"},
+			{Name: "TPUs", Type: "string"},
+			{Name: "Memory", Type: "string"},
+			{Name: "Last Scale", Type: "string"},
+		},
+	}
+
+	for _, raycluster := range rayclustersList.Items {
+		workerGroupStatuses, _, err := unstructured.NestedSlice(raycluster.Object, "status", "workerGroupStatuses")
+		if err != nil {
+			return fmt.Errorf("unable to parse worker group statuses of raycluster %s: %w", raycluster.GetName(), err)
+		}
+		for _, item := range workerGroupStatuses {
+			workerGroupStatus, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			lastScale := "<unknown>"
+			if lastScaleTime, ok := workerGroupStatus["lastScaleTime"].(string); ok {
+				if t, err := time.Parse(time.RFC3339, lastScaleTime); err == nil {
+					lastScale = duration.HumanDuration(time.Since(t))
+				}
+			}
+			resTable.Rows = append(resTable.Rows, v1.TableRow{
+				Cells: []interface{}{
+					raycluster.GetName(),
+					raycluster.GetNamespace(),
+					workerGroupStatus["groupName"],
+					valueOrDefault(workerGroupStatus, "desiredReplicas", 0),
+					valueOrDefault(workerGroupStatus, "readyReplicas", 0),
+					valueOrDefault(workerGroupStatus, "availableReplicas", 0),
+					valueOrDefault(workerGroupStatus, "pendingReplicas", 0),
+					valueOrDefault(workerGroupStatus, "failedReplicas", 0),
+					valueOrDefault(workerGroupStatus, "desiredCPU", "0"),
+					valueOrDefault(workerGroupStatus, "desiredGPU", "0"),
+					valueOrDefault(workerGroupStatus, "desiredTPU", "0"),
+					valueOrDefault(workerGroupStatus, "desiredMemory", "0"),
+					lastScale,
+				},
+			})
+		}
+	}
+
+	return resultTablePrinter.PrintObj(resTable, output)
+}
+
+// valueOrDefault returns obj[key], or defaultValue if the key is absent. Zero values are omitted from the status.
+func valueOrDefault(obj map[string]interface{}, key string, defaultValue interface{}) interface{} {
+	if value, ok := obj[key]; ok {
+		return value
+	}
+	return defaultValue
+}
@@ -216,3 +216,81 @@ func TestRayClusterGetRun(t *testing.T) {
 		t.Errorf("\nexpected\n%v\ngot\n%v", e, a)
 	}
 }
+
+// Tests that the worker group table is printed from the status of each ray cluster.
+func TestPrintWorkerGroups(t *testing.T) {
+	rayclustersList := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{
+			{
+				Object: map[string]interface{}{
+					"apiVersion": "ray.io/v1",
+					"kind":       "RayCluster",
+					"metadata": map[string]interface{}{
+						"name":      "raycluster-kuberay",
+						"namespace": "test",
+					},
+					"status": map[string]interface{}{
+						"workerGroupStatuses": []interface{}{
+							map[string]interface{}{
+								"groupName":         "workergroup",
+								"desiredReplicas":   int64(2),
+								"readyReplicas":     int64(1),
+								"availableReplicas": int64(1),
+								"pendingReplicas":   int64(1),
+								"desiredCPU":        "4",
+								"desiredMemory":     "8Gi",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expectedTestResultTable := printers.NewTablePrinter(printers.PrintOptions{})
+	testResTable := &v1.Table{
+		ColumnDefinitions: []v1.TableColumnDefinition{
+			{Name: "Cluster", Type: "string"},
+			{Name: "Namespace", Type: "string"},
+			{Name: "Worker Group", Type: "string"},
+			{Name: "Desired", Type: "string"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Available", Type: "string"},
+			{Name: "Pending", Type: "string"},
+			{Name: "Failed", Type: "string"},
+			{Name: "CPUs", Type: "string"},
+			{Name: "GPUs", Type: "string"},
+			{Name: "TPUs", Type: "string"},
+			{Name: "Memory", Type: "string"},
+			{Name: "Last Scale", Type: "string"},
+		},
+		Rows: []v1.TableRow{
+			{
+				Cells: []interface{}{
+					"raycluster-kuberay",
+					"test",
+					"workergroup",
+					int64(2),
+					int64(1),
+					int64(1),
+					int64(1),
+					0,
+					"4",
+					"0",
+					"0",
+					"8Gi",
+					"<unknown>",
+				},
+			},
+		},
+	}
+
+	var resbuffer bytes.Buffer
+	err := expectedTestResultTable.PrintObj(testResTable, &resbuffer)
+	assert.Nil(t, err)
+
+	var outbuffer bytes.Buffer
+	err = printWorkerGroups(rayclustersList, &outbuffer)
+	assert.Nil(t, err)
+	assert.Equal(t, resbuffer.String(), outbuffer.String())
+}
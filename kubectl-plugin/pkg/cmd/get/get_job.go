@@ -0,0 +1,218 @@
+package get
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ray-project/kuberay/kubectl-plugin/pkg/util/completion"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+type GetJobOptions struct {
+	configFlags   *genericclioptions.ConfigFlags
+	ioStreams     *genericclioptions.IOStreams
+	args          []string
+	AllNamespaces bool
+	ShowAttempts  bool
+}
+
+func NewGetJobOptions(streams genericclioptions.IOStreams) *GetJobOptions {
+	return &GetJobOptions{
+		configFlags: genericclioptions.NewConfigFlags(true),
+		ioStreams:   &streams,
+	}
+}
+
+func NewGetJobCommand(streams genericclioptions.IOStreams) *cobra.Command {
+	options := NewGetJobOptions(streams)
+	// Initialize the factory for later use with the current config flag
+	cmdFactory := cmdutil.NewFactory(options.configFlags)
+
+	cmd := &cobra.Command{
+		Use:               "job [NAME]",
+		Short:             "Get job information.",
+		SilenceUsage:      true,
+		ValidArgsFunction: completion.RayJobCompletionFunc(cmdFactory),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(args); err != nil {
+				return err
+			}
+			if err := options.Validate(); err != nil {
+				return err
+			}
+			// running cmd.Execute or cmd.ExecuteE sets the context, which will be done by root
+			return options.Run(cmd.Context(), cmdFactory)
+		},
+	}
+	cmd.Flags().BoolVarP(&options.AllNamespaces, "all-namespaces", "A", options.AllNamespaces, "If present, list the requested jobs across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().BoolVar(&options.ShowAttempts, "attempts", options.ShowAttempts, "If present, also print the attempt history of the requested jobs.")
+	options.configFlags.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func (options *GetJobOptions) Complete(args []string) error {
+	if *options.configFlags.Namespace == "" {
+		options.AllNamespaces = true
+	}
+
+	options.args = args
+	return nil
+}
+
+func (options *GetJobOptions) Validate() error {
+	// Overrides and binds the kube config then retrieves the merged result
+	config, err := options.configFlags.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return fmt.Errorf("Error retrieving raw config: %w", err)
+	}
+	if len(config.CurrentContext) == 0 {
+		return fmt.Errorf("no context is currently set, use %q to select a new one", "kubectl config use-context <context>")
+	}
+	if len(options.args) > 1 {
+		return fmt.Errorf("too many arguments, either one or no arguments are allowed")
+	}
+	return nil
+}
+
+func (options *GetJobOptions) Run(ctx context.Context, factory cmdutil.Factory) error {
+	// Retrieves the dynamic client with factory.
+	dynamicClient, err := factory.DynamicClient()
+	if err != nil {
+		return fmt.Errorf("dynamic client failed to initialize: %w", err)
+	}
+
+	rayResourceSchema := schema.GroupVersionResource{
+		Group:    "ray.io",
+		Version:  "v1",
+		Resource: "rayjobs",
+	}
+
+	var rayjobsList *unstructured.UnstructuredList
+
+	listopts := v1.ListOptions{}
+	if len(options.args) == 1 {
+		listopts = v1.ListOptions{
+			FieldSelector: fmt.Sprintf("metadata.name=%s", options.args[0]),
+		}
+	}
+
+	if options.AllNamespaces {
+		rayjobsList, err = dynamicClient.Resource(rayResourceSchema).List(ctx, listopts)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve rayjob for all namespaces: %w", err)
+		}
+	} else {
+		rayjobsList, err = dynamicClient.Resource(rayResourceSchema).Namespace(*options.configFlags.Namespace).List(ctx, listopts)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve rayjob for namespace %s: %w", *options.configFlags.Namespace, err)
+		}
+	}
+
+	if err := printJobs(rayjobsList, options.ioStreams.Out); err != nil {
+		return err
+	}
+	if !options.ShowAttempts {
+		return nil
+	}
+	fmt.Fprintln(options.ioStreams.Out)
+	return printJobAttempts(rayjobsList, options.ioStreams.Out)
+}
+
+func printJobs(rayjobsList *unstructured.UnstructuredList, output io.Writer) error {
+	resultTablePrinter := printers.NewTablePrinter(printers.PrintOptions{})
+
+	resTable := &v1.Table{
+		ColumnDefinitions: []v1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Namespace", Type: "string"},
+			{Name: "Job ID", Type: "string"},
+			{Name: "Job Status", Type: "string"},
+			{Name: "Deployment Status", Type: "string"},
+			{Name: "Ray Cluster", Type: "string"},
+			{Name: "Attempts", Type: "string"},
+			{Name: "Age", Type: "string"},
+		},
+	}
+
+	for _, rayjob := range rayjobsList.Items {
+		age := duration.HumanDuration(time.Since(rayjob.GetCreationTimestamp().Time))
+		if rayjob.GetCreationTimestamp().Time.IsZero() {
+			age = "<unknown>"
+		}
+		status, _, _ := unstructured.NestedMap(rayjob.Object, "status")
+		attempts, _, _ := unstructured.NestedSlice(rayjob.Object, "status", "attempts")
+		resTable.Rows = append(resTable.Rows, v1.TableRow{
+			Cells: []interface{}{
+				rayjob.GetName(),
+				rayjob.GetNamespace(),
+				valueOrDefault(status, "jobId", ""),
+				valueOrDefault(status, "jobStatus", ""),
+				valueOrDefault(status, "jobDeploymentStatus", ""),
+				valueOrDefault(status, "rayClusterName", ""),
+				len(attempts),
+				age,
+			},
+		})
+	}
+
+	return resultTablePrinter.PrintObj(resTable, output)
+}
+
+func printJobAttempts(rayjobsList *unstructured.UnstructuredList, output io.Writer) error {
+	resultTablePrinter := printers.NewTablePrinter(printers.PrintOptions{})
+
+	resTable := &v1.Table{
+		ColumnDefinitions: []v1.TableColumnDefinition{
+			{Name: "Job", Type: "string"},
+			{Name: "Namespace", Type: "string"},
+			{Name: "Attempt", Type: "string"},
+			{Name: "Job ID", Type: "string"},
+			{Name: "Ray Cluster", Type: "string"},
+			{Name: "Submission Mode", Type: "string"},
+			{Name: "Job Status", Type: "string"},
+			{Name: "Reason", Type: "string"},
+			{Name: "Start Time", Type: "string"},
+			{Name: "End Time", Type: "string"},
+			{Name: "Message", Type: "string"},
+		},
+	}
+
+	for _, rayjob := range rayjobsList.Items {
+		attempts, _, err := unstructured.NestedSlice(rayjob.Object, "status", "attempts")
+		if err != nil {
+			return fmt.Errorf("unable to parse attempts of rayjob %s: %w", rayjob.GetName(), err)
+		}
+		for i, item := range attempts {
+			attempt, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			resTable.Rows = append(resTable.Rows, v1.TableRow{
+				Cells: []interface{}{
+					rayjob.GetName(),
+					rayjob.GetNamespace(),
+					i + 1,
+					valueOrDefault(attempt, "jobId", ""),
+					valueOrDefault(attempt, "rayClusterName", ""),
+					valueOrDefault(attempt, "submissionMode", ""),
+					valueOrDefault(attempt, "jobStatus", ""),
+					valueOrDefault(attempt, "reason", ""),
+					valueOrDefault(attempt, "startTime", "<unknown>"),
+					valueOrDefault(attempt, "endTime", "<unknown>"),
+					valueOrDefault(attempt, "message", ""),
+				},
+			})
+		}
+	}
+
+	return resultTablePrinter.PrintObj(resTable, output)
+}
@@ -0,0 +1,164 @@
+package get
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+)
+
+func newTestRayJob() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "ray.io/v1",
+			"kind":       "RayJob",
+			"metadata": map[string]interface{}{
+				"name":      "rayjob-sample",
+				"namespace": "test",
+			},
+			"status": map[string]interface{}{
+				"jobId":               "rayjob-sample-abcde",
+				"jobStatus":           "RUNNING",
+				"jobDeploymentStatus": "Running",
+				"rayClusterName":      "rayjob-sample-raycluster-xxxxx",
+				"attempts": []interface{}{
+					map[string]interface{}{
+						"jobId":          "rayjob-sample-fghij",
+						"rayClusterName": "rayjob-sample-raycluster-xxxxx",
+						"submissionMode": "K8sJobMode",
+						"jobStatus":      "FAILED",
+						"reason":         "AppFailed",
+						"message":        "Job failed",
+						"startTime":      "2024-07-24T00:00:00Z",
+						"endTime":        "2024-07-24T01:00:00Z",
+					},
+				},
+			},
+		},
+	}
+}
+
+// This is to test Complete() and ensure that it is setting the namespace and arguments correctly
+func TestRayJobGetComplete(t *testing.T) {
+	testStreams, _, _, _ := genericclioptions.NewTestIOStreams()
+	fakeJobGetOptions := NewGetJobOptions(testStreams)
+	fakeArgs := []string{"Expected", "output"}
+
+	*fakeJobGetOptions.configFlags.Namespace = ""
+	fakeJobGetOptions.AllNamespaces = false
+
+	err := fakeJobGetOptions.Complete(fakeArgs)
+	assert.Nil(t, err)
+
+	assert.True(t, fakeJobGetOptions.AllNamespaces)
+	assert.Equal(t, fakeJobGetOptions.args, fakeArgs)
+}
+
+// Tests the Run() step of the command and ensure that the output is as expected.
+func TestRayJobGetRun(t *testing.T) {
+	tf := cmdtesting.NewTestFactory().WithNamespace("test")
+	defer tf.Cleanup()
+
+	testStreams, _, resBuf, _ := genericclioptions.NewTestIOStreams()
+
+	fakeJobGetOptions := NewGetJobOptions(testStreams)
+
+	expectedTestResultTable := printers.NewTablePrinter(printers.PrintOptions{})
+	testResTable := &v1.Table{
+		ColumnDefinitions: []v1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Namespace", Type: "string"},
+			{Name: "Job ID", Type: "string"},
+			{Name: "Job Status", Type: "string"},
+			{Name: "Deployment Status", Type: "string"},
+			{Name: "Ray Cluster", Type: "string"},
+			{Name: "Attempts", Type: "string"},
+			{Name: "Age", Type: "string"},
+		},
+		Rows: []v1.TableRow{
+			{
+				Cells: []interface{}{
+					"rayjob-sample",
+					"test",
+					"rayjob-sample-abcde",
+					"RUNNING",
+					"Running",
+					"rayjob-sample-raycluster-xxxxx",
+					1,
+					"<unknown>",
+				},
+			},
+		},
+	}
+
+	var resbuffer bytes.Buffer
+	err := expectedTestResultTable.PrintObj(testResTable, &resbuffer)
+	assert.Nil(t, err)
+
+	tf.FakeDynamicClient = fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), newTestRayJob())
+
+	err = fakeJobGetOptions.Run(context.Background(), tf)
+	assert.Nil(t, err)
+
+	if e, a := resbuffer.String(), resBuf.String(); e != a {
+		t.Errorf("\nexpected\n%v\ngot\n%v", e, a)
+	}
+}
+
+// Tests that the attempt table is printed from the status of each ray job.
+func TestPrintJobAttempts(t *testing.T) {
+	rayjobsList := &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{*newTestRayJob()},
+	}
+
+	expectedTestResultTable := printers.NewTablePrinter(printers.PrintOptions{})
+	testResTable := &v1.Table{
+		ColumnDefinitions: []v1.TableColumnDefinition{
+			{Name: "Job", Type: "string"},
+			{Name: "Namespace", Type: "string"},
+			{Name: "Attempt", Type: "string"},
+			{Name: "Job ID", Type: "string"},
+			{Name: "Ray Cluster", Type: "string"},
+			{Name: "Submission Mode", Type: "string"},
+			{Name: "Job Status", Type: "string"},
+			{Name: "Reason", Type: "string"},
+			{Name: "Start Time", Type: "string"},
+			{Name: "End Time", Type: "string"},
+			{Name: "Message", Type: "string"},
+		},
+		Rows: []v1.TableRow{
+			{
+				Cells: []interface{}{
+					"rayjob-sample",
+					"test",
+					1,
+					"rayjob-sample-fghij",
+					"rayjob-sample-raycluster-xxxxx",
+					"K8sJobMode",
+					"FAILED",
+					"AppFailed",
+					"2024-07-24T00:00:00Z",
+					"2024-07-24T01:00:00Z",
+					"Job failed",
+				},
+			},
+		},
+	}
+
+	var resbuffer bytes.Buffer
+	err := expectedTestResultTable.PrintObj(testResTable, &resbuffer)
+	assert.Nil(t, err)
+
+	var outbuffer bytes.Buffer
+	err = printJobAttempts(rayjobsList, &outbuffer)
+	assert.Nil(t, err)
+	assert.Equal(t, resbuffer.String(), outbuffer.String())
+}
@@ -4,6 +4,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -19,6 +20,10 @@ type RayClusterSpec struct {
 	HeadServiceAnnotations map[string]string  `json:"headServiceAnnotations,omitempty"`
 	// EnableInTreeAutoscaling indicates whether operator should create in tree autoscaling configs
 	EnableInTreeAutoscaling *bool `json:"enableInTreeAutoscaling,omitempty"`
+	// UpgradeStrategy defines how worker Pods are replaced when a worker group's template changes.
+	// If it is not set, changes to the worker group templates are not applied to existing worker Pods.
+	// If it is set, the worker Pods of the worker groups removed from WorkerGroupSpecs are also deleted.
+	UpgradeStrategy *RayClusterUpgradeStrategy `json:"upgradeStrategy,omitempty"`
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 	// HeadGroupSpecs are the spec for the head pod
@@ -69,10 +74,68 @@ type WorkerGroupSpec struct {
 
 // ScaleStrategy to remove workers
 type ScaleStrategy struct {
+	// DrainGracePeriodSeconds is the maximum number of seconds to wait for a Ray node to drain
+	// before its worker Pod is deleted. The Ray node is asked to drain first so that running tasks
+	// and actors can finish. If not set, worker Pods are deleted without draining.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DrainGracePeriodSeconds *int32 `json:"drainGracePeriodSeconds,omitempty"`
+	// VictimSelectionPolicy decides which worker Pods are deleted when the worker group is scaled down by
+	// the KubeRay operator instead of the autoscaler. Can be "Random", "PodStatus" or "IdleNode". If not set, worker Pods
+	// are deleted in the order in which they are listed.
+	// +optional
+	VictimSelectionPolicy *VictimSelectionPolicy `json:"victimSelectionPolicy,omitempty"`
 	// WorkersToDelete workers to be deleted
 	WorkersToDelete []string `json:"workersToDelete,omitempty"`
 }
 
+// VictimSelectionPolicy is the policy used to choose the worker Pods to delete when a worker group has more Pods than desired.
+// +kubebuilder:validation:Enum=Random;PodStatus;IdleNode
+type VictimSelectionPolicy string
+
+const (
+	// RandomVictimSelection deletes randomly chosen worker Pods.
+	RandomVictimSelection VictimSelectionPolicy = "Random"
+	// PodStatusVictimSelection deletes pending or unscheduled worker Pods first, then the ones that are not ready,
+	// and then the youngest ones.
+	PodStatusVictimSelection VictimSelectionPolicy = "PodStatus"
+	// IdleNodeVictimSelection works like PodStatusVictimSelection, but deletes the ready worker Pods whose Ray nodes
+	// are idle according to the Ray dashboard before the other ready worker Pods.
+	IdleNodeVictimSelection VictimSelectionPolicy = "IdleNode"
+)
+
+// RayClusterUpgradeType is the type of upgrade strategy used for the worker groups of a RayCluster.
+// +kubebuilder:validation:Enum=Recreate;RollingUpdate
+type RayClusterUpgradeType string
+
+const (
+	// Recreate deletes all outdated worker Pods of a worker group at once and then creates new ones.
+	Recreate RayClusterUpgradeType = "Recreate"
+	// RollingUpdate replaces outdated worker Pods of a worker group in batches bounded by MaxUnavailable and MaxSurge.
+	RollingUpdate RayClusterUpgradeType = "RollingUpdate"
+)
+
+// RayClusterUpgradeStrategy defines how outdated worker Pods are replaced.
+type RayClusterUpgradeStrategy struct {
+	// Type of the upgrade strategy. Can be "Recreate" or "RollingUpdate".
+	Type *RayClusterUpgradeType `json:"type,omitempty"`
+	// RollingUpdate configures the rolling update. It is only used when Type is "RollingUpdate".
+	RollingUpdate *RollingUpdateConfig `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateConfig bounds the number of worker Pods replaced at the same time in a worker group.
+// Both fields are scaled against the desired number of worker Pods in the group, which is replicas * numOfHosts.
+type RollingUpdateConfig struct {
+	// MaxUnavailable is the maximum number of worker Pods that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired Pods (ex: 10%), and is rounded down.
+	// Defaults to 25%.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// MaxSurge is the maximum number of worker Pods that can be created above the desired number of Pods.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired Pods (ex: 10%), and is rounded up.
+	// Defaults to 25%.
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
 // AutoscalerOptions specifies optional configuration for the Ray autoscaler.
 type AutoscalerOptions struct {
 	// Resources specifies optional resource request and limit overrides for the autoscaler container.
@@ -135,6 +198,9 @@ type RayClusterStatus struct {
 	// LastUpdateTime indicates last update timestamp for this cluster status.
 	// +nullable
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// LastHeadRestartTime is the last time the KubeRay operator restarted the head Pod after it failed.
+	// +nullable
+	LastHeadRestartTime *metav1.Time `json:"lastHeadRestartTime,omitempty"`
 	// StateTransitionTimes indicates the time of the last state transition for each state.
 	StateTransitionTimes map[ClusterState]*metav1.Time `json:"stateTransitionTimes,omitempty"`
 	// Service Endpoints
@@ -150,6 +216,8 @@ type RayClusterStatus struct {
 	// +listType=map
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// WorkerGroupStatuses reports the observed state of each worker group.
+	WorkerGroupStatuses []WorkerGroupStatus `json:"workerGroupStatuses,omitempty"`
 
 	// ReadyWorkerReplicas indicates how many worker replicas are ready in the cluster
 	ReadyWorkerReplicas int32 `json:"readyWorkerReplicas,omitempty"`
@@ -161,11 +229,47 @@ type RayClusterStatus struct {
 	MinWorkerReplicas int32 `json:"minWorkerReplicas,omitempty"`
 	// MaxWorkerReplicas indicates sum of maximum replicas of each node group.
 	MaxWorkerReplicas int32 `json:"maxWorkerReplicas,omitempty"`
+	// HeadRestarts is the number of times the KubeRay operator has restarted the head Pod after it failed.
+	HeadRestarts int32 `json:"headRestarts,omitempty"`
 	// observedGeneration is the most recent generation observed for this RayCluster. It corresponds to the
 	// RayCluster's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+// WorkerGroupStatus is the observed state of a worker group.
+// All replica counts are numbers of worker Pods, so a multi-host replica counts as NumOfHosts Pods.
+// Pods that are being deleted are not counted.
+type WorkerGroupStatus struct {
+	// GroupName is the name of the worker group.
+	GroupName string `json:"groupName"`
+	// LastScaleTime indicates the last time the desired replicas of the worker group changed.
+	// +nullable
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+	// DesiredCPU indicates total desired CPUs for the worker group
+	DesiredCPU resource.Quantity `json:"desiredCPU,omitempty"`
+	// DesiredMemory indicates total desired memory for the worker group
+	DesiredMemory resource.Quantity `json:"desiredMemory,omitempty"`
+	// DesiredGPU indicates total desired GPUs for the worker group
+	DesiredGPU resource.Quantity `json:"desiredGPU,omitempty"`
+	// DesiredTPU indicates total desired TPUs for the worker group
+	DesiredTPU resource.Quantity `json:"desiredTPU,omitempty"`
+	// UpdatedReplicas is the number of worker Pods created from the current template of the worker group.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// OutdatedReplicas is the number of worker Pods created from a previous template of the worker group.
+	OutdatedReplicas int32 `json:"outdatedReplicas,omitempty"`
+	// DesiredReplicas indicates the desired number of worker Pods of the group, which is the desired replicas
+	// after applying the min/max constraints multiplied by NumOfHosts.
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+	// ReadyReplicas indicates how many worker Pods of the group are running and ready.
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// AvailableReplicas indicates how many worker Pods of the group are running.
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// PendingReplicas indicates how many worker Pods of the group are pending.
+	PendingReplicas int32 `json:"pendingReplicas,omitempty"`
+	// FailedReplicas indicates how many worker Pods of the group have failed.
+	FailedReplicas int32 `json:"failedReplicas,omitempty"`
+}
+
 type RayClusterConditionType string
 
 // Custom Reason for RayClusterCondition
@@ -174,6 +278,13 @@ const (
 	RayClusterPodsProvisioning     = "RayClusterPodsProvisioning"
 	HeadPodNotFound                = "HeadPodNotFound"
 	HeadPodRunningAndReady         = "HeadPodRunningAndReady"
+	HeadPodRecovering              = "HeadPodRecovering"
+	HeadPodRecovered               = "HeadPodRecovered"
+	// The following reasons of the ReplicaFailure condition are diagnosed from the states of the RayCluster Pods.
+	PodImagePullBackOff     = "PodImagePullBackOff"
+	PodUnschedulable        = "PodUnschedulable"
+	PodOOMKilled            = "PodOOMKilled"
+	HeadPodCrashLoopBackOff = "HeadPodCrashLoopBackOff"
 	// UnknownReason says that the reason for the condition is unknown.
 	UnknownReason = "Unknown"
 )
@@ -184,7 +295,11 @@ const (
 	RayClusterProvisioned RayClusterConditionType = "RayClusterProvisioned"
 	// HeadPodReady indicates whether RayCluster's head Pod is ready for requests.
 	HeadPodReady RayClusterConditionType = "HeadPodReady"
-	// RayClusterReplicaFailure is added in a RayCluster when one of its pods fails to be created or deleted.
+	// HeadRecovered is added in a RayCluster after KubeRay restarts its failed head Pod. It is set to true once
+	// the new head Pod is ready.
+	HeadRecovered RayClusterConditionType = "HeadRecovered"
+	// RayClusterReplicaFailure is added in a RayCluster when one of its pods fails to be created or deleted, or when
+	// one of its pods can't become ready, e.g. because it is unschedulable or its image can't be pulled.
 	RayClusterReplicaFailure RayClusterConditionType = "ReplicaFailure"
 	// RayClusterSuspending is set to true when a user sets .Spec.Suspend to true, ensuring the atomicity of the suspend operation.
 	RayClusterSuspending RayClusterConditionType = "RayClusterSuspending"
@@ -6,6 +6,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -59,6 +60,10 @@ func (r *RayCluster) validateRayCluster() error {
 		allErrs = append(allErrs, err)
 	}
 
+	if err := r.validateUpgradeStrategy(); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -87,3 +92,41 @@ func (r *RayCluster) validateWorkerGroups() *field.Error {
 
 	return nil
 }
+
+func (r *RayCluster) validateUpgradeStrategy() *field.Error {
+	strategy := r.Spec.UpgradeStrategy
+	if strategy == nil || strategy.RollingUpdate == nil {
+		return nil
+	}
+	path := field.NewPath("spec").Child("upgradeStrategy")
+	if strategy.Type == nil || *strategy.Type != RollingUpdate {
+		return field.Invalid(path.Child("rollingUpdate"), strategy.RollingUpdate, "rollingUpdate may only be set when type is RollingUpdate")
+	}
+
+	isZero := func(name string, value *intstr.IntOrString) (bool, *field.Error) {
+		if value == nil {
+			return false, nil
+		}
+		// Scale against 100 to validate percentages without knowing the number of worker Pods.
+		scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true)
+		if err != nil {
+			return false, field.Invalid(path.Child("rollingUpdate", name), value.String(), err.Error())
+		}
+		if scaled < 0 {
+			return false, field.Invalid(path.Child("rollingUpdate", name), value.String(), "must be greater than or equal to 0")
+		}
+		return scaled == 0, nil
+	}
+	surgeIsZero, err := isZero("maxSurge", strategy.RollingUpdate.MaxSurge)
+	if err != nil {
+		return err
+	}
+	unavailableIsZero, err := isZero("maxUnavailable", strategy.RollingUpdate.MaxUnavailable)
+	if err != nil {
+		return err
+	}
+	if surgeIsZero && unavailableIsZero {
+		return field.Invalid(path.Child("rollingUpdate"), strategy.RollingUpdate, "maxSurge and maxUnavailable cannot both be 0")
+	}
+	return nil
+}
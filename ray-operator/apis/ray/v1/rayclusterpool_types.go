@@ -0,0 +1,78 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayClusterPoolReleasePolicy specifies what happens to a RayCluster of a RayClusterPool after the RayJob that
+// claimed it finishes.
+// +kubebuilder:validation:Enum=Delete;Reuse
+type RayClusterPoolReleasePolicy string
+
+const (
+	// DeleteRayClusterPoolReleasePolicy deletes the RayCluster, and the pool creates a new one from its template.
+	DeleteRayClusterPoolReleasePolicy RayClusterPoolReleasePolicy = "Delete"
+	// ReuseRayClusterPoolReleasePolicy returns the RayCluster to the pool so that another RayJob can claim it.
+	ReuseRayClusterPoolReleasePolicy RayClusterPoolReleasePolicy = "Reuse"
+)
+
+// RayClusterPoolSpec defines the desired state of RayClusterPool
+type RayClusterPoolSpec struct {
+	// ReleasePolicy specifies what happens to a RayCluster after the RayJob that claimed it finishes.
+	// "Delete" deletes the RayCluster, and "Reuse" returns it to the pool. Defaults to "Delete".
+	// +kubebuilder:default:=Delete
+	// +optional
+	ReleasePolicy RayClusterPoolReleasePolicy `json:"releasePolicy,omitempty"`
+	// Template is the spec of the RayClusters in the pool. When it changes, the idle RayClusters are
+	// replaced, while the RayClusters claimed by RayJobs are kept until they are released.
+	Template RayClusterSpec `json:"template"`
+	// Size is the number of idle RayClusters that the pool keeps. The pool creates a new RayCluster
+	// whenever a RayJob claims one of them.
+	// +kubebuilder:validation:Minimum=0
+	Size int32 `json:"size"`
+}
+
+// RayClusterPoolStatus defines the observed state of RayClusterPool
+type RayClusterPoolStatus struct {
+	// observedGeneration is the most recent generation observed for this RayClusterPool. It corresponds to the
+	// RayClusterPool's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Idle is the number of RayClusters in the pool that aren't claimed by a RayJob.
+	Idle int32 `json:"idle,omitempty"`
+	// Ready is the number of idle RayClusters whose state is `ready`.
+	Ready int32 `json:"ready,omitempty"`
+	// Claimed is the number of RayClusters in the pool that are claimed by RayJobs.
+	Claimed int32 `json:"claimed,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="size",type=integer,JSONPath=".spec.size",priority=0
+// +kubebuilder:printcolumn:name="ready",type=integer,JSONPath=".status.ready",priority=0
+// +kubebuilder:printcolumn:name="idle",type=integer,JSONPath=".status.idle",priority=0
+// +kubebuilder:printcolumn:name="claimed",type=integer,JSONPath=".status.claimed",priority=0
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// +genclient
+// RayClusterPool is the Schema for the rayclusterpools API
+type RayClusterPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RayClusterPoolSpec   `json:"spec,omitempty"`
+	Status RayClusterPoolStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RayClusterPoolList contains a list of RayClusterPool
+type RayClusterPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayClusterPool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayClusterPool{}, &RayClusterPoolList{})
+}
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConcurrencyPolicy describes how the RayJobs created by a RayCronJob are handled when their runs overlap.
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent allows the RayJobs created by a RayCronJob to run concurrently.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+	// ForbidConcurrent skips a scheduled run if the RayJob of the previous run hasn't finished yet.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+	// ReplaceConcurrent deletes the RayJob of the previous run if it hasn't finished yet and creates a new one.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
+// RayCronJobSpec defines the desired state of RayCronJob
+type RayCronJobSpec struct {
+	// StartingDeadlineSeconds is the deadline in seconds for starting a RayJob if it misses its scheduled
+	// time for any reason. Missed runs are skipped once the deadline has passed.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+	// SuccessfulJobsHistoryLimit is the number of successfully finished RayJobs to keep.
+	// +kubebuilder:default:=3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+	// FailedJobsHistoryLimit is the number of failed RayJobs to keep.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+	// Schedule is the schedule of the RayCronJob in Cron format, see https://en.wikipedia.org/wiki/Cron.
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// ConcurrencyPolicy specifies how to treat concurrent runs of the RayCronJob. Can be "Allow", "Forbid" or "Replace".
+	// "Allow" creates a new RayJob even if the previous one is still running, "Forbid" skips the new run,
+	// and "Replace" deletes the previous RayJob before creating the new one. Defaults to "Allow".
+	// +kubebuilder:default:=Allow
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// JobTemplate is the spec of the RayJobs created by the RayCronJob.
+	JobTemplate RayJobSpec `json:"jobTemplate"`
+}
+
+// RayCronJobStatus defines the observed state of RayCronJob
+type RayCronJobStatus struct {
+	// LastScheduleTime is the last time a RayJob was successfully scheduled.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is the last time a RayJob created by the RayCronJob finished successfully.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// Active holds the names of the RayJobs created by the RayCronJob that haven't finished yet.
+	// +optional
+	Active []string `json:"active,omitempty"`
+	// observedGeneration is the most recent generation observed for this RayCronJob. It corresponds to the
+	// RayCronJob's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="schedule",type=string,JSONPath=".spec.schedule",priority=0
+// +kubebuilder:printcolumn:name="last schedule",type=date,JSONPath=".status.lastScheduleTime",priority=0
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// +genclient
+// RayCronJob is the Schema for the raycronjobs API
+type RayCronJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status RayCronJobStatus `json:"status,omitempty"`
+	Spec   RayCronJobSpec   `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RayCronJobList contains a list of RayCronJob
+type RayCronJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayCronJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayCronJob{}, &RayCronJobList{})
+}
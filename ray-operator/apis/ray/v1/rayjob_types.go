@@ -43,6 +43,7 @@ const (
 	JobDeploymentStatusSuspended    JobDeploymentStatus = "Suspended"
 	JobDeploymentStatusRetrying     JobDeploymentStatus = "Retrying"
 	JobDeploymentStatusWaiting      JobDeploymentStatus = "Waiting"
+	JobDeploymentStatusBlocked      JobDeploymentStatus = "Blocked"
 )
 
 // JobFailedReason indicates the reason the RayJob changes its JobDeploymentStatus to 'Failed'
@@ -52,6 +53,14 @@ const (
 	SubmissionFailed JobFailedReason = "SubmissionFailed"
 	DeadlineExceeded JobFailedReason = "DeadlineExceeded"
 	AppFailed        JobFailedReason = "AppFailed"
+	DependencyFailed JobFailedReason = "DependencyFailed"
+	// The following reasons are diagnosed from the states of the RayCluster Pods and the submitter Pods.
+	ImagePullBackOff           JobFailedReason = "ImagePullBackOff"
+	Unschedulable              JobFailedReason = "Unschedulable"
+	OOMKilled                  JobFailedReason = "OOMKilled"
+	HeadPodCrashLoop           JobFailedReason = "HeadPodCrashLoop"
+	ClusterProvisioningTimeout JobFailedReason = "ClusterProvisioningTimeout"
+	SubmitterPodFailed         JobFailedReason = "SubmitterPodFailed"
 )
 
 type JobSubmissionMode string
@@ -62,20 +71,138 @@ const (
 	InteractiveMode JobSubmissionMode = "InteractiveMode" // Don't submit job in KubeRay. Instead, wait for user to submit job and provide the job submission ID.
 )
 
+// RayJobRetryPolicy is the policy used to retry a RayJob whose Ray job has failed.
+// +kubebuilder:validation:Enum=NewCluster;SameCluster
+type RayJobRetryPolicy string
+
+const (
+	// NewClusterRetry deletes the RayCluster and retries the Ray job on a new RayCluster.
+	NewClusterRetry RayJobRetryPolicy = "NewCluster"
+	// SameClusterRetry resubmits the Ray job with a new submission ID to the existing RayCluster.
+	SameClusterRetry RayJobRetryPolicy = "SameCluster"
+)
+
+// RayJobDependencyCondition is the terminal state that a RayJob in `DependsOn` must reach before the dependent RayJob starts.
+// +kubebuilder:validation:Enum=Complete;Failed;Any
+type RayJobDependencyCondition string
+
+const (
+	// RayJobDependencyComplete requires the Ray job of the RayJob to succeed.
+	RayJobDependencyComplete RayJobDependencyCondition = "Complete"
+	// RayJobDependencyFailed requires the RayJob to finish without its Ray job succeeding.
+	RayJobDependencyFailed RayJobDependencyCondition = "Failed"
+	// RayJobDependencyAny requires the RayJob to finish, whether or not its Ray job succeeded.
+	RayJobDependencyAny RayJobDependencyCondition = "Any"
+)
+
+// RayJobDependency is a RayJob in the same namespace that must finish before the dependent RayJob starts.
+type RayJobDependency struct {
+	// Name is the name of the RayJob.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Condition is the terminal state that the RayJob must reach. Can be "Complete", "Failed" or "Any".
+	// If the RayJob finishes in another state, the dependent RayJob fails with the reason "DependencyFailed".
+	// +kubebuilder:default:=Complete
+	// +optional
+	Condition RayJobDependencyCondition `json:"condition,omitempty"`
+}
+
+// DeletionPolicyType specifies which resources are deleted after a RayJob finishes.
+// +kubebuilder:validation:Enum=DeleteCluster;DeleteWorkers;DeleteSelf;DeleteNone
+type DeletionPolicyType string
+
+const (
+	// DeleteClusterDeletionPolicy deletes the RayCluster.
+	DeleteClusterDeletionPolicy DeletionPolicyType = "DeleteCluster"
+	// DeleteWorkersDeletionPolicy scales all worker groups of the RayCluster to zero and keeps the head Pod for debugging.
+	DeleteWorkersDeletionPolicy DeletionPolicyType = "DeleteWorkers"
+	// DeleteSelfDeletionPolicy deletes the RayJob custom resource together with the resources it owns.
+	DeleteSelfDeletionPolicy DeletionPolicyType = "DeleteSelf"
+	// DeleteNoneDeletionPolicy doesn't delete any resources.
+	DeleteNoneDeletionPolicy DeletionPolicyType = "DeleteNone"
+)
+
+// DeletionPolicy specifies which resources are deleted after a RayJob finishes, depending on whether the Ray job succeeded.
+type DeletionPolicy struct {
+	// OnSuccess is the deletion policy applied when the Ray job succeeds.
+	OnSuccess DeletionPolicyType `json:"onSuccess"`
+	// OnFailure is the deletion policy applied when the Ray job or the RayJob fails.
+	OnFailure DeletionPolicyType `json:"onFailure"`
+}
+
 type SubmitterConfig struct {
 	// BackoffLimit of the submitter k8s job.
 	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
 }
 
+// LogRetention configures where the driver logs of the Ray job are stored once the Ray job reaches a terminal
+// status, so that they remain available after the RayCluster is deleted. Exactly one of ConfigMap and
+// PersistentVolumeClaim must be set.
+type LogRetention struct {
+	// ConfigMap stores the tail of the driver logs in a ConfigMap owned by the RayJob.
+	// +optional
+	ConfigMap *ConfigMapLogRetention `json:"configMap,omitempty"`
+	// PersistentVolumeClaim stores the full driver logs on a PersistentVolumeClaim.
+	// +optional
+	PersistentVolumeClaim *PersistentVolumeClaimLogRetention `json:"persistentVolumeClaim,omitempty"`
+}
+
+type ConfigMapLogRetention struct {
+	// TailBytes is the maximum number of bytes at the end of the driver logs to store in the ConfigMap.
+	// +kubebuilder:default:=65536
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000000
+	// +optional
+	TailBytes *int32 `json:"tailBytes,omitempty"`
+}
+
+type PersistentVolumeClaimLogRetention struct {
+	// ClaimName is the name of a PersistentVolumeClaim in the same namespace as the RayJob.
+	// +kubebuilder:validation:MinLength=1
+	ClaimName string `json:"claimName"`
+	// SubPath is the directory in the volume where the logs are written. Defaults to the root of the volume.
+	// +optional
+	SubPath string `json:"subPath,omitempty"`
+}
+
+// RayJobLogLocation describes where the driver logs of a Ray job are stored.
+type RayJobLogLocation struct {
+	// ConfigMapName is the name of the ConfigMap that stores the tail of the driver logs.
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// PersistentVolumeClaimName is the name of the PersistentVolumeClaim that stores the driver logs.
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName,omitempty"`
+	// Path is the path of the log file in the ConfigMap data or in the PersistentVolumeClaim.
+	Path string `json:"path,omitempty"`
+}
+
 // RayJobSpec defines the desired state of RayJob
 type RayJobSpec struct {
 	// ActiveDeadlineSeconds is the duration in seconds that the RayJob may be active before
 	// KubeRay actively tries to terminate the RayJob; value must be positive integer.
+	// While the RayJob is blocked by DependsOn, the duration is counted from the creation of the RayJob.
 	ActiveDeadlineSeconds *int32 `json:"activeDeadlineSeconds,omitempty"`
+	// InitializationDeadlineSeconds is the duration in seconds that the RayJob may stay in the `Initializing`
+	// status waiting for the RayCluster to become ready. After the deadline, the RayJob fails with the reason
+	// diagnosed from the RayCluster Pods, or `ClusterProvisioningTimeout` if no Pod failure is found.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	InitializationDeadlineSeconds *int32 `json:"initializationDeadlineSeconds,omitempty"`
+	// SuspendGracePeriodSeconds is the duration in seconds that KubeRay waits for the Ray job to stop
+	// before deleting the RayCluster and the submitter Kubernetes Job when the RayJob is suspended.
+	// KubeRay stops the Ray job and waits until it is STOPPED or the grace period expires.
+	// If not set, the resources are deleted without stopping the Ray job first.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuspendGracePeriodSeconds *int32 `json:"suspendGracePeriodSeconds,omitempty"`
 	// Specifies the number of retries before marking this job failed.
-	// Each retry creates a new RayCluster.
+	// Each retry creates a new RayCluster unless RetryPolicy is set to "SameCluster".
 	// +kubebuilder:default:=0
 	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+	// RetryPolicy specifies how a failed Ray job is retried. Can be "NewCluster" or "SameCluster".
+	// "NewCluster" deletes the RayCluster and retries the Ray job on a new one, while "SameCluster"
+	// resubmits the Ray job with a new submission ID to the existing RayCluster. Defaults to "NewCluster".
+	// +optional
+	RetryPolicy *RayJobRetryPolicy `json:"retryPolicy,omitempty"`
 	// RayClusterSpec is the cluster template to run the job
 	RayClusterSpec *RayClusterSpec `json:"rayClusterSpec,omitempty"`
 	// SubmitterPodTemplate is the template for the pod that will run `ray job submit`.
@@ -84,8 +211,31 @@ type RayJobSpec struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 	// clusterSelector is used to select running rayclusters by labels
 	ClusterSelector map[string]string `json:"clusterSelector,omitempty"`
+	// ClusterPoolSelector selects a pool of shared RayClusters by labels. The Ray job is submitted to the `Ready`
+	// RayCluster in the pool that runs the fewest Ray jobs. It can't be used together with ClusterSelector or RayClusterSpec.
+	// +optional
+	ClusterPoolSelector *metav1.LabelSelector `json:"clusterPoolSelector,omitempty"`
+	// MaxConcurrentJobsPerCluster is the maximum number of running Ray jobs on a RayCluster in the pool selected by
+	// ClusterPoolSelector. A RayCluster that has reached the limit isn't chosen, and the RayJob waits until a RayCluster
+	// in the pool has room for it. If not set, the number of Ray jobs on a RayCluster isn't limited.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentJobsPerCluster *int32 `json:"maxConcurrentJobsPerCluster,omitempty"`
 	// Configurations of submitter k8s job.
 	SubmitterConfig *SubmitterConfig `json:"submitterConfig,omitempty"`
+	// LogRetention stores the driver logs of the Ray job when it reaches a terminal status, before the
+	// RayCluster is deleted. The location of the logs is recorded in the status.
+	// +optional
+	LogRetention *LogRetention `json:"logRetention,omitempty"`
+	// DeletionPolicy specifies which resources are deleted after the RayJob finishes, with separate
+	// rules for success and failure. It can't be used together with ShutdownAfterJobFinishes.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+	// RuntimeEnvFrom references a key of a ConfigMap in the namespace of the RayJob that contains the runtime
+	// environment as a YAML string. It can't be set together with RuntimeEnvYAML. The ConfigMap is read when the
+	// Ray job is submitted.
+	// +optional
+	RuntimeEnvFrom *corev1.ConfigMapKeySelector `json:"runtimeEnvFrom,omitempty"`
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 	Entrypoint string `json:"entrypoint,omitempty"`
@@ -103,12 +253,23 @@ type RayJobSpec struct {
 	// EntrypointResources specifies the custom resources and quantities to reserve for the
 	// entrypoint command.
 	EntrypointResources string `json:"entrypointResources,omitempty"`
+	// RayClusterPoolName is the name of a RayClusterPool in the same namespace. The RayJob claims an idle RayCluster
+	// of the pool instead of creating one, and releases it according to the release policy of the pool after the
+	// RayJob finishes. It can't be used together with RayClusterSpec, ClusterSelector or ClusterPoolSelector.
+	// +optional
+	RayClusterPoolName string `json:"rayClusterPoolName,omitempty"`
+	// DependsOn lists the RayJobs in the same namespace that must finish before this RayJob starts.
+	// Until then, the RayJob stays in the "Blocked" JobDeploymentStatus. A RayJob that isn't found keeps
+	// this RayJob blocked until ActiveDeadlineSeconds passes, so the RayJobs in DependsOn must not delete
+	// themselves, e.g., with the DeleteSelf deletion policy, before this RayJob starts.
+	// +optional
+	DependsOn []RayJobDependency `json:"dependsOn,omitempty"`
 	// EntrypointNumCpus specifies the number of cpus to reserve for the entrypoint command.
 	EntrypointNumCpus float32 `json:"entrypointNumCpus,omitempty"`
 	// EntrypointNumGpus specifies the number of gpus to reserve for the entrypoint command.
 	EntrypointNumGpus float32 `json:"entrypointNumGpus,omitempty"`
 	// TTLSecondsAfterFinished is the TTL to clean up RayCluster.
-	// It's only working when ShutdownAfterJobFinishes set to true.
+	// It's only working when ShutdownAfterJobFinishes set to true or DeletionPolicy is set.
 	// +kubebuilder:default:=0
 	TTLSecondsAfterFinished int32 `json:"ttlSecondsAfterFinished,omitempty"`
 	// ShutdownAfterJobFinishes will determine whether to delete the ray cluster once rayJob succeed or failed.
@@ -123,6 +284,19 @@ type RayJobSpec struct {
 
 // RayJobStatus defines the observed state of RayJob
 type RayJobStatus struct {
+	// Attempts is the history of the attempts to run the Ray job, in the order they finished. An attempt
+	// finishes when the Ray job reaches a terminal state, the submission fails, or the RayJob is suspended.
+	// Only the 10 most recent attempts are kept, and the history is preserved across retries and suspensions.
+	// +kubebuilder:validation:MaxItems=10
+	// +optional
+	Attempts []RayJobAttempt `json:"attempts,omitempty"`
+	// Represents the latest available observations of a RayJob's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 	JobId               string              `json:"jobId,omitempty"`
@@ -138,6 +312,13 @@ type RayJobStatus struct {
 	// This occurs when the Ray job reaches a terminal state (SUCCEEDED, FAILED, STOPPED)
 	// or the submitter Job has failed.
 	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// SuspendStartTime is the time when JobDeploymentStatus transitioned to 'Suspending' with SuspendGracePeriodSeconds set.
+	// The grace period to stop the Ray job starts at this time. It is cleared once the RayJob is suspended.
+	// +optional
+	SuspendStartTime *metav1.Time `json:"suspendStartTime,omitempty"`
+	// LogLocation is where the driver logs of the most recent Ray job are stored when LogRetention is set.
+	// +optional
+	LogLocation *RayJobLogLocation `json:"logLocation,omitempty"`
 	// Succeeded is the number of times this job succeeded.
 	// +kubebuilder:default:=0
 	Succeeded *int32 `json:"succeeded,omitempty"`
@@ -152,6 +333,39 @@ type RayJobStatus struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+type RayJobConditionType string
+
+const (
+	// RayJobClusterReady indicates whether the RayCluster of the RayJob is ready to run the Ray job.
+	RayJobClusterReady RayJobConditionType = "ClusterReady"
+	// RayJobSubmitted indicates whether the Ray job has been submitted to the RayCluster.
+	RayJobSubmitted RayJobConditionType = "JobSubmitted"
+	// RayJobFinished is set to true when the RayJob is `Complete` or `Failed`. Its reason is the reason of the failure, if any.
+	RayJobFinished RayJobConditionType = "JobFinished"
+	// RayJobSuspended is set to true when the RayJob is `Suspended`.
+	RayJobSuspended RayJobConditionType = "Suspended"
+)
+
+// RayJobAttempt records a single attempt to run the Ray job.
+type RayJobAttempt struct {
+	// StartTime is the time when the attempt started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time when the attempt finished.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// JobId is the submission ID of the Ray job in this attempt.
+	JobId string `json:"jobId,omitempty"`
+	// RayClusterName is the name of the RayCluster that ran the Ray job in this attempt.
+	RayClusterName string `json:"rayClusterName,omitempty"`
+	// SubmissionMode is the mode used to submit the Ray job in this attempt.
+	SubmissionMode JobSubmissionMode `json:"submissionMode,omitempty"`
+	// JobStatus is the status of the Ray job when the attempt finished.
+	JobStatus JobStatus `json:"jobStatus,omitempty"`
+	// Reason is the reason why the attempt failed, if it did.
+	Reason JobFailedReason `json:"reason,omitempty"`
+	// Message is the message of the Ray job when the attempt finished, e.g. the reason why it failed.
+	Message string `json:"message,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:categories=all
 // +kubebuilder:subresource:status
@@ -0,0 +1,137 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayJobSetState is the state of a RayJobSet.
+type RayJobSetState string
+
+const (
+	RayJobSetRunning  RayJobSetState = "Running"
+	RayJobSetComplete RayJobSetState = "Complete"
+	RayJobSetFailed   RayJobSetState = "Failed"
+)
+
+// RayJobSetParameters defines the parameter sets of a RayJobSet. Exactly one of Matrix and List must be set.
+type RayJobSetParameters struct {
+	// Matrix maps each parameter name to a list of values. A child RayJob is created for every combination of the values.
+	// +optional
+	Matrix map[string][]string `json:"matrix,omitempty"`
+	// List holds the parameter sets explicitly. A child RayJob is created for each of them.
+	// +optional
+	List []map[string]string `json:"list,omitempty"`
+}
+
+// RayJobSetSuccessPolicy specifies when a RayJobSet succeeds.
+type RayJobSetSuccessPolicy struct {
+	// SucceededJobs is the number of child RayJobs that must succeed for the RayJobSet to succeed.
+	// Defaults to the number of parameter sets, i.e., all the child RayJobs must succeed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	SucceededJobs *int32 `json:"succeededJobs,omitempty"`
+}
+
+// RayJobSetFailurePolicy specifies when a RayJobSet fails.
+type RayJobSetFailurePolicy struct {
+	// FailedJobs is the number of failed child RayJobs at which the RayJobSet fails. Defaults to the
+	// smallest number of failures that makes it impossible to satisfy the success policy.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	FailedJobs *int32 `json:"failedJobs,omitempty"`
+}
+
+// RayJobSetSpec defines the desired state of RayJobSet
+type RayJobSetSpec struct {
+	// Parallelism is the maximum number of child RayJobs that run at the same time.
+	// If not set, the child RayJobs of all the parameter sets are created at once.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Parallelism *int32 `json:"parallelism,omitempty"`
+	// SuccessPolicy specifies when the RayJobSet succeeds.
+	// +optional
+	SuccessPolicy *RayJobSetSuccessPolicy `json:"successPolicy,omitempty"`
+	// FailurePolicy specifies when the RayJobSet fails.
+	// +optional
+	FailurePolicy *RayJobSetFailurePolicy `json:"failurePolicy,omitempty"`
+	// Parameters defines the parameter sets of the sweep. Each parameter set is injected into its child RayJob
+	// as environment variables of the Ray job through `runtimeEnvYAML`, and every `$(NAME)` in `entrypoint`
+	// is replaced with the value of the parameter NAME.
+	Parameters RayJobSetParameters `json:"parameters"`
+	// Template is the spec shared by the child RayJobs of the RayJobSet.
+	Template RayJobSpec `json:"template"`
+}
+
+// RayJobSetJobStatus is the status of a child RayJob of a RayJobSet.
+type RayJobSetJobStatus struct {
+	// Parameters is the parameter set of the child RayJob.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// Name is the name of the child RayJob.
+	Name string `json:"name"`
+	// JobStatus is the status of the Ray job. It is empty if the child RayJob hasn't been created yet.
+	JobStatus JobStatus `json:"jobStatus,omitempty"`
+	// JobDeploymentStatus is the deployment status of the child RayJob.
+	JobDeploymentStatus JobDeploymentStatus `json:"jobDeploymentStatus,omitempty"`
+}
+
+// RayJobSetStatus defines the observed state of RayJobSet
+type RayJobSetStatus struct {
+	// StartTime is the time when the RayJobSet started to create child RayJobs.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time when the RayJobSet transitioned to 'Complete' or 'Failed'.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// State is the state of the RayJobSet. Can be "Running", "Complete" or "Failed".
+	// +optional
+	State RayJobSetState `json:"state,omitempty"`
+	// Message explains why the RayJobSet is in its current state.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Jobs holds the status of the child RayJob of each parameter set.
+	// +optional
+	Jobs []RayJobSetJobStatus `json:"jobs,omitempty"`
+	// observedGeneration is the most recent generation observed for this RayJobSet. It corresponds to the
+	// RayJobSet's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Pending is the number of child RayJobs that haven't been created yet.
+	Pending int32 `json:"pending,omitempty"`
+	// Active is the number of child RayJobs that haven't finished yet.
+	Active int32 `json:"active,omitempty"`
+	// Succeeded is the number of child RayJobs whose Ray job succeeded.
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// Failed is the number of child RayJobs that finished without their Ray job succeeding.
+	Failed int32 `json:"failed,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="state",type=string,JSONPath=".status.state",priority=0
+// +kubebuilder:printcolumn:name="succeeded",type=integer,JSONPath=".status.succeeded",priority=0
+// +kubebuilder:printcolumn:name="failed",type=integer,JSONPath=".status.failed",priority=0
+// +kubebuilder:printcolumn:name="active",type=integer,JSONPath=".status.active",priority=0
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
+// +genclient
+// RayJobSet is the Schema for the rayjobsets API
+type RayJobSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RayJobSetSpec   `json:"spec,omitempty"`
+	Status RayJobSetStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RayJobSetList contains a list of RayJobSet
+type RayJobSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayJobSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayJobSet{}, &RayJobSetList{})
+}
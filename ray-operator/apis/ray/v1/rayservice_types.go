@@ -27,8 +27,39 @@ const (
 	NewCluster RayServiceUpgradeStrategy = "NewCluster"
 	// No new cluster will be created while the strategy is set to None
 	None RayServiceUpgradeStrategy = "None"
+	// During upgrade, IncrementalUpgrade strategy will create new upgraded cluster and shift the traffic to it in steps
+	IncrementalUpgrade RayServiceUpgradeStrategy = "IncrementalUpgrade"
 )
 
+// RayServiceWorkerGroupUpgradeStrategy decides how a RayService applies changes that only affect the worker groups
+// of the RayCluster.
+// +kubebuilder:validation:Enum=NewCluster;InPlace
+type RayServiceWorkerGroupUpgradeStrategy string
+
+const (
+	// The worker group changes are applied by preparing a new RayCluster according to the upgrade strategy of the RayService.
+	NewClusterWorkerGroupUpgrade RayServiceWorkerGroupUpgradeStrategy = "NewCluster"
+	// The worker group changes are applied to the active RayCluster, which replaces its outdated worker Pods
+	// according to `rayClusterConfig.upgradeStrategy`.
+	InPlaceWorkerGroupUpgrade RayServiceWorkerGroupUpgradeStrategy = "InPlace"
+)
+
+// IncrementalUpgradeOptions configures how the traffic is shifted to the new RayCluster with the IncrementalUpgrade strategy.
+// The traffic is split by labeling the serving Pods of both RayClusters, so the actual split is approximated by the
+// numbers of ready serving Pods in each RayCluster.
+type IncrementalUpgradeOptions struct {
+	// IntervalSeconds is how long the traffic is held at each step before it's shifted to the next one.
+	// The traffic is only shifted while the Serve applications on the new RayCluster are running. Defaults to 60.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+	// TrafficSteps are the percentages of the traffic routed to the new RayCluster in each step. They must be
+	// increasing and end with 100. The new RayCluster becomes active once it receives all the traffic.
+	// Defaults to [10, 50, 100].
+	// +optional
+	TrafficSteps []int32 `json:"trafficSteps,omitempty"`
+}
+
 // These statuses should match Ray Serve's application statuses
 // See `enum ApplicationStatus` in https://sourcegraph.com/github.com/ray-project/ray/-/blob/src/ray/protobuf/serve.proto for more details.
 var ApplicationStatusEnum = struct {
@@ -66,8 +97,29 @@ type RayServiceSpec struct {
 	DeploymentUnhealthySecondThreshold *int32 `json:"deploymentUnhealthySecondThreshold,omitempty"`
 	// ServeService is the Kubernetes service for head node and worker nodes who have healthy http proxy to serve traffics.
 	ServeService *corev1.Service `json:"serveService,omitempty"`
-	// UpgradeStrategy represents the strategy used when upgrading the RayService. Currently supports `NewCluster` and `None`
+	// UpgradeStrategy represents the strategy used when upgrading the RayService. Currently supports `NewCluster`, `IncrementalUpgrade` and `None`
 	UpgradeStrategy *RayServiceUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	// IncrementalUpgradeOptions configures the IncrementalUpgrade strategy.
+	// +optional
+	IncrementalUpgradeOptions *IncrementalUpgradeOptions `json:"incrementalUpgradeOptions,omitempty"`
+	// WorkerGroupUpgradeStrategy decides how changes that only affect `rayClusterConfig.workerGroupSpecs`, such as a new
+	// worker image or different resources, are applied. With `InPlace`, the active RayCluster is updated and its worker
+	// Pods are replaced according to `rayClusterConfig.upgradeStrategy`, which must be set. Any other change to the
+	// RayCluster spec still prepares a new RayCluster. Defaults to `NewCluster`.
+	// +optional
+	WorkerGroupUpgradeStrategy *RayServiceWorkerGroupUpgradeStrategy `json:"workerGroupUpgradeStrategy,omitempty"`
+	// UpgradeTimeoutSeconds is the maximum time the Serve applications on the new RayCluster can take to become ready
+	// during an upgrade, measured from the creation of the new RayCluster. If it's exceeded, the upgrade is rolled back:
+	// the new RayCluster is abandoned and its spec is recorded in `status.lastFailedSpec` so that it isn't retried.
+	// If it isn't set, the upgrade waits for the new RayCluster indefinitely.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UpgradeTimeoutSeconds *int32 `json:"upgradeTimeoutSeconds,omitempty"`
+	// ServeConfigV2From references a key of a ConfigMap in the namespace of the RayService that contains the Serve config.
+	// It can't be set together with ServeConfigV2 and can't be optional. Changes to the ConfigMap are deployed like changes
+	// to ServeConfigV2. If the ConfigMap or the key is missing or empty, the Serve config applied before is kept.
+	// +optional
+	ServeConfigV2From *corev1.ConfigMapKeySelector `json:"serveConfigV2From,omitempty"`
 	// Important: Run "make" to regenerate code after modifying this file
 	// Defines the applications and deployments to deploy, should be a YAML multi-line scalar string.
 	ServeConfigV2  string         `json:"serveConfigV2,omitempty"`
@@ -78,9 +130,28 @@ type RayServiceSpec struct {
 type RayServiceStatuses struct {
 	// LastUpdateTime represents the timestamp when the RayService status was last updated.
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Represents the latest available observations of a RayService's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
 	// ServiceStatus indicates the current RayService status.
-	ServiceStatus       ServiceStatus    `json:"serviceStatus,omitempty"`
-	ActiveServiceStatus RayServiceStatus `json:"activeServiceStatus,omitempty"`
+	ServiceStatus ServiceStatus `json:"serviceStatus,omitempty"`
+	// LastFailedSpec is the hash of the RayCluster spec of the last upgrade that was rolled back. KubeRay doesn't
+	// prepare a new RayCluster for this spec again until the spec changes.
+	// +optional
+	LastFailedSpec string `json:"lastFailedSpec,omitempty"`
+	// PreviousActiveRayClusterName is the name of the RayCluster that was active before the last upgrade. It can be
+	// restored with the `ray.io/rollback` annotation until it's deleted.
+	// +optional
+	PreviousActiveRayClusterName string `json:"previousActiveRayClusterName,omitempty"`
+	// RayClusterDeletionTimestamps maps the names of the inactive RayClusters of the RayService to the time
+	// they're scheduled to be deleted.
+	// +optional
+	RayClusterDeletionTimestamps map[string]metav1.Time `json:"rayClusterDeletionTimestamps,omitempty"`
+	ActiveServiceStatus          RayServiceStatus       `json:"activeServiceStatus,omitempty"`
 	// Pending Service Status indicates a RayCluster will be created or is being created.
 	PendingServiceStatus RayServiceStatus `json:"pendingServiceStatus,omitempty"`
 	// NumServeEndpoints indicates the number of Ray Pods that are actively serving or have been selected by the serve service.
@@ -91,20 +162,55 @@ type RayServiceStatuses struct {
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+type RayServiceConditionType string
+
+const (
+	// ServeApplicationsReady indicates whether all the Serve applications on the active RayCluster are running.
+	ServeApplicationsReady RayServiceConditionType = "ServeApplicationsReady"
+	// UpgradeInProgress is set to true while a pending RayCluster is being prepared to replace the active one.
+	UpgradeInProgress RayServiceConditionType = "UpgradeInProgress"
+)
+
+// Custom Reason for RayServiceCondition
+const (
+	AllServeApplicationsRunning = "AllServeApplicationsRunning"
+	ServeApplicationsNotRunning = "ServeApplicationsNotRunning"
+	NoActiveRayCluster          = "NoActiveRayCluster"
+	PendingRayClusterPreparing  = "PendingRayClusterPreparing"
+	NoPendingRayCluster         = "NoPendingRayCluster"
+)
+
 type RayServiceStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
-	Applications     map[string]AppStatus `json:"applicationStatuses,omitempty"`
-	RayClusterName   string               `json:"rayClusterName,omitempty"`
-	RayClusterStatus RayClusterStatus     `json:"rayClusterStatus,omitempty"`
+	Applications map[string]AppStatus `json:"applicationStatuses,omitempty"`
+	// TrafficRoutedPercent is the percentage of the traffic routed to the RayCluster with the IncrementalUpgrade strategy.
+	// It's the share of the serving Pods of both RayClusters that receive the traffic, so it may be lower than
+	// TargetTrafficPercent.
+	// +optional
+	TrafficRoutedPercent *int32 `json:"trafficRoutedPercent,omitempty"`
+	// TargetTrafficPercent is the traffic step of the incremental upgrade that the RayCluster has reached.
+	// +optional
+	TargetTrafficPercent *int32 `json:"targetTrafficPercent,omitempty"`
+	// LastTrafficMigratedTime is the last time the traffic was shifted to the RayCluster during an incremental upgrade.
+	// +optional
+	LastTrafficMigratedTime *metav1.Time     `json:"lastTrafficMigratedTime,omitempty"`
+	RayClusterName          string           `json:"rayClusterName,omitempty"`
+	RayClusterStatus        RayClusterStatus `json:"rayClusterStatus,omitempty"`
 }
 
 type AppStatus struct {
 	// Keep track of how long the service is healthy.
 	// Update when Serve deployment is healthy or first time convert to unhealthy from healthy.
-	HealthLastUpdateTime *metav1.Time                     `json:"healthLastUpdateTime,omitempty"`
-	Deployments          map[string]ServeDeploymentStatus `json:"serveDeploymentStatuses,omitempty"`
-	Status               string                           `json:"status,omitempty"`
-	Message              string                           `json:"message,omitempty"`
+	HealthLastUpdateTime *metav1.Time `json:"healthLastUpdateTime,omitempty"`
+	// LastDeployedTime is the time when the Serve application was last deployed, as reported by Ray Serve.
+	// +optional
+	LastDeployedTime *metav1.Time                     `json:"lastDeployedTime,omitempty"`
+	Deployments      map[string]ServeDeploymentStatus `json:"serveDeploymentStatuses,omitempty"`
+	Status           string                           `json:"status,omitempty"`
+	Message          string                           `json:"message,omitempty"`
+	// RoutePrefix is the HTTP route prefix of the Serve application. It is empty if the application doesn't accept HTTP traffic.
+	// +optional
+	RoutePrefix string `json:"routePrefix,omitempty"`
 }
 
 // ServeDeploymentStatus defines the current state of a Serve deployment
@@ -112,12 +218,32 @@ type ServeDeploymentStatus struct {
 	// Keep track of how long the service is healthy.
 	// Update when Serve deployment is healthy or first time convert to unhealthy from healthy.
 	HealthLastUpdateTime *metav1.Time `json:"healthLastUpdateTime,omitempty"`
+	// Autoscaling holds the replica bounds of the Serve deployment. It is nil if autoscaling is disabled.
+	// +optional
+	Autoscaling *ServeDeploymentAutoscalingStatus `json:"autoscaling,omitempty"`
+	// ReplicaStates is the number of replicas of the Serve deployment in each state, such as RUNNING or STARTING.
+	// +optional
+	ReplicaStates map[string]int32 `json:"replicaStates,omitempty"`
+	// TargetReplicas is the number of replicas that Ray Serve is scaling the deployment to.
+	// +optional
+	TargetReplicas *int32 `json:"targetReplicas,omitempty"`
+	// RunningReplicas is the number of replicas of the Serve deployment in the RUNNING state.
+	// +optional
+	RunningReplicas *int32 `json:"runningReplicas,omitempty"`
 	// Name, Status, Message are from Ray Dashboard and represent a Serve deployment's state.
 	// TODO: change status type to enum
 	Status  string `json:"status,omitempty"`
 	Message string `json:"message,omitempty"`
 }
 
+// ServeDeploymentAutoscalingStatus describes the replica bounds of an autoscaling Serve deployment.
+type ServeDeploymentAutoscalingStatus struct {
+	// MinReplicas is the minimum number of replicas of the Serve deployment.
+	MinReplicas int32 `json:"minReplicas"`
+	// MaxReplicas is the maximum number of replicas of the Serve deployment.
+	MaxReplicas int32 `json:"maxReplicas"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:categories=all
 // +kubebuilder:subresource:status
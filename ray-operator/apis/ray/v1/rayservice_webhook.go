@@ -0,0 +1,371 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+)
+
+// log is for logging in this package.
+var rayservicelog = logf.Log.WithName("rayservice-resource")
+
+func (r *RayService) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-ray-io-v1-rayservice,mutating=false,failurePolicy=fail,sideEffects=None,groups=ray.io,resources=rayservices,verbs=create;update,versions=v1,name=vrayservice.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RayService{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *RayService) ValidateCreate() (admission.Warnings, error) {
+	rayservicelog.Info("validate create", "name", r.Name)
+	return r.validateRayService()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *RayService) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	rayservicelog.Info("validate update", "name", r.Name)
+	// Only validate the Serve config if it changes, so that RayServices created before the validation was introduced
+	// can still be updated, for example to remove their finalizers.
+	if oldRayService, ok := old.(*RayService); ok && oldRayService.Spec.ServeConfigV2 == r.Spec.ServeConfigV2 &&
+		equality.Semantic.DeepEqual(oldRayService.Spec.ServeConfigV2From, r.Spec.ServeConfigV2From) {
+		return nil, nil
+	}
+	return r.validateRayService()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *RayService) ValidateDelete() (admission.Warnings, error) {
+	rayservicelog.Info("validate delete", "name", r.Name)
+	return nil, nil
+}
+
+func (r *RayService) validateRayService() (admission.Warnings, error) {
+	warnings, allErrs := r.validateServeConfigV2()
+	if len(allErrs) == 0 {
+		return warnings, nil
+	}
+
+	return warnings, apierrors.NewInvalid(
+		schema.GroupKind{Group: "ray.io", Kind: "RayService"},
+		r.Name, allErrs)
+}
+
+// The types below model the subset of the Ray Serve config schema that KubeRay validates. They mirror
+// `ServeDeploySchema`, `ServeApplicationSchema` and `DeploymentSchema` in `ray/serve/schema.py`.
+
+// +kubebuilder:object:generate=false
+type serveConfig struct {
+	ProxyLocation  *string                `json:"proxy_location,omitempty"`
+	HTTPOptions    *serveHTTPOptions      `json:"http_options,omitempty"`
+	GRPCOptions    *serveGRPCOptions      `json:"grpc_options,omitempty"`
+	LoggingConfig  map[string]interface{} `json:"logging_config,omitempty"`
+	TargetCapacity *float64               `json:"target_capacity,omitempty"`
+	Applications   []serveApplication     `json:"applications,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type serveHTTPOptions struct {
+	Host              *string  `json:"host,omitempty"`
+	Port              *int32   `json:"port,omitempty"`
+	RootPath          *string  `json:"root_path,omitempty"`
+	RequestTimeoutS   *float64 `json:"request_timeout_s,omitempty"`
+	KeepAliveTimeoutS *float64 `json:"keep_alive_timeout_s,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type serveGRPCOptions struct {
+	Port                  *int32   `json:"port,omitempty"`
+	RequestTimeoutS       *float64 `json:"request_timeout_s,omitempty"`
+	GRPCServicerFunctions []string `json:"grpc_servicer_functions,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type serveApplication struct {
+	RuntimeEnv            map[string]interface{} `json:"runtime_env,omitempty"`
+	Args                  map[string]interface{} `json:"args,omitempty"`
+	LoggingConfig         map[string]interface{} `json:"logging_config,omitempty"`
+	Host                  *string                `json:"host,omitempty"`
+	Port                  *int32                 `json:"port,omitempty"`
+	ExternalScalerEnabled *bool                  `json:"external_scaler_enabled,omitempty"`
+	RoutePrefix           serveRoutePrefix       `json:"route_prefix"`
+	Name                  string                 `json:"name,omitempty"`
+	ImportPath            string                 `json:"import_path,omitempty"`
+	Deployments           []serveDeployment      `json:"deployments,omitempty"`
+}
+
+// serveRoutePrefix distinguishes an omitted route prefix, which defaults to "/", from an explicit null,
+// which disables HTTP for the application.
+// +kubebuilder:object:generate=false
+type serveRoutePrefix struct {
+	Value *string
+	Set   bool
+}
+
+func (p *serveRoutePrefix) UnmarshalJSON(data []byte) error {
+	p.Set = true
+	return json.Unmarshal(data, &p.Value)
+}
+
+// +kubebuilder:object:generate=false
+type serveDeployment struct {
+	UserConfig                interface{}              `json:"user_config,omitempty"`
+	NumReplicas               *intstr.IntOrString      `json:"num_replicas,omitempty"`
+	RoutePrefix               *string                  `json:"route_prefix,omitempty"`
+	MaxConcurrentQueries      *int32                   `json:"max_concurrent_queries,omitempty"`
+	MaxOngoingRequests        *int32                   `json:"max_ongoing_requests,omitempty"`
+	MaxQueuedRequests         *int32                   `json:"max_queued_requests,omitempty"`
+	AutoscalingConfig         *serveAutoscalingConfig  `json:"autoscaling_config,omitempty"`
+	GracefulShutdownWaitLoopS *float64                 `json:"graceful_shutdown_wait_loop_s,omitempty"`
+	GracefulShutdownTimeoutS  *float64                 `json:"graceful_shutdown_timeout_s,omitempty"`
+	HealthCheckPeriodS        *float64                 `json:"health_check_period_s,omitempty"`
+	HealthCheckTimeoutS       *float64                 `json:"health_check_timeout_s,omitempty"`
+	RayActorOptions           *serveRayActorOptions    `json:"ray_actor_options,omitempty"`
+	PlacementGroupStrategy    *string                  `json:"placement_group_strategy,omitempty"`
+	MaxReplicasPerNode        *int32                   `json:"max_replicas_per_node,omitempty"`
+	LoggingConfig             map[string]interface{}   `json:"logging_config,omitempty"`
+	Name                      string                   `json:"name,omitempty"`
+	PlacementGroupBundles     []map[string]interface{} `json:"placement_group_bundles,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type serveAutoscalingConfig struct {
+	MinReplicas                        *int32      `json:"min_replicas,omitempty"`
+	InitialReplicas                    *int32      `json:"initial_replicas,omitempty"`
+	MaxReplicas                        *int32      `json:"max_replicas,omitempty"`
+	TargetOngoingRequests              *float64    `json:"target_ongoing_requests,omitempty"`
+	TargetNumOngoingRequestsPerReplica *float64    `json:"target_num_ongoing_requests_per_replica,omitempty"`
+	MetricsIntervalS                   *float64    `json:"metrics_interval_s,omitempty"`
+	LookBackPeriodS                    *float64    `json:"look_back_period_s,omitempty"`
+	SmoothingFactor                    *float64    `json:"smoothing_factor,omitempty"`
+	UpscaleSmoothingFactor             *float64    `json:"upscale_smoothing_factor,omitempty"`
+	DownscaleSmoothingFactor           *float64    `json:"downscale_smoothing_factor,omitempty"`
+	UpscalingFactor                    *float64    `json:"upscaling_factor,omitempty"`
+	DownscalingFactor                  *float64    `json:"downscaling_factor,omitempty"`
+	DownscaleDelayS                    *float64    `json:"downscale_delay_s,omitempty"`
+	UpscaleDelayS                      *float64    `json:"upscale_delay_s,omitempty"`
+	AggregationFunction                *string     `json:"aggregation_function,omitempty"`
+	Policy                             interface{} `json:"policy,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+type serveRayActorOptions struct {
+	RuntimeEnv        map[string]interface{} `json:"runtime_env,omitempty"`
+	Resources         map[string]float64     `json:"resources,omitempty"`
+	NumCPUs           *float64               `json:"num_cpus,omitempty"`
+	NumGPUs           *float64               `json:"num_gpus,omitempty"`
+	Memory            *float64               `json:"memory,omitempty"`
+	ObjectStoreMemory *float64               `json:"object_store_memory,omitempty"`
+	AcceleratorType   *string                `json:"accelerator_type,omitempty"`
+}
+
+// validateServeConfigV2 parses ServeConfigV2 against the Serve config schema. Fields that aren't part of the schema
+// are reported as warnings because they may have been added in a newer Ray version.
+func (r *RayService) validateServeConfigV2() (admission.Warnings, field.ErrorList) {
+	path := field.NewPath("spec").Child("serveConfigV2")
+	if r.Spec.ServeConfigV2 == "" {
+		return nil, nil
+	}
+	if r.Spec.ServeConfigV2From != nil {
+		return nil, field.ErrorList{field.Forbidden(path, "serveConfigV2 and serveConfigV2From can't be set at the same time")}
+	}
+
+	config := serveConfig{}
+	if err := yaml.Unmarshal([]byte(r.Spec.ServeConfigV2), &config); err != nil {
+		return nil, field.ErrorList{field.Invalid(path, r.Spec.ServeConfigV2, fmt.Sprintf("failed to parse the Serve config: %v", err))}
+	}
+	var warnings admission.Warnings
+	if err := yaml.UnmarshalStrict([]byte(r.Spec.ServeConfigV2), &serveConfig{}); err != nil {
+		warnings = append(warnings, fmt.Sprintf("%s: %v", path.String(), err))
+	}
+
+	var allErrs field.ErrorList
+	if location := config.ProxyLocation; location != nil && *location != "EveryNode" && *location != "HeadOnly" && *location != "Disabled" {
+		allErrs = append(allErrs, field.NotSupported(path.Child("proxy_location"), *location, []string{"EveryNode", "HeadOnly", "Disabled"}))
+	}
+	allErrs = append(allErrs, validateServePorts(path, config.HTTPOptions, config.GRPCOptions)...)
+
+	appNames := make(map[string]bool)
+	routePrefixes := make(map[string]string)
+	for _, app := range config.Applications {
+		name := app.Name
+		if name == "" {
+			name = "default"
+		}
+		appPath := path.Child("applications").Key(name)
+		if appNames[name] {
+			allErrs = append(allErrs, field.Duplicate(appPath.Child("name"), name))
+			continue
+		}
+		appNames[name] = true
+
+		if !isValidServeImportPath(app.ImportPath) {
+			allErrs = append(allErrs, field.Invalid(appPath.Child("import_path"), app.ImportPath,
+				fmt.Sprintf("application %q must have an import_path of the form '{module}.{attr}' or '{module}:{attr}'", name)))
+		}
+
+		// The route prefix defaults to "/". An explicit null disables HTTP for the application.
+		routePrefix := "/"
+		if app.RoutePrefix.Set {
+			if app.RoutePrefix.Value == nil {
+				routePrefix = ""
+			} else {
+				routePrefix = *app.RoutePrefix.Value
+			}
+		}
+		if routePrefix != "" {
+			if !strings.HasPrefix(routePrefix, "/") || strings.ContainsAny(routePrefix, "{}") {
+				allErrs = append(allErrs, field.Invalid(appPath.Child("route_prefix"), routePrefix,
+					fmt.Sprintf("application %q must have a route_prefix that starts with '/' and doesn't contain wildcards", name)))
+			} else if other, ok := routePrefixes[routePrefix]; ok {
+				allErrs = append(allErrs, field.Invalid(appPath.Child("route_prefix"), routePrefix,
+					fmt.Sprintf("application %q uses the same route_prefix as application %q", name, other)))
+			} else {
+				routePrefixes[routePrefix] = name
+			}
+		}
+
+		deploymentNames := make(map[string]bool)
+		for i, deployment := range app.Deployments {
+			deploymentPath := appPath.Child("deployments").Index(i)
+			if deployment.Name == "" {
+				allErrs = append(allErrs, field.Required(deploymentPath.Child("name"), fmt.Sprintf("deployment of application %q must have a name", name)))
+			} else if deploymentNames[deployment.Name] {
+				allErrs = append(allErrs, field.Duplicate(deploymentPath.Child("name"), deployment.Name))
+			}
+			deploymentNames[deployment.Name] = true
+			allErrs = append(allErrs, validateServeDeployment(deploymentPath, name, deployment)...)
+		}
+	}
+	return warnings, allErrs
+}
+
+// validateServePorts checks that the HTTP and gRPC proxies listen on valid ports that don't collide.
+func validateServePorts(path *field.Path, httpOptions *serveHTTPOptions, grpcOptions *serveGRPCOptions) field.ErrorList {
+	var allErrs field.ErrorList
+	var httpPort, grpcPort int32
+	if httpOptions != nil && httpOptions.Port != nil {
+		httpPort = *httpOptions.Port
+		if httpPort < 1 || httpPort > 65535 {
+			allErrs = append(allErrs, field.Invalid(path.Child("http_options", "port"), httpPort, "must be between 1 and 65535"))
+		}
+	}
+	if grpcOptions != nil && grpcOptions.Port != nil {
+		grpcPort = *grpcOptions.Port
+		if grpcPort < 1 || grpcPort > 65535 {
+			allErrs = append(allErrs, field.Invalid(path.Child("grpc_options", "port"), grpcPort, "must be between 1 and 65535"))
+		}
+	}
+	if httpPort != 0 && httpPort == grpcPort {
+		allErrs = append(allErrs, field.Invalid(path.Child("grpc_options", "port"), grpcPort, "must be different from http_options.port"))
+	}
+	return allErrs
+}
+
+func validateServeDeployment(path *field.Path, appName string, deployment serveDeployment) field.ErrorList {
+	var allErrs field.ErrorList
+	invalid := func(name string, value interface{}, requirement string) {
+		allErrs = append(allErrs, field.Invalid(path.Child(name), value,
+			fmt.Sprintf("deployment %q of application %q: %s", deployment.Name, appName, requirement)))
+	}
+
+	if numReplicas := deployment.NumReplicas; numReplicas != nil {
+		if numReplicas.Type == intstr.String && numReplicas.StrVal != "auto" {
+			invalid("num_replicas", numReplicas.StrVal, "must be a non-negative integer or \"auto\"")
+		} else if numReplicas.Type == intstr.Int {
+			if numReplicas.IntVal < 0 {
+				invalid("num_replicas", numReplicas.IntVal, "must be a non-negative integer or \"auto\"")
+			} else if deployment.AutoscalingConfig != nil {
+				invalid("num_replicas", numReplicas.IntVal, "can't be set to a number together with autoscaling_config")
+			}
+		}
+	}
+	if value := deployment.MaxOngoingRequests; value != nil && *value <= 0 {
+		invalid("max_ongoing_requests", *value, "must be greater than 0")
+	}
+	if value := deployment.MaxConcurrentQueries; value != nil && *value <= 0 {
+		invalid("max_concurrent_queries", *value, "must be greater than 0")
+	}
+	if value := deployment.MaxQueuedRequests; value != nil && *value != -1 && *value <= 0 {
+		invalid("max_queued_requests", *value, "must be -1 or greater than 0")
+	}
+	if value := deployment.GracefulShutdownWaitLoopS; value != nil && *value < 0 {
+		invalid("graceful_shutdown_wait_loop_s", *value, "must be greater than or equal to 0")
+	}
+	if value := deployment.GracefulShutdownTimeoutS; value != nil && *value < 0 {
+		invalid("graceful_shutdown_timeout_s", *value, "must be greater than or equal to 0")
+	}
+	if value := deployment.HealthCheckPeriodS; value != nil && *value <= 0 {
+		invalid("health_check_period_s", *value, "must be greater than 0")
+	}
+	if value := deployment.HealthCheckTimeoutS; value != nil && *value <= 0 {
+		invalid("health_check_timeout_s", *value, "must be greater than 0")
+	}
+	if value := deployment.MaxReplicasPerNode; value != nil && (*value < 1 || *value > 100) {
+		invalid("max_replicas_per_node", *value, "must be between 1 and 100")
+	}
+
+	if config := deployment.AutoscalingConfig; config != nil {
+		if config.MinReplicas != nil && *config.MinReplicas < 0 {
+			invalid("autoscaling_config", *config.MinReplicas, "min_replicas must be greater than or equal to 0")
+		}
+		if config.MaxReplicas != nil && *config.MaxReplicas < 1 {
+			invalid("autoscaling_config", *config.MaxReplicas, "max_replicas must be greater than 0")
+		}
+		if config.MinReplicas != nil && config.MaxReplicas != nil && *config.MinReplicas > *config.MaxReplicas {
+			invalid("autoscaling_config", *config.MinReplicas, "min_replicas must not be greater than max_replicas")
+		}
+		if config.InitialReplicas != nil && ((config.MinReplicas != nil && *config.InitialReplicas < *config.MinReplicas) ||
+			(config.MaxReplicas != nil && *config.InitialReplicas > *config.MaxReplicas)) {
+			invalid("autoscaling_config", *config.InitialReplicas, "initial_replicas must be between min_replicas and max_replicas")
+		}
+		if config.TargetOngoingRequests != nil && *config.TargetOngoingRequests <= 0 {
+			invalid("autoscaling_config", *config.TargetOngoingRequests, "target_ongoing_requests must be greater than 0")
+		}
+	}
+
+	if options := deployment.RayActorOptions; options != nil {
+		for name, value := range map[string]*float64{
+			"num_cpus":            options.NumCPUs,
+			"num_gpus":            options.NumGPUs,
+			"memory":              options.Memory,
+			"object_store_memory": options.ObjectStoreMemory,
+		} {
+			if value != nil && *value < 0 {
+				invalid("ray_actor_options", *value, name+" must be greater than or equal to 0")
+			}
+		}
+	}
+	return allErrs
+}
+
+// isValidServeImportPath returns whether the import path has the form "{module}.{attr}" or "{module}:{attr}".
+func isValidServeImportPath(importPath string) bool {
+	if importPath == "" || strings.ContainsAny(importPath, " \t\n") {
+		return false
+	}
+	module, attr, found := strings.Cut(importPath, ":")
+	if !found {
+		index := strings.LastIndex(importPath, ".")
+		if index < 0 {
+			return false
+		}
+		module, attr = importPath[:index], importPath[index+1:]
+	}
+	return module != "" && attr != "" && !strings.Contains(attr, ":")
+}
@@ -0,0 +1,326 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestValidateServeConfigV2(t *testing.T) {
+	tests := map[string]struct {
+		serveConfigV2     string
+		serveConfigV2From *corev1.ConfigMapKeySelector
+		expectedErrors    []string
+		expectWarning     bool
+	}{
+		"empty config": {
+			serveConfigV2: "",
+		},
+		"valid config": {
+			serveConfigV2: `
+proxy_location: EveryNode
+http_options:
+  host: 0.0.0.0
+  port: 8000
+grpc_options:
+  port: 9000
+applications:
+  - name: fruit_app
+    import_path: fruit.deployment_graph
+    route_prefix: /fruit
+    deployments:
+      - name: MangoStand
+        num_replicas: 1
+        user_config:
+          price: 3
+        ray_actor_options:
+          num_cpus: 0.1
+      - name: PearStand
+        num_replicas: auto
+        max_ongoing_requests: 5
+        max_queued_requests: -1
+  - name: math_app
+    import_path: conditional_dag:serve_dag
+    route_prefix: /calc
+    deployments:
+      - name: Adder
+        autoscaling_config:
+          min_replicas: 0
+          initial_replicas: 1
+          max_replicas: 5
+          target_ongoing_requests: 2
+  - name: batch_app
+    import_path: batch.app
+    route_prefix: null
+`,
+		},
+		"invalid YAML": {
+			serveConfigV2:  "applications: [",
+			expectedErrors: []string{"failed to parse the Serve config"},
+		},
+		"type mismatch": {
+			serveConfigV2:  "applications: foo",
+			expectedErrors: []string{"failed to parse the Serve config"},
+		},
+		"unknown field is a warning": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    unknown_field: true
+`,
+			expectWarning: true,
+		},
+		"both serveConfigV2 and serveConfigV2From": {
+			serveConfigV2:     "applications: []",
+			serveConfigV2From: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "serve"}, Key: "config"},
+			expectedErrors:    []string{"serveConfigV2 and serveConfigV2From can't be set at the same time"},
+		},
+		"invalid proxy location": {
+			serveConfigV2:  "proxy_location: Everywhere",
+			expectedErrors: []string{"spec.serveConfigV2.proxy_location: Unsupported value: \"Everywhere\""},
+		},
+		"duplicate application names": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    route_prefix: /fruit
+  - name: app
+    import_path: math.deployment_graph
+    route_prefix: /calc
+`,
+			expectedErrors: []string{"spec.serveConfigV2.applications[app].name: Duplicate value: \"app\""},
+		},
+		"duplicate default application names": {
+			serveConfigV2: `
+applications:
+  - import_path: fruit.deployment_graph
+    route_prefix: /fruit
+  - import_path: math.deployment_graph
+    route_prefix: /calc
+`,
+			expectedErrors: []string{"spec.serveConfigV2.applications[default].name: Duplicate value: \"default\""},
+		},
+		"duplicate route prefixes": {
+			serveConfigV2: `
+applications:
+  - name: fruit_app
+    import_path: fruit.deployment_graph
+  - name: math_app
+    import_path: math.deployment_graph
+`,
+			expectedErrors: []string{"application \"math_app\" uses the same route_prefix as application \"fruit_app\""},
+		},
+		"invalid route prefix": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    route_prefix: fruit
+`,
+			expectedErrors: []string{"application \"app\" must have a route_prefix that starts with '/'"},
+		},
+		"missing import path": {
+			serveConfigV2: `
+applications:
+  - name: app
+`,
+			expectedErrors: []string{"application \"app\" must have an import_path"},
+		},
+		"import path without attribute": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit
+`,
+			expectedErrors: []string{"application \"app\" must have an import_path"},
+		},
+		"deployment without name": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    deployments:
+      - num_replicas: 1
+`,
+			expectedErrors: []string{"deployment of application \"app\" must have a name"},
+		},
+		"duplicate deployment names": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+      - name: MangoStand
+`,
+			expectedErrors: []string{"spec.serveConfigV2.applications[app].deployments[1].name: Duplicate value: \"MangoStand\""},
+		},
+		"invalid deployment options": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        num_replicas: -1
+        max_ongoing_requests: 0
+        max_queued_requests: 0
+        health_check_period_s: 0
+        max_replicas_per_node: 101
+        ray_actor_options:
+          num_gpus: -1
+`,
+			expectedErrors: []string{
+				"deployment \"MangoStand\" of application \"app\": must be a non-negative integer or \"auto\"",
+				"max_ongoing_requests: Invalid value: 0: deployment \"MangoStand\" of application \"app\": must be greater than 0",
+				"max_queued_requests: Invalid value: 0: deployment \"MangoStand\" of application \"app\": must be -1 or greater than 0",
+				"health_check_period_s: Invalid value: 0: deployment \"MangoStand\" of application \"app\": must be greater than 0",
+				"max_replicas_per_node: Invalid value: 101: deployment \"MangoStand\" of application \"app\": must be between 1 and 100",
+				"deployment \"MangoStand\" of application \"app\": num_gpus must be greater than or equal to 0",
+			},
+		},
+		"invalid num_replicas string": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        num_replicas: many
+`,
+			expectedErrors: []string{"deployment \"MangoStand\" of application \"app\": must be a non-negative integer or \"auto\""},
+		},
+		"num_replicas with autoscaling_config": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        num_replicas: 2
+        autoscaling_config:
+          max_replicas: 5
+`,
+			expectedErrors: []string{"can't be set to a number together with autoscaling_config"},
+		},
+		"invalid autoscaling_config": {
+			serveConfigV2: `
+applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    deployments:
+      - name: MangoStand
+        autoscaling_config:
+          min_replicas: 5
+          max_replicas: 2
+`,
+			expectedErrors: []string{"deployment \"MangoStand\" of application \"app\": min_replicas must not be greater than max_replicas"},
+		},
+		"colliding ports": {
+			serveConfigV2: `
+http_options:
+  port: 8000
+grpc_options:
+  port: 8000
+`,
+			expectedErrors: []string{"spec.serveConfigV2.grpc_options.port: Invalid value: 8000: must be different from http_options.port"},
+		},
+		"port out of range": {
+			serveConfigV2: `
+http_options:
+  port: 70000
+`,
+			expectedErrors: []string{"spec.serveConfigV2.http_options.port: Invalid value: 70000: must be between 1 and 65535"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayService := &RayService{
+				Spec: RayServiceSpec{
+					ServeConfigV2:     tc.serveConfigV2,
+					ServeConfigV2From: tc.serveConfigV2From,
+				},
+			}
+			rayService.Name = "rayservice-sample"
+
+			warnings, err := rayService.validateRayService()
+			if tc.expectWarning {
+				require.NotEmpty(t, warnings)
+			} else {
+				require.Empty(t, warnings)
+			}
+			if len(tc.expectedErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, expected := range tc.expectedErrors {
+				require.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	invalidServeConfigV2 := `
+applications:
+  - name: fruit_app
+    import_path: fruit`
+	validServeConfigV2 := `
+applications:
+  - name: fruit_app
+    import_path: fruit.deployment_graph`
+	tests := map[string]struct {
+		oldServeConfigV2 string
+		newServeConfigV2 string
+		expectError      bool
+	}{
+		"unchanged invalid config": {
+			oldServeConfigV2: invalidServeConfigV2,
+			newServeConfigV2: invalidServeConfigV2,
+		},
+		"changed to an invalid config": {
+			oldServeConfigV2: validServeConfigV2,
+			newServeConfigV2: invalidServeConfigV2,
+			expectError:      true,
+		},
+		"changed to a valid config": {
+			oldServeConfigV2: invalidServeConfigV2,
+			newServeConfigV2: validServeConfigV2,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			oldRayService := &RayService{Spec: RayServiceSpec{ServeConfigV2: tc.oldServeConfigV2}}
+			newRayService := &RayService{Spec: RayServiceSpec{ServeConfigV2: tc.newServeConfigV2}}
+			_, err := newRayService.ValidateUpdate(oldRayService)
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsValidServeImportPath(t *testing.T) {
+	for importPath, expected := range map[string]bool{
+		"fruit.deployment_graph":    true,
+		"conditional_dag:serve_dag": true,
+		"mobilenet.mobilenet:app":   true,
+		"":                          false,
+		"fruit":                     false,
+		"fruit.":                    false,
+		":app":                      false,
+		"fruit:app:extra":           false,
+		"fruit deployment.graph":    false,
+	} {
+		require.Equal(t, expected, isValidServeImportPath(importPath), importPath)
+	}
+}
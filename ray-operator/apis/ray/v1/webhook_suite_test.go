@@ -19,8 +19,10 @@ import (
 	//+kubebuilder:scaffold:imports
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
@@ -98,6 +100,9 @@ var _ = BeforeSuite(func() {
 	err = (&RayCluster{}).SetupWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = (&RayService{}).SetupWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
 	//+kubebuilder:scaffold:webhook
 
 	go func() {
@@ -204,6 +209,77 @@ var _ = Describe("RayCluster validating webhook", func() {
 			Expect(err.Error()).To(ContainSubstring("worker group names must be unique"))
 		})
 	})
+
+	Context("when the rolling update cannot make progress", func() {
+		It("should return error", func() {
+			rayCluster := RayCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      fmt.Sprintf("test-raycluster-%d", rand.IntnRange(1000, 9000)),
+				},
+				Spec: RayClusterSpec{
+					UpgradeStrategy: &RayClusterUpgradeStrategy{
+						Type: ptr.To(RollingUpdate),
+						RollingUpdate: &RollingUpdateConfig{
+							MaxSurge:       ptr.To(intstr.FromInt32(0)),
+							MaxUnavailable: ptr.To(intstr.FromString("0%")),
+						},
+					},
+					HeadGroupSpec: HeadGroupSpec{
+						RayStartParams: map[string]string{"DEADBEEF": "DEADBEEF"},
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{},
+							},
+						},
+					},
+					WorkerGroupSpecs: []WorkerGroupSpec{},
+				},
+			}
+
+			err := k8sClient.Create(context.TODO(), &rayCluster)
+			Expect(err).To(HaveOccurred())
+
+			Expect(err.Error()).To(ContainSubstring("maxSurge and maxUnavailable cannot both be 0"))
+		})
+	})
+})
+
+var _ = Describe("RayService validating webhook", func() {
+	Context("when application names are not unique", func() {
+		It("should return error", func() {
+			rayService := RayService{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      fmt.Sprintf("test-rayservice-%d", rand.IntnRange(1000, 9000)),
+				},
+				Spec: RayServiceSpec{
+					ServeConfigV2: `applications:
+  - name: app
+    import_path: fruit.deployment_graph
+    route_prefix: /fruit
+  - name: app
+    import_path: math.deployment_graph
+    route_prefix: /calc`,
+					RayClusterSpec: RayClusterSpec{
+						HeadGroupSpec: HeadGroupSpec{
+							RayStartParams: map[string]string{},
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray"}},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			err := k8sClient.Create(context.TODO(), &rayService)
+			Expect(err).To(HaveOccurred())
+
+			Expect(err.Error()).To(ContainSubstring("spec.serveConfigV2.applications[app].name: Duplicate value"))
+		})
+	})
 })
 
 var _ = AfterSuite(func() {
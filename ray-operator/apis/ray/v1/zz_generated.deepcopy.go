@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -17,6 +18,10 @@ func (in *AppStatus) DeepCopyInto(out *AppStatus) {
 		in, out := &in.HealthLastUpdateTime, &out.HealthLastUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastDeployedTime != nil {
+		in, out := &in.LastDeployedTime, &out.LastDeployedTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Deployments != nil {
 		in, out := &in.Deployments, &out.Deployments
 		*out = make(map[string]ServeDeploymentStatus, len(*in))
@@ -102,6 +107,41 @@ func (in *AutoscalerOptions) DeepCopy() *AutoscalerOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapLogRetention) DeepCopyInto(out *ConfigMapLogRetention) {
+	*out = *in
+	if in.TailBytes != nil {
+		in, out := &in.TailBytes, &out.TailBytes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapLogRetention.
+func (in *ConfigMapLogRetention) DeepCopy() *ConfigMapLogRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapLogRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeletionPolicy) DeepCopyInto(out *DeletionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionPolicy.
+func (in *DeletionPolicy) DeepCopy() *DeletionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HeadGroupSpec) DeepCopyInto(out *HeadGroupSpec) {
 	*out = *in
@@ -150,6 +190,71 @@ func (in *HeadInfo) DeepCopy() *HeadInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncrementalUpgradeOptions) DeepCopyInto(out *IncrementalUpgradeOptions) {
+	*out = *in
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TrafficSteps != nil {
+		in, out := &in.TrafficSteps, &out.TrafficSteps
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncrementalUpgradeOptions.
+func (in *IncrementalUpgradeOptions) DeepCopy() *IncrementalUpgradeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(IncrementalUpgradeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogRetention) DeepCopyInto(out *LogRetention) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(ConfigMapLogRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(PersistentVolumeClaimLogRetention)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogRetention.
+func (in *LogRetention) DeepCopy() *LogRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(LogRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimLogRetention) DeepCopyInto(out *PersistentVolumeClaimLogRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimLogRetention.
+func (in *PersistentVolumeClaimLogRetention) DeepCopy() *PersistentVolumeClaimLogRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimLogRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RayCluster) DeepCopyInto(out *RayCluster) {
 	*out = *in
@@ -159,50 +264,602 @@ func (in *RayCluster) DeepCopyInto(out *RayCluster) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCluster.
-func (in *RayCluster) DeepCopy() *RayCluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCluster.
+func (in *RayCluster) DeepCopy() *RayCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(RayCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterList) DeepCopyInto(out *RayClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RayCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterList.
+func (in *RayClusterList) DeepCopy() *RayClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterPool) DeepCopyInto(out *RayClusterPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterPool.
+func (in *RayClusterPool) DeepCopy() *RayClusterPool {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayClusterPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterPoolList) DeepCopyInto(out *RayClusterPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RayClusterPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterPoolList.
+func (in *RayClusterPoolList) DeepCopy() *RayClusterPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayClusterPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterPoolSpec) DeepCopyInto(out *RayClusterPoolSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterPoolSpec.
+func (in *RayClusterPoolSpec) DeepCopy() *RayClusterPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterPoolStatus) DeepCopyInto(out *RayClusterPoolStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterPoolStatus.
+func (in *RayClusterPoolStatus) DeepCopy() *RayClusterPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterSpec) DeepCopyInto(out *RayClusterSpec) {
+	*out = *in
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutoscalerOptions != nil {
+		in, out := &in.AutoscalerOptions, &out.AutoscalerOptions
+		*out = new(AutoscalerOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HeadServiceAnnotations != nil {
+		in, out := &in.HeadServiceAnnotations, &out.HeadServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EnableInTreeAutoscaling != nil {
+		in, out := &in.EnableInTreeAutoscaling, &out.EnableInTreeAutoscaling
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(RayClusterUpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	in.HeadGroupSpec.DeepCopyInto(&out.HeadGroupSpec)
+	if in.WorkerGroupSpecs != nil {
+		in, out := &in.WorkerGroupSpecs, &out.WorkerGroupSpecs
+		*out = make([]WorkerGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterSpec.
+func (in *RayClusterSpec) DeepCopy() *RayClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterStatus) DeepCopyInto(out *RayClusterStatus) {
+	*out = *in
+	out.DesiredCPU = in.DesiredCPU.DeepCopy()
+	out.DesiredMemory = in.DesiredMemory.DeepCopy()
+	out.DesiredGPU = in.DesiredGPU.DeepCopy()
+	out.DesiredTPU = in.DesiredTPU.DeepCopy()
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastHeadRestartTime != nil {
+		in, out := &in.LastHeadRestartTime, &out.LastHeadRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StateTransitionTimes != nil {
+		in, out := &in.StateTransitionTimes, &out.StateTransitionTimes
+		*out = make(map[ClusterState]*metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Head = in.Head
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkerGroupStatuses != nil {
+		in, out := &in.WorkerGroupStatuses, &out.WorkerGroupStatuses
+		*out = make([]WorkerGroupStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterStatus.
+func (in *RayClusterStatus) DeepCopy() *RayClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayClusterUpgradeStrategy) DeepCopyInto(out *RayClusterUpgradeStrategy) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(RayClusterUpgradeType)
+		**out = **in
+	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdateConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterUpgradeStrategy.
+func (in *RayClusterUpgradeStrategy) DeepCopy() *RayClusterUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RayClusterUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayCronJob) DeepCopyInto(out *RayCronJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCronJob.
+func (in *RayCronJob) DeepCopy() *RayCronJob {
+	if in == nil {
+		return nil
+	}
+	out := new(RayCronJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayCronJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayCronJobList) DeepCopyInto(out *RayCronJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RayCronJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCronJobList.
+func (in *RayCronJobList) DeepCopy() *RayCronJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(RayCronJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayCronJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayCronJobSpec) DeepCopyInto(out *RayCronJobSpec) {
+	*out = *in
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SuccessfulJobsHistoryLimit != nil {
+		in, out := &in.SuccessfulJobsHistoryLimit, &out.SuccessfulJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailedJobsHistoryLimit != nil {
+		in, out := &in.FailedJobsHistoryLimit, &out.FailedJobsHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	in.JobTemplate.DeepCopyInto(&out.JobTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCronJobSpec.
+func (in *RayCronJobSpec) DeepCopy() *RayCronJobSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RayCronJobSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayCronJobStatus) DeepCopyInto(out *RayCronJobStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayCronJobStatus.
+func (in *RayCronJobStatus) DeepCopy() *RayCronJobStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RayCronJobStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJob) DeepCopyInto(out *RayJob) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJob.
+func (in *RayJob) DeepCopy() *RayJob {
+	if in == nil {
+		return nil
+	}
+	out := new(RayJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayJob) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJobAttempt) DeepCopyInto(out *RayJobAttempt) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobAttempt.
+func (in *RayJobAttempt) DeepCopy() *RayJobAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(RayJobAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJobDependency) DeepCopyInto(out *RayJobDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobDependency.
+func (in *RayJobDependency) DeepCopy() *RayJobDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(RayJobDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJobList) DeepCopyInto(out *RayJobList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RayJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobList.
+func (in *RayJobList) DeepCopy() *RayJobList {
+	if in == nil {
+		return nil
+	}
+	out := new(RayJobList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayJobList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJobLogLocation) DeepCopyInto(out *RayJobLogLocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobLogLocation.
+func (in *RayJobLogLocation) DeepCopy() *RayJobLogLocation {
+	if in == nil {
+		return nil
+	}
+	out := new(RayJobLogLocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJobSet) DeepCopyInto(out *RayJobSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSet.
+func (in *RayJobSet) DeepCopy() *RayJobSet {
+	if in == nil {
+		return nil
+	}
+	out := new(RayJobSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RayJobSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJobSetFailurePolicy) DeepCopyInto(out *RayJobSetFailurePolicy) {
+	*out = *in
+	if in.FailedJobs != nil {
+		in, out := &in.FailedJobs, &out.FailedJobs
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSetFailurePolicy.
+func (in *RayJobSetFailurePolicy) DeepCopy() *RayJobSetFailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RayJobSetFailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RayJobSetJobStatus) DeepCopyInto(out *RayJobSetJobStatus) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSetJobStatus.
+func (in *RayJobSetJobStatus) DeepCopy() *RayJobSetJobStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RayCluster)
+	out := new(RayJobSetJobStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RayCluster) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RayClusterList) DeepCopyInto(out *RayClusterList) {
+func (in *RayJobSetList) DeepCopyInto(out *RayJobSetList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RayCluster, len(*in))
+		*out = make([]RayJobSet, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterList.
-func (in *RayClusterList) DeepCopy() *RayClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSetList.
+func (in *RayJobSetList) DeepCopy() *RayJobSetList {
 	if in == nil {
 		return nil
 	}
-	out := new(RayClusterList)
+	out := new(RayJobSetList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RayClusterList) DeepCopyObject() runtime.Object {
+func (in *RayJobSetList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -210,154 +867,131 @@ func (in *RayClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RayClusterSpec) DeepCopyInto(out *RayClusterSpec) {
+func (in *RayJobSetParameters) DeepCopyInto(out *RayJobSetParameters) {
 	*out = *in
-	if in.Suspend != nil {
-		in, out := &in.Suspend, &out.Suspend
-		*out = new(bool)
-		**out = **in
-	}
-	if in.AutoscalerOptions != nil {
-		in, out := &in.AutoscalerOptions, &out.AutoscalerOptions
-		*out = new(AutoscalerOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.HeadServiceAnnotations != nil {
-		in, out := &in.HeadServiceAnnotations, &out.HeadServiceAnnotations
-		*out = make(map[string]string, len(*in))
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = make(map[string][]string, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
 		}
 	}
-	if in.EnableInTreeAutoscaling != nil {
-		in, out := &in.EnableInTreeAutoscaling, &out.EnableInTreeAutoscaling
-		*out = new(bool)
-		**out = **in
-	}
-	in.HeadGroupSpec.DeepCopyInto(&out.HeadGroupSpec)
-	if in.WorkerGroupSpecs != nil {
-		in, out := &in.WorkerGroupSpecs, &out.WorkerGroupSpecs
-		*out = make([]WorkerGroupSpec, len(*in))
+	if in.List != nil {
+		in, out := &in.List, &out.List
+		*out = make([]map[string]string, len(*in))
 		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterSpec.
-func (in *RayClusterSpec) DeepCopy() *RayClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSetParameters.
+func (in *RayJobSetParameters) DeepCopy() *RayJobSetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RayClusterSpec)
+	out := new(RayJobSetParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RayClusterStatus) DeepCopyInto(out *RayClusterStatus) {
+func (in *RayJobSetSpec) DeepCopyInto(out *RayJobSetSpec) {
 	*out = *in
-	out.DesiredCPU = in.DesiredCPU.DeepCopy()
-	out.DesiredMemory = in.DesiredMemory.DeepCopy()
-	out.DesiredGPU = in.DesiredGPU.DeepCopy()
-	out.DesiredTPU = in.DesiredTPU.DeepCopy()
-	if in.LastUpdateTime != nil {
-		in, out := &in.LastUpdateTime, &out.LastUpdateTime
-		*out = (*in).DeepCopy()
-	}
-	if in.StateTransitionTimes != nil {
-		in, out := &in.StateTransitionTimes, &out.StateTransitionTimes
-		*out = make(map[ClusterState]*metav1.Time, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Endpoints != nil {
-		in, out := &in.Endpoints, &out.Endpoints
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.SuccessPolicy != nil {
+		in, out := &in.SuccessPolicy, &out.SuccessPolicy
+		*out = new(RayJobSetSuccessPolicy)
+		(*in).DeepCopyInto(*out)
 	}
-	out.Head = in.Head
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(RayJobSetFailurePolicy)
+		(*in).DeepCopyInto(*out)
 	}
+	in.Parameters.DeepCopyInto(&out.Parameters)
+	in.Template.DeepCopyInto(&out.Template)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayClusterStatus.
-func (in *RayClusterStatus) DeepCopy() *RayClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSetSpec.
+func (in *RayJobSetSpec) DeepCopy() *RayJobSetSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RayClusterStatus)
+	out := new(RayJobSetSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RayJob) DeepCopyInto(out *RayJob) {
+func (in *RayJobSetStatus) DeepCopyInto(out *RayJobSetStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]RayJobSetJobStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJob.
-func (in *RayJob) DeepCopy() *RayJob {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSetStatus.
+func (in *RayJobSetStatus) DeepCopy() *RayJobSetStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RayJob)
+	out := new(RayJobSetStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RayJob) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RayJobList) DeepCopyInto(out *RayJobList) {
+func (in *RayJobSetSuccessPolicy) DeepCopyInto(out *RayJobSetSuccessPolicy) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]RayJob, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.SucceededJobs != nil {
+		in, out := &in.SucceededJobs, &out.SucceededJobs
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobList.
-func (in *RayJobList) DeepCopy() *RayJobList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSetSuccessPolicy.
+func (in *RayJobSetSuccessPolicy) DeepCopy() *RayJobSetSuccessPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(RayJobList)
+	out := new(RayJobSetSuccessPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RayJobList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RayJobSpec) DeepCopyInto(out *RayJobSpec) {
 	*out = *in
@@ -366,11 +1000,26 @@ func (in *RayJobSpec) DeepCopyInto(out *RayJobSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.InitializationDeadlineSeconds != nil {
+		in, out := &in.InitializationDeadlineSeconds, &out.InitializationDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SuspendGracePeriodSeconds != nil {
+		in, out := &in.SuspendGracePeriodSeconds, &out.SuspendGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.BackoffLimit != nil {
 		in, out := &in.BackoffLimit, &out.BackoffLimit
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RayJobRetryPolicy)
+		**out = **in
+	}
 	if in.RayClusterSpec != nil {
 		in, out := &in.RayClusterSpec, &out.RayClusterSpec
 		*out = new(RayClusterSpec)
@@ -395,11 +1044,41 @@ func (in *RayJobSpec) DeepCopyInto(out *RayJobSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ClusterPoolSelector != nil {
+		in, out := &in.ClusterPoolSelector, &out.ClusterPoolSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConcurrentJobsPerCluster != nil {
+		in, out := &in.MaxConcurrentJobsPerCluster, &out.MaxConcurrentJobsPerCluster
+		*out = new(int32)
+		**out = **in
+	}
 	if in.SubmitterConfig != nil {
 		in, out := &in.SubmitterConfig, &out.SubmitterConfig
 		*out = new(SubmitterConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LogRetention != nil {
+		in, out := &in.LogRetention, &out.LogRetention
+		*out = new(LogRetention)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
+	if in.RuntimeEnvFrom != nil {
+		in, out := &in.RuntimeEnvFrom, &out.RuntimeEnvFrom
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]RayJobDependency, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RayJobSpec.
@@ -415,6 +1094,20 @@ func (in *RayJobSpec) DeepCopy() *RayJobSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RayJobStatus) DeepCopyInto(out *RayJobStatus) {
 	*out = *in
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]RayJobAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.StartTime != nil {
 		in, out := &in.StartTime, &out.StartTime
 		*out = (*in).DeepCopy()
@@ -423,6 +1116,15 @@ func (in *RayJobStatus) DeepCopyInto(out *RayJobStatus) {
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.SuspendStartTime != nil {
+		in, out := &in.SuspendStartTime, &out.SuspendStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LogLocation != nil {
+		in, out := &in.LogLocation, &out.LogLocation
+		*out = new(RayJobLogLocation)
+		**out = **in
+	}
 	if in.Succeeded != nil {
 		in, out := &in.Succeeded, &out.Succeeded
 		*out = new(int32)
@@ -528,6 +1230,26 @@ func (in *RayServiceSpec) DeepCopyInto(out *RayServiceSpec) {
 		*out = new(RayServiceUpgradeStrategy)
 		**out = **in
 	}
+	if in.IncrementalUpgradeOptions != nil {
+		in, out := &in.IncrementalUpgradeOptions, &out.IncrementalUpgradeOptions
+		*out = new(IncrementalUpgradeOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkerGroupUpgradeStrategy != nil {
+		in, out := &in.WorkerGroupUpgradeStrategy, &out.WorkerGroupUpgradeStrategy
+		*out = new(RayServiceWorkerGroupUpgradeStrategy)
+		**out = **in
+	}
+	if in.UpgradeTimeoutSeconds != nil {
+		in, out := &in.UpgradeTimeoutSeconds, &out.UpgradeTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ServeConfigV2From != nil {
+		in, out := &in.ServeConfigV2From, &out.ServeConfigV2From
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	in.RayClusterSpec.DeepCopyInto(&out.RayClusterSpec)
 }
 
@@ -551,6 +1273,20 @@ func (in *RayServiceStatus) DeepCopyInto(out *RayServiceStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.TrafficRoutedPercent != nil {
+		in, out := &in.TrafficRoutedPercent, &out.TrafficRoutedPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetTrafficPercent != nil {
+		in, out := &in.TargetTrafficPercent, &out.TargetTrafficPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LastTrafficMigratedTime != nil {
+		in, out := &in.LastTrafficMigratedTime, &out.LastTrafficMigratedTime
+		*out = (*in).DeepCopy()
+	}
 	in.RayClusterStatus.DeepCopyInto(&out.RayClusterStatus)
 }
 
@@ -571,6 +1307,20 @@ func (in *RayServiceStatuses) DeepCopyInto(out *RayServiceStatuses) {
 		in, out := &in.LastUpdateTime, &out.LastUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RayClusterDeletionTimestamps != nil {
+		in, out := &in.RayClusterDeletionTimestamps, &out.RayClusterDeletionTimestamps
+		*out = make(map[string]metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	in.ActiveServiceStatus.DeepCopyInto(&out.ActiveServiceStatus)
 	in.PendingServiceStatus.DeepCopyInto(&out.PendingServiceStatus)
 }
@@ -585,9 +1335,44 @@ func (in *RayServiceStatuses) DeepCopy() *RayServiceStatuses {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdateConfig) DeepCopyInto(out *RollingUpdateConfig) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateConfig.
+func (in *RollingUpdateConfig) DeepCopy() *RollingUpdateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleStrategy) DeepCopyInto(out *ScaleStrategy) {
 	*out = *in
+	if in.DrainGracePeriodSeconds != nil {
+		in, out := &in.DrainGracePeriodSeconds, &out.DrainGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.VictimSelectionPolicy != nil {
+		in, out := &in.VictimSelectionPolicy, &out.VictimSelectionPolicy
+		*out = new(VictimSelectionPolicy)
+		**out = **in
+	}
 	if in.WorkersToDelete != nil {
 		in, out := &in.WorkersToDelete, &out.WorkersToDelete
 		*out = make([]string, len(*in))
@@ -605,6 +1390,21 @@ func (in *ScaleStrategy) DeepCopy() *ScaleStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServeDeploymentAutoscalingStatus) DeepCopyInto(out *ServeDeploymentAutoscalingStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServeDeploymentAutoscalingStatus.
+func (in *ServeDeploymentAutoscalingStatus) DeepCopy() *ServeDeploymentAutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServeDeploymentAutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServeDeploymentStatus) DeepCopyInto(out *ServeDeploymentStatus) {
 	*out = *in
@@ -612,6 +1412,28 @@ func (in *ServeDeploymentStatus) DeepCopyInto(out *ServeDeploymentStatus) {
 		in, out := &in.HealthLastUpdateTime, &out.HealthLastUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(ServeDeploymentAutoscalingStatus)
+		**out = **in
+	}
+	if in.ReplicaStates != nil {
+		in, out := &in.ReplicaStates, &out.ReplicaStates
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TargetReplicas != nil {
+		in, out := &in.TargetReplicas, &out.TargetReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RunningReplicas != nil {
+		in, out := &in.RunningReplicas, &out.RunningReplicas
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServeDeploymentStatus.
@@ -682,3 +1504,26 @@ func (in *WorkerGroupSpec) DeepCopy() *WorkerGroupSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerGroupStatus) DeepCopyInto(out *WorkerGroupStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	out.DesiredCPU = in.DesiredCPU.DeepCopy()
+	out.DesiredMemory = in.DesiredMemory.DeepCopy()
+	out.DesiredGPU = in.DesiredGPU.DeepCopy()
+	out.DesiredTPU = in.DesiredTPU.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerGroupStatus.
+func (in *WorkerGroupStatus) DeepCopy() *WorkerGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -129,6 +129,42 @@ func RayServiceRayClustersAssociationOptions(rayService *rayv1.RayService) Assoc
 	}
 }
 
+// RayCronJobRayJobsAssociationOptions selects the RayJobs created by the RayCronJob. The caller should still check the
+// controller reference of each RayJob, because a RayJob with the same labels may be created by users.
+func RayCronJobRayJobsAssociationOptions(rayCronJob *rayv1.RayCronJob) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(rayCronJob.Namespace),
+		client.MatchingLabels{
+			utils.RayOriginatedFromCRNameLabelKey: rayCronJob.Name,
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayCronJobCRD),
+		},
+	}
+}
+
+// RayJobSetRayJobsAssociationOptions selects the child RayJobs of the RayJobSet. The caller should still check the
+// controller reference of each RayJob.
+func RayJobSetRayJobsAssociationOptions(rayJobSet *rayv1.RayJobSet) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(rayJobSet.Namespace),
+		client.MatchingLabels{
+			utils.RayOriginatedFromCRNameLabelKey: rayJobSet.Name,
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayJobSetCRD),
+		},
+	}
+}
+
+// RayClusterPoolRayClustersAssociationOptions selects the RayClusters of the RayClusterPool. The caller should still
+// check the controller reference of each RayCluster.
+func RayClusterPoolRayClustersAssociationOptions(rayClusterPool *rayv1.RayClusterPool) AssociationOptions {
+	return AssociationOptions{
+		client.InNamespace(rayClusterPool.Namespace),
+		client.MatchingLabels{
+			utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+			utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+		},
+	}
+}
+
 func RayServiceServeServiceNamespacedName(rayService *rayv1.RayService) types.NamespacedName {
 	if rayService.Spec.ServeService != nil && rayService.Spec.ServeService.Name != "" {
 		return types.NamespacedName{
@@ -36,6 +36,13 @@ var (
 		},
 		[]string{"namespace"},
 	)
+	clusterHeadRestartsCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ray_operator_cluster_head_restarts_total",
+			Help: "Counts number of head Pods restarted after they failed",
+		},
+		[]string{"namespace"},
+	)
 )
 
 func init() {
@@ -43,7 +50,8 @@ func init() {
 	metrics.Registry.MustRegister(clustersCreatedCount,
 		clustersDeletedCount,
 		clustersSuccessfulCount,
-		clustersFailedCount)
+		clustersFailedCount,
+		clusterHeadRestartsCount)
 }
 
 func CreatedClustersCounterInc(namespace string) {
@@ -62,3 +70,7 @@ func SuccessfulClustersCounterInc(namespace string) {
 func FailedClustersCounterInc(namespace string) {
 	clustersFailedCount.WithLabelValues(namespace).Inc()
 }
+
+func HeadRestartsCounterInc(namespace string) {
+	clusterHeadRestartsCount.WithLabelValues(namespace).Inc()
+}
@@ -200,6 +200,12 @@ func BuildServeService(ctx context.Context, rayService rayv1.RayService, rayClus
 	}
 	if isRayService {
 		selectorLabels[utils.RayClusterServingServiceLabelKey] = utils.EnableRayClusterServingServiceTrue
+		// With the IncrementalUpgrade strategy, the serve Service selects the labeled serving Pods of both the active
+		// and the pending RayClusters, so that the traffic can be split between them.
+		if utils.IsIncrementalUpgradeEnabled(&rayService.Spec) {
+			delete(selectorLabels, utils.RayClusterLabelKey)
+			selectorLabels[utils.RayServiceTrafficLabelKey] = utils.CheckLabel(rayService.Name)
+		}
 	}
 
 	defaultName := utils.GenerateServeServiceName(name)
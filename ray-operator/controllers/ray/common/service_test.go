@@ -525,6 +525,21 @@ func TestBuildServeServiceForRayService(t *testing.T) {
 	validateNameAndNamespaceForUserSpecifiedService(svc, serviceInstance.ObjectMeta.Namespace, expectedName, t)
 }
 
+func TestBuildServeServiceForRayService_IncrementalUpgrade(t *testing.T) {
+	rayService := serviceInstance.DeepCopy()
+	upgradeStrategy := rayv1.IncrementalUpgrade
+	rayService.Spec.UpgradeStrategy = &upgradeStrategy
+	svc, err := BuildServeServiceForRayService(context.Background(), *rayService, *instanceWithWrongSvc)
+	assert.Nil(t, err)
+
+	// The serve service selects the serving Pods of all the RayClusters that are labeled to receive the traffic.
+	expectedSelector := map[string]string{
+		utils.RayClusterServingServiceLabelKey: utils.EnableRayClusterServingServiceTrue,
+		utils.RayServiceTrafficLabelKey:        rayService.Name,
+	}
+	assert.Equal(t, expectedSelector, svc.Spec.Selector)
+}
+
 func TestBuildServeServiceForRayCluster(t *testing.T) {
 	svc, err := BuildServeServiceForRayCluster(context.Background(), *instanceForSvc)
 	assert.Nil(t, err)
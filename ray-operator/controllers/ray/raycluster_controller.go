@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -35,9 +36,11 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,6 +56,10 @@ type reconcileFunc func(context.Context, *rayv1.RayCluster) error
 var (
 	DefaultRequeueDuration = 2 * time.Second
 
+	// errWaitForWorkerPods is wrapped by the errors that reconcilePods returns while worker Pods are draining or
+	// waiting to be deleted. It isn't a reconciler error: the RayCluster is requeued after DefaultRequeueDuration.
+	errWaitForWorkerPods = errstd.New("waiting for worker Pods")
+
 	// Definition of a index field for pod name
 	podUIDIndexField = "metadata.uid"
 )
@@ -126,6 +133,8 @@ func NewReconciler(ctx context.Context, mgr manager.Manager, options RayClusterR
 
 		headSidecarContainers:   options.HeadSidecarContainers,
 		workerSidecarContainers: options.WorkerSidecarContainers,
+		dashboardClientFunc:     rayConfigs.GetDashboardClient(mgr),
+		gcsClient:               utils.NewRayGcsClient(),
 	}
 }
 
@@ -138,6 +147,8 @@ type RayClusterReconciler struct {
 	Recorder          record.EventRecorder
 	BatchSchedulerMgr *batchscheduler.SchedulerManager
 
+	dashboardClientFunc     func() utils.RayDashboardClientInterface
+	gcsClient               utils.RayGcsClientInterface
 	headSidecarContainers   []corev1.Container
 	workerSidecarContainers []corev1.Container
 
@@ -351,8 +362,15 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 		r.reconcilePods,
 	}
 
+	waitForWorkerPods := false
 	for _, fn := range reconcileFuncs {
 		if reconcileErr = fn(ctx, instance); reconcileErr != nil {
+			if errstd.Is(reconcileErr, errWaitForWorkerPods) {
+				logger.Info("Requeue the RayCluster", "reason", reconcileErr.Error())
+				reconcileErr = nil
+				waitForWorkerPods = true
+				break
+			}
 			funcName := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
 			logger.Error(reconcileErr, "Error reconcile resources", "function name", funcName)
 			break
@@ -381,7 +399,7 @@ func (r *RayClusterReconciler) rayClusterReconcile(ctx context.Context, instance
 	// If the custom resource's status is updated, requeue the reconcile key.
 	// Without this behavior, atomic operations such as the suspend operation would need to wait for `RAYCLUSTER_DEFAULT_REQUEUE_SECONDS` to delete Pods
 	// after the condition rayv1.RayClusterSuspending is set to true.
-	if err != nil || inconsistent {
+	if err != nil || inconsistent || waitForWorkerPods {
 		return ctrl.Result{RequeueAfter: DefaultRequeueDuration}, err
 	}
 
@@ -450,6 +468,21 @@ func (r *RayClusterReconciler) inconsistentRayClusterStatus(ctx context.Context,
 		)
 		return true
 	}
+	if oldStatus.HeadRestarts != newStatus.HeadRestarts || !reflect.DeepEqual(oldStatus.LastHeadRestartTime, newStatus.LastHeadRestartTime) {
+		logger.Info(
+			"inconsistentRayClusterStatus",
+			"oldHeadRestarts", oldStatus.HeadRestarts,
+			"newHeadRestarts", newStatus.HeadRestarts,
+			"oldLastHeadRestartTime", oldStatus.LastHeadRestartTime,
+			"newLastHeadRestartTime", newStatus.LastHeadRestartTime,
+		)
+		return true
+	}
+	// The worker group statuses hold resource quantities, which compare by value only with semantic equality.
+	if !equality.Semantic.DeepEqual(oldStatus.WorkerGroupStatuses, newStatus.WorkerGroupStatuses) {
+		logger.Info("inconsistentRayClusterStatus", "oldWorkerGroupStatuses", oldStatus.WorkerGroupStatuses, "newWorkerGroupStatuses", newStatus.WorkerGroupStatuses)
+		return true
+	}
 	if !reflect.DeepEqual(oldStatus.Conditions, newStatus.Conditions) {
 		logger.Info("inconsistentRayClusterStatus", "old conditions", oldStatus.Conditions, "new conditions", newStatus.Conditions)
 		return true
@@ -659,6 +692,14 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 	statusConditionGateEnabled := features.Enabled(features.RayClusterStatusConditions)
 	if suspendStatus == rayv1.RayClusterSuspending ||
 		(!statusConditionGateEnabled && instance.Spec.Suspend != nil && *instance.Spec.Suspend) {
+		// Drain the Ray nodes of the worker groups with a drain grace period before deleting any Pod.
+		numDrainingWorkerPods, err := r.drainWorkerPodsForSuspension(ctx, instance)
+		if err != nil {
+			return err
+		}
+		if numDrainingWorkerPods > 0 {
+			return fmt.Errorf("%w: %d worker Pods are draining before suspending the RayCluster", errWaitForWorkerPods, numDrainingWorkerPods)
+		}
 		if _, err := r.deleteAllPods(ctx, common.RayClusterAllPodsAssociationOptions(instance)); err != nil {
 			r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeletePod),
 				"Failed deleting Pods due to suspension for RayCluster %s/%s, %v",
@@ -710,6 +751,13 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		shouldDelete, reason := shouldDeletePod(headPod, rayv1.HeadNode)
 		logger.Info("reconcilePods", "head Pod", headPod.Name, "shouldDelete", shouldDelete, "reason", reason)
 		if shouldDelete {
+			// Back off before restarting a head Pod that keeps failing shortly after it was restarted.
+			if lastRestartTime := instance.Status.LastHeadRestartTime; lastRestartTime != nil {
+				if backoff := time.Until(lastRestartTime.Add(getHeadRestartBackoff(instance.Status.HeadRestarts))); backoff > 0 {
+					logger.Info("reconcilePods", "Back off before restarting the head Pod", headPod.Name, "backoff", backoff, "head restarts", instance.Status.HeadRestarts)
+					return fmt.Errorf("wait %s before restarting the head Pod %s", backoff.Round(time.Second), headPod.Name)
+				}
+			}
 			if err := r.Delete(ctx, &headPod); err != nil {
 				r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteHeadPod),
 					"Failed deleting head Pod %s/%s; Pod status: %s; Pod restart policy: %s; Ray container terminated status: %v, %v",
@@ -719,6 +767,21 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedHeadPod),
 				"Deleted head Pod %s/%s; Pod status: %s; Pod restart policy: %s; Ray container terminated status: %v",
 				headPod.Namespace, headPod.Name, headPod.Status.Phase, headPod.Spec.RestartPolicy, getRayContainerStateTerminated(headPod))
+			// With GCS fault tolerance, the worker Pods reconnect to the new head Pod. Otherwise, the cluster state is
+			// lost with the head Pod, and the worker Pods are restarted to join the new cluster.
+			if !common.IsGCSFaultToleranceEnabled(*instance) {
+				if _, err := r.deleteAllPods(ctx, common.RayClusterWorkerPodsAssociationOptions(instance)); err != nil {
+					r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteWorkerPod),
+						"Failed deleting worker Pods after restarting the head Pod of RayCluster %s/%s, %v", instance.Namespace, instance.Name, err)
+					return errstd.Join(utils.ErrFailedDeleteWorkerPod, err)
+				}
+				r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedWorkerPod),
+					"Deleted worker Pods of RayCluster %s/%s because the head Pod was restarted without GCS fault tolerance", instance.Namespace, instance.Name)
+			}
+			now := metav1.Now()
+			instance.Status.HeadRestarts++
+			instance.Status.LastHeadRestartTime = &now
+			common.HeadRestartsCounterInc(instance.Namespace)
 			return errstd.New(reason)
 		}
 	} else if len(headPods.Items) == 0 {
@@ -750,7 +813,16 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 		}
 	}
 
+	// Delete the worker Pods of the worker groups that were removed from the spec if an upgrade strategy is configured.
+	if upgradeStrategy := instance.Spec.UpgradeStrategy; upgradeStrategy != nil && upgradeStrategy.Type != nil {
+		if err := r.deleteRemovedWorkerGroupPods(ctx, instance); err != nil {
+			return err
+		}
+	}
+
 	// Reconcile worker pods now
+	numDrainingWorkerPods := 0
+	numOutdatedWorkerPods := 0
 	for _, worker := range instance.Spec.WorkerGroupSpecs {
 		// workerReplicas will store the target number of pods for this worker group.
 		var workerReplicas int32 = utils.GetWorkerGroupDesiredReplicas(ctx, worker)
@@ -761,14 +833,23 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			return err
 		}
 
-		// Delete unhealthy worker Pods.
+		// Delete unhealthy worker Pods. The hosts of a multi-host replica are recreated together if any of them is unhealthy.
 		deletedWorkers := make(map[string]struct{})
 		deleted := struct{}{}
-		numDeletedUnhealthyWorkerPods := 0
+		unhealthyWorkers := []string{}
 		for _, workerPod := range workerPods.Items {
 			shouldDelete, reason := shouldDeletePod(workerPod, rayv1.WorkerNode)
 			logger.Info("reconcilePods", "worker Pod", workerPod.Name, "shouldDelete", shouldDelete, "reason", reason)
 			if shouldDelete {
+				unhealthyWorkers = append(unhealthyWorkers, workerPod.Name)
+			}
+		}
+		if worker.NumOfHosts > 1 {
+			unhealthyWorkers = expandToWorkerReplicas(unhealthyWorkers, workerPods.Items, worker.NumOfHosts)
+		}
+		numDeletedUnhealthyWorkerPods := 0
+		for _, workerPod := range workerPods.Items {
+			if slices.Contains(unhealthyWorkers, workerPod.Name) {
 				numDeletedUnhealthyWorkerPods++
 				deletedWorkers[workerPod.Name] = deleted
 				if err := r.Delete(ctx, &workerPod); err != nil {
@@ -788,13 +869,53 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			return fmt.Errorf("delete %d unhealthy worker Pods", numDeletedUnhealthyWorkerPods)
 		}
 
+		// Finish the deletion of the worker Pods that started draining in a previous reconciliation. These Pods
+		// are no longer counted as running Pods, even if the drain grace period has been removed in the meantime.
+		for _, workerPod := range workerPods.Items {
+			if _, ok := workerPod.Annotations[utils.RayNodeDrainStartTimeAnnotationKey]; !ok || !workerPod.DeletionTimestamp.IsZero() {
+				continue
+			}
+			deletedWorkers[workerPod.Name] = deleted
+			if !r.drainWorkerPod(ctx, instance, &workerPod, worker.ScaleStrategy.DrainGracePeriodSeconds) {
+				numDrainingWorkerPods++
+				continue
+			}
+			logger.Info("Deleting drained pod", "namespace", workerPod.Namespace, "name", workerPod.Name)
+			if err := r.Delete(ctx, &workerPod); err != nil {
+				if !errors.IsNotFound(err) {
+					r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteWorkerPod), "Failed deleting pod %s/%s, %v", workerPod.Namespace, workerPod.Name, err)
+					return errstd.Join(utils.ErrFailedDeleteWorkerPod, err)
+				}
+				logger.Info("reconcilePods", "The worker Pod has already been deleted", workerPod.Name)
+				continue
+			}
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedWorkerPod), "Deleted pod %s/%s", workerPod.Namespace, workerPod.Name)
+		}
+
 		// Always remove the specified WorkersToDelete - regardless of the value of Replicas.
 		// Essentially WorkersToDelete has to be deleted to meet the expectations of the Autoscaler.
 		logger.Info("reconcilePods", "removing the pods in the scaleStrategy of", worker.GroupName)
-		for _, podsToDelete := range worker.ScaleStrategy.WorkersToDelete {
+		workersToDelete := worker.ScaleStrategy.WorkersToDelete
+		if worker.NumOfHosts > 1 {
+			// Deleting a host of a multi-host replica deletes the whole replica.
+			workersToDelete = expandToWorkerReplicas(workersToDelete, workerPods.Items, worker.NumOfHosts)
+		}
+		for _, podsToDelete := range workersToDelete {
+			if _, ok := deletedWorkers[podsToDelete]; ok {
+				continue
+			}
 			pod := corev1.Pod{}
 			pod.Name = podsToDelete
 			pod.Namespace = utils.GetNamespace(instance.ObjectMeta)
+			if worker.ScaleStrategy.DrainGracePeriodSeconds != nil {
+				if workerPod := findPodByName(workerPods.Items, podsToDelete); workerPod != nil {
+					if !r.drainWorkerPod(ctx, instance, workerPod, worker.ScaleStrategy.DrainGracePeriodSeconds) {
+						deletedWorkers[pod.Name] = deleted
+						numDrainingWorkerPods++
+						continue
+					}
+				}
+			}
 			logger.Info("Deleting pod", "namespace", pod.Namespace, "name", pod.Name)
 			if err := r.Delete(ctx, &pod); err != nil {
 				if !errors.IsNotFound(err) {
@@ -823,6 +944,55 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			worker.NumOfHosts = 1
 		}
 		numExpectedPods := int(workerReplicas * worker.NumOfHosts)
+
+		// Replace the worker Pods created from a previous template of the worker group based on the upgrade strategy.
+		if upgradeStrategy := instance.Spec.UpgradeStrategy; upgradeStrategy != nil && upgradeStrategy.Type != nil {
+			templateHash, err := utils.GenerateWorkerGroupTemplateHash(worker)
+			if err != nil {
+				return err
+			}
+			updatedPods, outdatedPods := splitWorkerPodsByTemplateHash(runningPods.Items, templateHash)
+			switch *upgradeStrategy.Type {
+			case rayv1.Recreate:
+				// Don't create the replacements until all the outdated Pods are gone, including the ones that are
+				// draining or terminating, so that the old and new Pods never run at the same time. The outdated Pods
+				// are then replaced by the scaling logic below in a later reconciliation.
+				if _, allOutdatedPods := splitWorkerPodsByTemplateHash(workerPods.Items, templateHash); len(allOutdatedPods) > 0 {
+					logger.Info("reconcilePods", "Worker group", worker.GroupName, "upgrade strategy", *upgradeStrategy.Type,
+						"updated Pods", len(updatedPods), "outdated Pods", len(allOutdatedPods))
+					numDrainingOutdatedPods, err := r.deleteWorkerPods(ctx, instance, outdatedPods, worker.ScaleStrategy.DrainGracePeriodSeconds)
+					if err != nil {
+						return err
+					}
+					numDrainingWorkerPods += numDrainingOutdatedPods
+					numOutdatedWorkerPods += len(allOutdatedPods)
+					continue
+				}
+			case rayv1.RollingUpdate:
+				if len(outdatedPods) > 0 {
+					logger.Info("reconcilePods", "Worker group", worker.GroupName, "upgrade strategy", *upgradeStrategy.Type,
+						"updated Pods", len(updatedPods), "outdated Pods", len(outdatedPods))
+					numDrainingRolloutPods, err := r.rollingUpdateWorkerGroup(ctx, instance, worker, workerReplicas, templateHash, runningPods.Items, workerPods.Items)
+					if err != nil {
+						return err
+					}
+					numDrainingWorkerPods += numDrainingRolloutPods
+					// The rolling update also takes care of scaling the worker group until all outdated Pods are replaced.
+					continue
+				}
+			}
+		}
+
+		// The Pods of a multi-host worker group are created and deleted by whole replicas.
+		if worker.NumOfHosts > 1 {
+			numDrainingReplicaPods, err := r.reconcileWorkerReplicas(ctx, instance, worker, workerReplicas, runningPods.Items, workerPods.Items)
+			if err != nil {
+				return err
+			}
+			numDrainingWorkerPods += numDrainingReplicaPods
+			continue
+		}
+
 		diff := numExpectedPods - len(runningPods.Items)
 
 		logger.Info("reconcilePods", "workerReplicas", workerReplicas, "NumOfHosts", worker.NumOfHosts, "runningPods", len(runningPods.Items), "diff", diff)
@@ -841,29 +1011,17 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			logger.Info("reconcilePods", "all workers already exist for group", worker.GroupName)
 			continue
 		} else {
-			// diff < 0 indicates the need to delete some Pods to match the desired number of replicas. However,
-			// randomly deleting Pods is certainly not ideal. So, if autoscaling is enabled for the cluster, we
-			// will disable random Pod deletion, making Autoscaler the sole decision-maker for Pod deletions.
-			enableInTreeAutoscaling := (instance.Spec.EnableInTreeAutoscaling != nil) && (*instance.Spec.EnableInTreeAutoscaling)
-
-			// TODO (kevin85421): `enableRandomPodDelete` is a feature flag for KubeRay v0.6.0. If users want to use
-			// the old behavior, they can set the environment variable `ENABLE_RANDOM_POD_DELETE` to `true`. When the
-			// default behavior is stable enough, we can remove this feature flag.
-			enableRandomPodDelete := false
-			if enableInTreeAutoscaling {
-				if s := os.Getenv(utils.ENABLE_RANDOM_POD_DELETE); strings.ToLower(s) == "true" {
-					enableRandomPodDelete = true
-				}
-			}
-			// Case 1: If Autoscaler is disabled, we will always enable random Pod deletion no matter the value of the feature flag.
-			// Case 2: If Autoscaler is enabled, we will respect the value of the feature flag. If the feature flag environment variable
-			// is not set, we will disable random Pod deletion by default.
-			if !enableInTreeAutoscaling || enableRandomPodDelete {
+			if isRandomPodDeleteEnabled(instance) {
 				// diff < 0 means that we need to delete some Pods to meet the desired number of replicas.
 				randomlyRemovedWorkers := -diff
 				logger.Info("reconcilePods", "Number workers to delete randomly", randomlyRemovedWorkers, "Worker group", worker.GroupName)
-				for i := 0; i < randomlyRemovedWorkers; i++ {
-					randomPodToDelete := runningPods.Items[i]
+				victims := r.newVictimSelector(ctx, instance, worker).SelectVictims(runningPods.Items, randomlyRemovedWorkers)
+				for i := range victims {
+					randomPodToDelete := victims[i]
+					if !r.drainWorkerPod(ctx, instance, &randomPodToDelete, worker.ScaleStrategy.DrainGracePeriodSeconds) {
+						numDrainingWorkerPods++
+						continue
+					}
 					logger.Info("Randomly deleting Pod", "progress", fmt.Sprintf("%d / %d", i+1, randomlyRemovedWorkers), "with name", randomPodToDelete.Name)
 					if err := r.Delete(ctx, &randomPodToDelete); err != nil {
 						if !errors.IsNotFound(err) {
@@ -879,9 +1037,617 @@ func (r *RayClusterReconciler) reconcilePods(ctx context.Context, instance *rayv
 			}
 		}
 	}
+
+	// Requeue soon to delete the worker Pods as soon as their Ray nodes are drained, and to replace the outdated
+	// worker Pods as soon as they are gone.
+	if numDrainingWorkerPods > 0 || numOutdatedWorkerPods > 0 {
+		return fmt.Errorf("%w: %d worker Pods are draining, %d outdated worker Pods are waiting to be deleted",
+			errWaitForWorkerPods, numDrainingWorkerPods, numOutdatedWorkerPods)
+	}
 	return nil
 }
 
+// getHeadRestartBackoff returns how long to wait after the last head Pod restart before restarting the head Pod again.
+// The backoff doubles with every restart and is capped at the maximum backoff.
+func getHeadRestartBackoff(headRestarts int32) time.Duration {
+	backoffSeconds, err := strconv.Atoi(os.Getenv(utils.RAYCLUSTER_HEAD_RESTART_BACKOFF_SECONDS))
+	if err != nil || backoffSeconds < 0 {
+		backoffSeconds = utils.DefaultHeadRestartBackoffSeconds
+	}
+	maxBackoffSeconds, err := strconv.Atoi(os.Getenv(utils.RAYCLUSTER_HEAD_RESTART_MAX_BACKOFF_SECONDS))
+	if err != nil || maxBackoffSeconds < 0 {
+		maxBackoffSeconds = utils.DefaultHeadRestartMaxBackoffSeconds
+	}
+	backoff := time.Duration(backoffSeconds) * time.Second
+	maxBackoff := time.Duration(maxBackoffSeconds) * time.Second
+	for i := int32(1); i < headRestarts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// isRandomPodDeleteEnabled reports whether KubeRay deletes worker Pods on its own when a worker group has more
+// Pods than desired. Randomly deleting Pods is certainly not ideal. So, if autoscaling is enabled for the cluster,
+// random Pod deletion is disabled, making Autoscaler the sole decision-maker for Pod deletions.
+func isRandomPodDeleteEnabled(instance *rayv1.RayCluster) bool {
+	enableInTreeAutoscaling := (instance.Spec.EnableInTreeAutoscaling != nil) && (*instance.Spec.EnableInTreeAutoscaling)
+
+	// TODO (kevin85421): `enableRandomPodDelete` is a feature flag for KubeRay v0.6.0. If users want to use
+	// the old behavior, they can set the environment variable `ENABLE_RANDOM_POD_DELETE` to `true`. When the
+	// default behavior is stable enough, we can remove this feature flag.
+	enableRandomPodDelete := false
+	if enableInTreeAutoscaling {
+		if s := os.Getenv(utils.ENABLE_RANDOM_POD_DELETE); strings.ToLower(s) == "true" {
+			enableRandomPodDelete = true
+		}
+	}
+	// Case 1: If Autoscaler is disabled, we will always enable random Pod deletion no matter the value of the feature flag.
+	// Case 2: If Autoscaler is enabled, we will respect the value of the feature flag. If the feature flag environment variable
+	// is not set, we will disable random Pod deletion by default.
+	return !enableInTreeAutoscaling || enableRandomPodDelete
+}
+
+// newVictimSelector returns the VictimSelector configured for the worker group. The Ray dashboard is only queried
+// if the policy needs to know which Ray nodes are idle.
+func (r *RayClusterReconciler) newVictimSelector(ctx context.Context, instance *rayv1.RayCluster, worker rayv1.WorkerGroupSpec) utils.VictimSelector {
+	var idleNodeIPs map[string]struct{}
+	if policy := worker.ScaleStrategy.VictimSelectionPolicy; policy != nil && *policy == rayv1.IdleNodeVictimSelection {
+		idleNodeIPs = r.getIdleRayNodeIPs(ctx, instance)
+	}
+	return utils.NewVictimSelector(worker.ScaleStrategy.VictimSelectionPolicy, idleNodeIPs)
+}
+
+// reconcileWorkerReplicas scales a multi-host worker group by whole replicas, and returns the number of worker Pods
+// that are still draining. Replicas that do not have exactly NumOfHosts running Pods, for example because a host was
+// deleted, are recreated.
+func (r *RayClusterReconciler) reconcileWorkerReplicas(ctx context.Context, instance *rayv1.RayCluster, worker rayv1.WorkerGroupSpec, workerReplicas int32, runningPods []corev1.Pod, allPods []corev1.Pod) (int, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	replicas := groupWorkerPodsByReplica(runningPods, worker.NumOfHosts)
+
+	completeReplicas := []workerReplica{}
+	numReplicasBeingCreated := 0
+	now := time.Now()
+	for _, replica := range replicas {
+		if len(replica.pods) == int(worker.NumOfHosts) {
+			completeReplicas = append(completeReplicas, replica)
+			continue
+		}
+		// A replica that was just created may look incomplete because the informer cache lags behind. It is neither
+		// deleted nor replaced until the grace period has passed.
+		if len(replica.pods) < int(worker.NumOfHosts) && replica.isBeingCreated(now) {
+			logger.Info("reconcilePods", "Worker group", worker.GroupName, "replica being created", replica.index,
+				"hosts", len(replica.pods), "NumOfHosts", worker.NumOfHosts)
+			numReplicasBeingCreated++
+			continue
+		}
+		logger.Info("reconcilePods", "Worker group", worker.GroupName, "incomplete replica", replica.index,
+			"hosts", len(replica.pods), "NumOfHosts", worker.NumOfHosts)
+		for i := range replica.pods {
+			pod := &replica.pods[i]
+			if err := r.Delete(ctx, pod); err != nil {
+				if !errors.IsNotFound(err) {
+					r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteWorkerPod), "Failed deleting Pod %s/%s, %v", pod.Namespace, pod.Name, err)
+					return 0, errstd.Join(utils.ErrFailedDeleteWorkerPod, err)
+				}
+				continue
+			}
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedWorkerPod),
+				"Deleted Pod %s/%s because its replica has %d of %d hosts", pod.Namespace, pod.Name, len(replica.pods), worker.NumOfHosts)
+		}
+	}
+
+	diff := int(workerReplicas) - len(completeReplicas) - numReplicasBeingCreated
+	logger.Info("reconcilePods", "workerReplicas", workerReplicas, "NumOfHosts", worker.NumOfHosts, "complete replicas", len(completeReplicas),
+		"replicas being created", numReplicasBeingCreated, "diff", diff)
+	if diff > 0 {
+		for _, replicaIndex := range getUnusedWorkerReplicaIndices(allPods, diff) {
+			logger.Info("reconcilePods", "creating replica for group", worker.GroupName, "replica index", replicaIndex)
+			if err := r.createWorkerReplica(ctx, *instance, worker, replicaIndex); err != nil {
+				return 0, errstd.Join(utils.ErrFailedCreateWorkerPod, err)
+			}
+		}
+		return 0, nil
+	}
+	if diff == 0 {
+		return 0, nil
+	}
+	if !isRandomPodDeleteEnabled(instance) {
+		logger.Info("Random Pod deletion is disabled for the cluster. The only decision-maker for Pod deletions is Autoscaler.")
+		return 0, nil
+	}
+
+	// Rank all the running Pods with the victim selection policy, and delete the replicas of the highest-ranked Pods.
+	replicaOfPod := make(map[string]int)
+	candidates := []corev1.Pod{}
+	for i, replica := range completeReplicas {
+		for _, pod := range replica.pods {
+			replicaOfPod[pod.Name] = i
+			candidates = append(candidates, pod)
+		}
+	}
+	victimReplicas := []int{}
+	for _, pod := range r.newVictimSelector(ctx, instance, worker).SelectVictims(candidates, len(candidates)) {
+		if len(victimReplicas) == -diff {
+			break
+		}
+		if i := replicaOfPod[pod.Name]; !slices.Contains(victimReplicas, i) {
+			victimReplicas = append(victimReplicas, i)
+		}
+	}
+
+	numDrainingWorkerPods := 0
+	for _, i := range victimReplicas {
+		logger.Info("Deleting replica", "Worker group", worker.GroupName, "replica index", completeReplicas[i].index)
+		for j := range completeReplicas[i].pods {
+			pod := &completeReplicas[i].pods[j]
+			if !r.drainWorkerPod(ctx, instance, pod, worker.ScaleStrategy.DrainGracePeriodSeconds) {
+				numDrainingWorkerPods++
+				continue
+			}
+			if err := r.Delete(ctx, pod); err != nil {
+				if !errors.IsNotFound(err) {
+					r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteWorkerPod), "Failed deleting Pod %s/%s, %v", pod.Namespace, pod.Name, err)
+					return 0, errstd.Join(utils.ErrFailedDeleteWorkerPod, err)
+				}
+				logger.Info("reconcilePods", "The worker Pod has already been deleted", pod.Name)
+				continue
+			}
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedWorkerPod), "Deleted Pod %s/%s", pod.Namespace, pod.Name)
+		}
+	}
+	return numDrainingWorkerPods, nil
+}
+
+// workerReplica is the set of worker Pods that form one replica of a multi-host worker group.
+type workerReplica struct {
+	index string
+	pods  []corev1.Pod
+}
+
+func (replica workerReplica) isReady() bool {
+	return !slices.ContainsFunc(replica.pods, func(pod corev1.Pod) bool { return !utils.IsRunningAndReady(&pod) })
+}
+
+func (replica workerReplica) isDeleting() bool {
+	return slices.ContainsFunc(replica.pods, func(pod corev1.Pod) bool { return !pod.DeletionTimestamp.IsZero() })
+}
+
+// isBeingCreated reports whether all the Pods of the replica were created within the grace period. The informer
+// cache may not contain all the hosts of a replica that was just created yet.
+func (replica workerReplica) isBeingCreated(now time.Time) bool {
+	return !slices.ContainsFunc(replica.pods, func(pod corev1.Pod) bool {
+		return now.Sub(pod.CreationTimestamp.Time) >= utils.WorkerReplicaCreationGracePeriodSeconds*time.Second
+	})
+}
+
+// groupWorkerPodsByReplica groups the Pods of a multi-host worker group by their RayWorkerReplicaIndexKey label, in
+// the order in which the replicas first appear in pods. Pods created before the label was introduced are grouped
+// into replicas of numOfHosts Pods in the order in which they are listed.
+func groupWorkerPodsByReplica(pods []corev1.Pod, numOfHosts int32) []workerReplica {
+	replicas := []workerReplica{}
+	replicaPositions := make(map[string]int)
+	numUnlabeledPods := 0
+	for _, pod := range pods {
+		index, ok := pod.Labels[utils.RayWorkerReplicaIndexKey]
+		if !ok {
+			// Unlabeled Pods use keys that cannot collide with the label values.
+			index = fmt.Sprintf("unlabeled-%d", numUnlabeledPods/int(max(numOfHosts, 1)))
+			numUnlabeledPods++
+		}
+		position, ok := replicaPositions[index]
+		if !ok {
+			position = len(replicas)
+			replicaPositions[index] = position
+			replicas = append(replicas, workerReplica{index: index})
+		}
+		replicas[position].pods = append(replicas[position].pods, pod)
+	}
+	return replicas
+}
+
+// expandToWorkerReplicas returns the given Pod names together with the names of all the other hosts in their
+// replicas, so that the hosts of a multi-host replica are always deleted together. Names of Pods that do not exist
+// in pods are kept as they are.
+func expandToWorkerReplicas(names []string, pods []corev1.Pod, numOfHosts int32) []string {
+	expanded := []string{}
+	for _, replica := range groupWorkerPodsByReplica(pods, numOfHosts) {
+		if !slices.ContainsFunc(replica.pods, func(pod corev1.Pod) bool { return slices.Contains(names, pod.Name) }) {
+			continue
+		}
+		for _, pod := range replica.pods {
+			expanded = append(expanded, pod.Name)
+		}
+	}
+	for _, name := range names {
+		if !slices.Contains(expanded, name) {
+			expanded = append(expanded, name)
+		}
+	}
+	return expanded
+}
+
+// getUnusedWorkerReplicaIndices returns the n smallest replica indices that are not used by any of the Pods.
+func getUnusedWorkerReplicaIndices(pods []corev1.Pod, n int) []int {
+	usedIndices := make(map[string]struct{})
+	for _, pod := range pods {
+		if index, ok := pod.Labels[utils.RayWorkerReplicaIndexKey]; ok {
+			usedIndices[index] = struct{}{}
+		}
+	}
+	indices := []int{}
+	for i := 0; len(indices) < n; i++ {
+		if _, ok := usedIndices[strconv.Itoa(i)]; !ok {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// drainWorkerPod asks Ray to drain the node running in a worker Pod that is going to be deleted, and reports whether
+// the Pod can be deleted now. The first call sends the drain request and records the start time in the
+// RayNodeDrainStartTimeAnnotationKey annotation of the Pod. The Pod can be deleted once the Ray node is dead or the
+// grace period has elapsed. If drainGracePeriodSeconds is nil, or the node cannot be drained, the Pod is deleted right away.
+func (r *RayClusterReconciler) drainWorkerPod(ctx context.Context, instance *rayv1.RayCluster, pod *corev1.Pod, drainGracePeriodSeconds *int32) bool {
+	logger := ctrl.LoggerFrom(ctx)
+	if drainGracePeriodSeconds == nil || !pod.DeletionTimestamp.IsZero() || pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+		return true
+	}
+
+	if startTimeStr, ok := pod.Annotations[utils.RayNodeDrainStartTimeAnnotationKey]; ok {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			logger.Info("Invalid drain start time, deleting the worker Pod", "pod", pod.Name, "drainStartTime", startTimeStr)
+			return true
+		}
+		if time.Since(startTime) >= time.Duration(*drainGracePeriodSeconds)*time.Second {
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DrainedWorkerPod),
+				"Drain grace period of %d seconds elapsed for worker Pod %s/%s", *drainGracePeriodSeconds, pod.Namespace, pod.Name)
+			return true
+		}
+		node, err := r.getAliveRayNode(ctx, instance, pod)
+		if err != nil {
+			// Keep waiting until the grace period elapses. The dashboard may be temporarily unavailable.
+			logger.Info("Failed to get the Ray node of the draining worker Pod", "pod", pod.Name, "error", err)
+			return false
+		}
+		if node == nil {
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DrainedWorkerPod),
+				"Drained the Ray node of worker Pod %s/%s", pod.Namespace, pod.Name)
+			return true
+		}
+		logger.Info("Waiting for the Ray node to drain", "pod", pod.Name, "nodeId", node.NodeId, "drainStartTime", startTimeStr)
+		return false
+	}
+
+	nodeId, err := r.startDrainingRayNode(ctx, instance, pod, *drainGracePeriodSeconds)
+	if err != nil {
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDrainWorkerPod),
+			"Failed draining the Ray node of worker Pod %s/%s, deleting the Pod without draining, %v", pod.Namespace, pod.Name, err)
+		return true
+	}
+	if nodeId == "" {
+		logger.Info("The worker Pod has no alive Ray node to drain", "pod", pod.Name)
+		return true
+	}
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DrainingWorkerPod),
+		"Draining the Ray node %s of worker Pod %s/%s with a grace period of %d seconds", nodeId, pod.Namespace, pod.Name, *drainGracePeriodSeconds)
+	return false
+}
+
+// startDrainingRayNode sends the drain request for the Ray node running in the Pod and annotates the Pod with the
+// drain start time. It returns the ID of the drained node, or an empty string if the Pod has no alive Ray node.
+func (r *RayClusterReconciler) startDrainingRayNode(ctx context.Context, instance *rayv1.RayCluster, pod *corev1.Pod, drainGracePeriodSeconds int32) (string, error) {
+	rayDashboardClient, err := r.getRayDashboardClient(ctx, instance)
+	if err != nil {
+		return "", err
+	}
+	node, err := findAliveRayNode(ctx, rayDashboardClient, pod)
+	if err != nil || node == nil {
+		return "", err
+	}
+	// The Ray dashboard doesn't expose an API to drain nodes, so the drain request is sent to the GCS server. The GCS
+	// server is reached the same way as the worker Pods reach it, because the name of the head Service port varies.
+	fqdnRayIP := utils.GenerateFQDNServiceName(ctx, *instance, instance.Namespace)
+	gcsAddress := fmt.Sprintf("%s:%s", fqdnRayIP, common.GetHeadPort(instance.Spec.HeadGroupSpec.RayStartParams))
+	startTime := time.Now()
+	if err := r.gcsClient.DrainNode(ctx, gcsAddress, node.NodeId, startTime.Add(time.Duration(drainGracePeriodSeconds)*time.Second)); err != nil {
+		return "", err
+	}
+	patch := client.MergeFrom(pod.DeepCopy())
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[utils.RayNodeDrainStartTimeAnnotationKey] = startTime.UTC().Format(time.RFC3339)
+	if err := r.Patch(ctx, pod, patch); err != nil {
+		return "", err
+	}
+	return node.NodeId, nil
+}
+
+// drainWorkerPodsForSuspension drains the Ray nodes of all worker Pods in worker groups with a drain grace period.
+// It returns the number of worker Pods that are still draining.
+func (r *RayClusterReconciler) drainWorkerPodsForSuspension(ctx context.Context, instance *rayv1.RayCluster) (int, error) {
+	numDrainingWorkerPods := 0
+	for _, worker := range instance.Spec.WorkerGroupSpecs {
+		if worker.ScaleStrategy.DrainGracePeriodSeconds == nil {
+			continue
+		}
+		workerPods := corev1.PodList{}
+		if err := r.List(ctx, &workerPods, common.RayClusterGroupPodsAssociationOptions(instance, worker.GroupName).ToListOptions()...); err != nil {
+			return 0, err
+		}
+		for i := range workerPods.Items {
+			if !r.drainWorkerPod(ctx, instance, &workerPods.Items[i], worker.ScaleStrategy.DrainGracePeriodSeconds) {
+				numDrainingWorkerPods++
+			}
+		}
+	}
+	return numDrainingWorkerPods, nil
+}
+
+// getAliveRayNode returns the alive Ray node running in the Pod, or nil if there is none.
+func (r *RayClusterReconciler) getAliveRayNode(ctx context.Context, instance *rayv1.RayCluster, pod *corev1.Pod) (*utils.RayNodeInfo, error) {
+	rayDashboardClient, err := r.getRayDashboardClient(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+	return findAliveRayNode(ctx, rayDashboardClient, pod)
+}
+
+func findAliveRayNode(ctx context.Context, rayDashboardClient utils.RayDashboardClientInterface, pod *corev1.Pod) (*utils.RayNodeInfo, error) {
+	nodes, err := rayDashboardClient.ListNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// A dead node may have the same IP as the Pod if the IP was reused, so only alive nodes are considered.
+	for i := range nodes {
+		if nodes[i].NodeIP == pod.Status.PodIP && nodes[i].State != utils.RayNodeStateDead {
+			return &nodes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// getIdleRayNodeIPs returns the IPs of the alive Ray nodes that are idle. Errors are logged and ignored because
+// idleness is only a hint for choosing which worker Pods to delete.
+func (r *RayClusterReconciler) getIdleRayNodeIPs(ctx context.Context, instance *rayv1.RayCluster) map[string]struct{} {
+	logger := ctrl.LoggerFrom(ctx)
+	rayDashboardClient, err := r.getRayDashboardClient(ctx, instance)
+	if err != nil {
+		logger.Info("Failed to get the Ray dashboard client, assuming no Ray node is idle", "error", err)
+		return nil
+	}
+	nodes, err := rayDashboardClient.ListNodes(ctx)
+	if err != nil {
+		logger.Info("Failed to list the Ray nodes, assuming no Ray node is idle", "error", err)
+		return nil
+	}
+	idleNodeIPs := make(map[string]struct{})
+	for _, node := range nodes {
+		if node.State != utils.RayNodeStateDead && node.StateSnapshot.State == utils.RayNodeSnapshotStateIdle {
+			idleNodeIPs[node.NodeIP] = struct{}{}
+		}
+	}
+	return idleNodeIPs
+}
+
+func (r *RayClusterReconciler) getRayDashboardClient(ctx context.Context, instance *rayv1.RayCluster) (utils.RayDashboardClientInterface, error) {
+	clientURL, err := utils.FetchHeadServiceURL(ctx, r.Client, instance, utils.DashboardPortName)
+	if err != nil {
+		return nil, err
+	}
+	rayDashboardClient := r.dashboardClientFunc()
+	if err := rayDashboardClient.InitClient(ctx, clientURL, instance); err != nil {
+		return nil, err
+	}
+	return rayDashboardClient, nil
+}
+
+func findPodByName(pods []corev1.Pod, name string) *corev1.Pod {
+	for i := range pods {
+		if pods[i].Name == name {
+			return &pods[i]
+		}
+	}
+	return nil
+}
+
+// deleteWorkerPods drains and deletes the worker Pods that an upgrade or a scale-down replaces, and returns the number
+// of worker Pods that are still draining. Pods that are already being deleted are skipped.
+func (r *RayClusterReconciler) deleteWorkerPods(ctx context.Context, instance *rayv1.RayCluster, pods []corev1.Pod, drainGracePeriodSeconds *int32) (int, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	numDrainingWorkerPods := 0
+	for _, pod := range pods {
+		if !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if !r.drainWorkerPod(ctx, instance, &pod, drainGracePeriodSeconds) {
+			numDrainingWorkerPods++
+			continue
+		}
+		logger.Info("Deleting worker Pod", "name", pod.Name)
+		if err := r.Delete(ctx, &pod); err != nil {
+			if !errors.IsNotFound(err) {
+				r.Recorder.Eventf(instance, corev1.EventTypeWarning, string(utils.FailedToDeleteWorkerPod), "Failed deleting worker Pod %s/%s, %v", pod.Namespace, pod.Name, err)
+				return 0, errstd.Join(utils.ErrFailedDeleteWorkerPod, err)
+			}
+			logger.Info("The worker Pod has already been deleted", "name", pod.Name)
+			continue
+		}
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, string(utils.DeletedWorkerPod), "Deleted worker Pod %s/%s", pod.Namespace, pod.Name)
+	}
+	return numDrainingWorkerPods, nil
+}
+
+// deleteRemovedWorkerGroupPods deletes the worker Pods that belong to a worker group that is no longer in the spec.
+// The drain grace period of a removed worker group is unknown, so its Pods are deleted without draining.
+func (r *RayClusterReconciler) deleteRemovedWorkerGroupPods(ctx context.Context, instance *rayv1.RayCluster) error {
+	workerPods := corev1.PodList{}
+	if err := r.List(ctx, &workerPods, common.RayClusterWorkerPodsAssociationOptions(instance).ToListOptions()...); err != nil {
+		return err
+	}
+	groupNames := make(map[string]struct{}, len(instance.Spec.WorkerGroupSpecs))
+	for _, worker := range instance.Spec.WorkerGroupSpecs {
+		groupNames[worker.GroupName] = struct{}{}
+	}
+	var removedPods []corev1.Pod
+	for _, pod := range workerPods.Items {
+		if _, ok := groupNames[pod.Labels[utils.RayNodeGroupLabelKey]]; !ok {
+			removedPods = append(removedPods, pod)
+		}
+	}
+	_, err := r.deleteWorkerPods(ctx, instance, removedPods, nil)
+	return err
+}
+
+// splitWorkerPodsByTemplateHash separates the worker Pods of a group into the Pods created from the template with
+// `templateHash` and the outdated ones.
+func splitWorkerPodsByTemplateHash(pods []corev1.Pod, templateHash string) (updatedPods []corev1.Pod, outdatedPods []corev1.Pod) {
+	for _, pod := range pods {
+		if utils.IsPodOutdated(pod, templateHash) {
+			outdatedPods = append(outdatedPods, pod)
+		} else {
+			updatedPods = append(updatedPods, pod)
+		}
+	}
+	return updatedPods, outdatedPods
+}
+
+// getRollingUpdateLimits resolves MaxSurge and MaxUnavailable against the desired number of worker Pods in a group.
+// Both values default to 25%. MaxSurge is rounded up and MaxUnavailable is rounded down, which is consistent with
+// Kubernetes Deployments. If both values are 0, MaxUnavailable is set to 1 so that the rolling update can make progress.
+func getRollingUpdateLimits(rollingUpdate *rayv1.RollingUpdateConfig, numExpectedPods int) (maxSurge int, maxUnavailable int, err error) {
+	defaultValue := intstr.FromString("25%")
+	var surge, unavailable *intstr.IntOrString
+	if rollingUpdate != nil {
+		surge, unavailable = rollingUpdate.MaxSurge, rollingUpdate.MaxUnavailable
+	}
+	if maxSurge, err = intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(surge, defaultValue), numExpectedPods, true); err != nil {
+		return 0, 0, fmt.Errorf("invalid maxSurge: %w", err)
+	}
+	if maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(intstr.ValueOrDefault(unavailable, defaultValue), numExpectedPods, false); err != nil {
+		return 0, 0, fmt.Errorf("invalid maxUnavailable: %w", err)
+	}
+	if maxSurge == 0 && maxUnavailable == 0 {
+		maxUnavailable = 1
+	}
+	return maxSurge, maxUnavailable, nil
+}
+
+// rollingUpdateWorkerGroup makes progress on the rolling update of a worker group, and returns the number of worker
+// Pods that are still draining. The Pods of a multi-host worker group are replaced by whole replicas, so maxSurge and
+// maxUnavailable are resolved against the number of replicas. A replica is outdated if any of its hosts is outdated.
+// The Pods of a single-host worker group are deleted in the order of the victim selection policy of the group, and
+// the updated Pods are scaled down if the group is scaled down during the rolling update.
+func (r *RayClusterReconciler) rollingUpdateWorkerGroup(ctx context.Context, instance *rayv1.RayCluster, worker rayv1.WorkerGroupSpec, workerReplicas int32, templateHash string, runningPods []corev1.Pod, allPods []corev1.Pod) (int, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	var updatedReplicas, outdatedReplicas []workerReplica
+	for _, replica := range groupWorkerPodsByReplica(runningPods, worker.NumOfHosts) {
+		if slices.ContainsFunc(replica.pods, func(pod corev1.Pod) bool { return utils.IsPodOutdated(pod, templateHash) }) {
+			outdatedReplicas = append(outdatedReplicas, replica)
+		} else {
+			updatedReplicas = append(updatedReplicas, replica)
+		}
+	}
+
+	var victimSelector utils.VictimSelector
+	if worker.NumOfHosts <= 1 {
+		victimSelector = r.newVictimSelector(ctx, instance, worker)
+		outdatedPods := podsOfWorkerReplicas(outdatedReplicas)
+		outdatedReplicas = groupWorkerPodsByReplica(victimSelector.SelectVictims(outdatedPods, len(outdatedPods)), 1)
+	}
+
+	maxSurge, maxUnavailable, err := getRollingUpdateLimits(instance.Spec.UpgradeStrategy.RollingUpdate, int(workerReplicas))
+	if err != nil {
+		return 0, err
+	}
+	numReplicasToCreate, replicasToDelete := getRollingUpdateBatch(int(workerReplicas), maxSurge, maxUnavailable, updatedReplicas, outdatedReplicas)
+	logger.Info("reconcilePods", "Worker group", worker.GroupName, "maxSurge", maxSurge, "maxUnavailable", maxUnavailable,
+		"Number of replicas to create", numReplicasToCreate, "Number of outdated replicas to delete", len(replicasToDelete))
+
+	if worker.NumOfHosts > 1 {
+		for _, replicaIndex := range getUnusedWorkerReplicaIndices(allPods, numReplicasToCreate) {
+			if err := r.createWorkerReplica(ctx, *instance, worker, replicaIndex); err != nil {
+				return 0, errstd.Join(utils.ErrFailedCreateWorkerPod, err)
+			}
+		}
+	} else {
+		for i := 0; i < numReplicasToCreate; i++ {
+			if err := r.createWorkerPod(ctx, *instance, *worker.DeepCopy()); err != nil {
+				return 0, errstd.Join(utils.ErrFailedCreateWorkerPod, err)
+			}
+		}
+	}
+	podsToDelete := podsOfWorkerReplicas(replicasToDelete)
+	if victimSelector != nil && isRandomPodDeleteEnabled(instance) {
+		updatedPods := []corev1.Pod{}
+		for _, replica := range updatedReplicas {
+			if !replica.isDeleting() {
+				updatedPods = append(updatedPods, replica.pods...)
+			}
+		}
+		if numExcessPods := len(updatedPods) - int(workerReplicas); numExcessPods > 0 {
+			logger.Info("reconcilePods", "Worker group", worker.GroupName, "Number of updated Pods to scale down", numExcessPods)
+			podsToDelete = append(podsToDelete, victimSelector.SelectVictims(updatedPods, numExcessPods)...)
+		}
+	}
+	return r.deleteWorkerPods(ctx, instance, podsToDelete, worker.ScaleStrategy.DrainGracePeriodSeconds)
+}
+
+// podsOfWorkerReplicas returns the Pods of all the replicas.
+func podsOfWorkerReplicas(replicas []workerReplica) []corev1.Pod {
+	pods := []corev1.Pod{}
+	for _, replica := range replicas {
+		pods = append(pods, replica.pods...)
+	}
+	return pods
+}
+
+// getRollingUpdateBatch decides how to make progress on the rolling update of a worker group in this reconciliation.
+// A replica is a single worker Pod, or all the hosts of a replica in a multi-host worker group. A replica is ready if
+// all of its Pods are ready, and it is being deleted if any of its Pods is being deleted.
+//
+// @return: numReplicasToCreate (int), replicasToDelete ([]workerReplica)
+// (1) numReplicasToCreate: The number of replicas to create from the current template. The total number of replicas
+// never exceeds numExpectedReplicas + maxSurge.
+// (2) replicasToDelete: The outdated replicas to delete. Outdated replicas that are not ready are always deleted
+// because they do not contribute to the availability of the group. Ready outdated replicas are only deleted as long as
+// at least numExpectedReplicas - maxUnavailable replicas stay ready.
+//
+// Replicas that are already being deleted are ignored.
+func getRollingUpdateBatch(numExpectedReplicas, maxSurge, maxUnavailable int, updatedReplicas, outdatedReplicas []workerReplica) (int, []workerReplica) {
+	numUpdatedReplicas, numAvailableReplicas := 0, 0
+	for _, replica := range updatedReplicas {
+		if replica.isDeleting() {
+			continue
+		}
+		numUpdatedReplicas++
+		if replica.isReady() {
+			numAvailableReplicas++
+		}
+	}
+	var readyOutdatedReplicas, replicasToDelete []workerReplica
+	for _, replica := range outdatedReplicas {
+		if replica.isDeleting() {
+			continue
+		}
+		if replica.isReady() {
+			readyOutdatedReplicas = append(readyOutdatedReplicas, replica)
+		} else {
+			replicasToDelete = append(replicasToDelete, replica)
+		}
+	}
+	numAvailableReplicas += len(readyOutdatedReplicas)
+	numTotalReplicas := numUpdatedReplicas + len(readyOutdatedReplicas) + len(replicasToDelete)
+
+	numReplicasToCreate := max(min(numExpectedReplicas+maxSurge-numTotalReplicas, numExpectedReplicas-numUpdatedReplicas), 0)
+	numReadyReplicasToDelete := min(numAvailableReplicas-(numExpectedReplicas-maxUnavailable), len(readyOutdatedReplicas))
+	for i := 0; i < numReadyReplicasToDelete; i++ {
+		replicasToDelete = append(replicasToDelete, readyOutdatedReplicas[i])
+	}
+	return numReplicasToCreate, replicasToDelete
+}
+
 // shouldDeletePod returns whether the Pod should be deleted and the reason
 //
 // @param pod: The Pod to be checked.
@@ -1043,10 +1809,27 @@ func (r *RayClusterReconciler) createHeadPod(ctx context.Context, instance rayv1
 }
 
 func (r *RayClusterReconciler) createWorkerPod(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec) error {
-	logger := ctrl.LoggerFrom(ctx)
-
 	// build the pod then create it
 	pod := r.buildWorkerPod(ctx, instance, worker)
+	return r.submitWorkerPod(ctx, instance, worker, pod)
+}
+
+// createWorkerReplica creates all the hosts of one replica of a multi-host worker group. Each Pod is labeled with the
+// index of the replica and its host index within the replica.
+func (r *RayClusterReconciler) createWorkerReplica(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec, replicaIndex int) error {
+	for hostIndex := 0; hostIndex < int(worker.NumOfHosts); hostIndex++ {
+		pod := r.buildWorkerPod(ctx, instance, *worker.DeepCopy())
+		pod.Labels[utils.RayWorkerReplicaIndexKey] = strconv.Itoa(replicaIndex)
+		pod.Labels[utils.RayHostIndexKey] = strconv.Itoa(hostIndex)
+		if err := r.submitWorkerPod(ctx, instance, worker, pod); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *RayClusterReconciler) submitWorkerPod(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec, pod corev1.Pod) error {
+	logger := ctrl.LoggerFrom(ctx)
 	if r.BatchSchedulerMgr != nil {
 		if scheduler, err := r.BatchSchedulerMgr.GetSchedulerForCluster(); err == nil {
 			scheduler.AddMetadataToPod(ctx, &instance, worker.GroupName, &pod)
@@ -1095,6 +1878,11 @@ func getCreatorCRDType(instance rayv1.RayCluster) utils.CRDType {
 func (r *RayClusterReconciler) buildWorkerPod(ctx context.Context, instance rayv1.RayCluster, worker rayv1.WorkerGroupSpec) corev1.Pod {
 	logger := ctrl.LoggerFrom(ctx)
 	podName := utils.PodGenerateName(fmt.Sprintf("%s-%s", instance.Name, worker.GroupName), rayv1.WorkerNode)
+	// The hash must be computed before the template is defaulted, because defaulting mutates the template's maps.
+	templateHash, templateHashErr := utils.GenerateWorkerGroupTemplateHash(worker)
+	if templateHashErr != nil {
+		logger.Error(templateHashErr, "Failed to generate the template hash for worker group", "groupName", worker.GroupName)
+	}
 	fqdnRayIP := utils.GenerateFQDNServiceName(ctx, instance, instance.Namespace) // Fully Qualified Domain Name
 
 	// The Ray head port used by workers to connect to the cluster (GCS server port for Ray >= 1.11.0, Redis port for older Ray.)
@@ -1106,6 +1894,10 @@ func (r *RayClusterReconciler) buildWorkerPod(ctx context.Context, instance rayv
 	}
 	creatorCRDType := getCreatorCRDType(instance)
 	pod := common.BuildPod(ctx, podTemplateSpec, rayv1.WorkerNode, worker.RayStartParams, headPort, autoscalingEnabled, creatorCRDType, fqdnRayIP)
+	// Record the template the Pod is created from so that outdated Pods can be detected when the worker group changes.
+	if templateHashErr == nil {
+		pod.Annotations[utils.RayPodTemplateHashKey] = templateHash
+	}
 	// Set raycluster instance as the owner and controller
 	if err := controllerutil.SetControllerReference(&instance, &pod, r.Scheme); err != nil {
 		logger.Error(err, "Failed to set controller reference for raycluster pod")
@@ -1230,6 +2022,12 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 	// Deep copy the instance, so we don't mutate the original object.
 	newInstance := instance.DeepCopy()
 
+	runtimePods := corev1.PodList{}
+	filterLabels := client.MatchingLabels{utils.RayClusterLabelKey: newInstance.Name}
+	if err := r.List(ctx, &runtimePods, client.InNamespace(newInstance.Namespace), filterLabels); err != nil {
+		return nil, err
+	}
+
 	statusConditionGateEnabled := features.Enabled(features.RayClusterStatusConditions)
 	if statusConditionGateEnabled {
 		if reconcileErr != nil {
@@ -1241,8 +2039,17 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 					Message: reconcileErr.Error(),
 				})
 			}
+		} else if reason, message := utils.DiagnoseRayClusterPodsFailure(runtimePods.Items); reason != "" {
+			// The Pods were created successfully, but one of them can't become ready, e.g. because its image
+			// can't be pulled or it was OOMKilled.
+			meta.SetStatusCondition(&newInstance.Status.Conditions, metav1.Condition{
+				Type:    string(rayv1.RayClusterReplicaFailure),
+				Status:  metav1.ConditionTrue,
+				Reason:  reason,
+				Message: message,
+			})
 		} else {
-			// if reconcileErr == nil, we can safely remove the RayClusterReplicaFailure condition.
+			// if reconcileErr == nil and no Pod has failed, we can safely remove the RayClusterReplicaFailure condition.
 			meta.RemoveStatusCondition(&newInstance.Status.Conditions, string(rayv1.RayClusterReplicaFailure))
 		}
 	}
@@ -1250,22 +2057,17 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 	// TODO (kevin85421): ObservedGeneration should be used to determine whether to update this CR or not.
 	newInstance.Status.ObservedGeneration = newInstance.ObjectMeta.Generation
 
-	runtimePods := corev1.PodList{}
-	filterLabels := client.MatchingLabels{utils.RayClusterLabelKey: newInstance.Name}
-	if err := r.List(ctx, &runtimePods, client.InNamespace(newInstance.Namespace), filterLabels); err != nil {
-		return nil, err
-	}
-
 	newInstance.Status.ReadyWorkerReplicas = utils.CalculateReadyReplicas(runtimePods)
 	newInstance.Status.AvailableWorkerReplicas = utils.CalculateAvailableReplicas(runtimePods)
 	newInstance.Status.DesiredWorkerReplicas = utils.CalculateDesiredReplicas(ctx, newInstance)
 	newInstance.Status.MinWorkerReplicas = utils.CalculateMinReplicas(newInstance)
 	newInstance.Status.MaxWorkerReplicas = utils.CalculateMaxReplicas(newInstance)
+	newInstance.Status.WorkerGroupStatuses = utils.CalculateWorkerGroupStatuses(ctx, newInstance, runtimePods)
 
 	totalResources := utils.CalculateDesiredResources(newInstance)
 	newInstance.Status.DesiredCPU = totalResources[corev1.ResourceCPU]
 	newInstance.Status.DesiredMemory = totalResources[corev1.ResourceMemory]
-	newInstance.Status.DesiredGPU = sumGPUs(totalResources)
+	newInstance.Status.DesiredGPU = utils.SumGPUs(totalResources)
 	newInstance.Status.DesiredTPU = totalResources[corev1.ResourceName("google.com/tpu")]
 
 	if utils.CheckAllPodsRunning(ctx, runtimePods) {
@@ -1291,6 +2093,25 @@ func (r *RayClusterReconciler) calculateStatus(ctx context.Context, instance *ra
 			meta.SetStatusCondition(&newInstance.Status.Conditions, headPodReadyCondition)
 		}
 
+		// HeadRecovered is only added once KubeRay has restarted the head Pod.
+		if newInstance.Status.HeadRestarts > 0 {
+			if headPod != nil && utils.IsRunningAndReady(headPod) {
+				meta.SetStatusCondition(&newInstance.Status.Conditions, metav1.Condition{
+					Type:    string(rayv1.HeadRecovered),
+					Status:  metav1.ConditionTrue,
+					Reason:  rayv1.HeadPodRecovered,
+					Message: fmt.Sprintf("Head Pod is ready after %d restarts", newInstance.Status.HeadRestarts),
+				})
+			} else {
+				meta.SetStatusCondition(&newInstance.Status.Conditions, metav1.Condition{
+					Type:    string(rayv1.HeadRecovered),
+					Status:  metav1.ConditionFalse,
+					Reason:  rayv1.HeadPodRecovering,
+					Message: "Waiting for the restarted head Pod to be ready",
+				})
+			}
+		}
+
 		suspendStatus := utils.FindRayClusterSuspendStatus(newInstance)
 		if !meta.IsStatusConditionTrue(newInstance.Status.Conditions, string(rayv1.RayClusterProvisioned)) && suspendStatus != rayv1.RayClusterSuspended {
 			// RayClusterProvisioned indicates whether all Ray Pods are ready when the RayCluster is first created.
@@ -1634,16 +2455,3 @@ func (r *RayClusterReconciler) updateRayClusterStatus(ctx context.Context, origi
 	}
 	return inconsistent, err
 }
-
-// sumGPUs sums the GPUs in the given resource list.
-func sumGPUs(resources map[corev1.ResourceName]resource.Quantity) resource.Quantity {
-	totalGPUs := resource.Quantity{}
-
-	for key, val := range resources {
-		if strings.HasSuffix(string(key), "gpu") && !val.IsZero() {
-			totalGPUs.Add(val)
-		}
-	}
-
-	return totalGPUs
-}
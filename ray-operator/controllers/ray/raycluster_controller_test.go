@@ -846,15 +846,27 @@ var _ = Context("Inside the default namespace", func() {
 				time.Second*3, time.Millisecond*500).Should(Equal(numWorkerPods), fmt.Sprintf("workerGroup %v", workerPods.Items))
 		})
 
+		It("All hosts of a replica share the same replica index", func() {
+			numPodsPerReplica := make(map[string]int)
+			for _, pod := range workerPods.Items {
+				Expect(pod.Labels).To(HaveKey(utils.RayWorkerReplicaIndexKey))
+				numPodsPerReplica[pod.Labels[utils.RayWorkerReplicaIndexKey]]++
+			}
+			Expect(numPodsPerReplica).To(HaveLen(3))
+			for _, numPods := range numPodsPerReplica {
+				Expect(numPods).To(Equal(int(numOfHosts)))
+			}
+		})
+
 		It("Simulate Ray Autoscaler scales down", func() {
+			// Ray Autoscaler only names one host of the replica, and KubeRay deletes all the hosts of the replica.
+			deletedReplicaIndex := workerPods.Items[0].Labels[utils.RayWorkerReplicaIndexKey]
 			err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 				Eventually(
 					getResourceFunc(ctx, client.ObjectKey{Name: rayCluster.Name, Namespace: namespace}, rayCluster),
 					time.Second*3, time.Millisecond*500).Should(BeNil())
 				rayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](2)
-				rayCluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{
-					workerPods.Items[0].Name, workerPods.Items[1].Name, workerPods.Items[2].Name, workerPods.Items[3].Name,
-				}
+				rayCluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{workerPods.Items[0].Name}
 				return k8sClient.Update(ctx, rayCluster)
 			})
 			Expect(err).NotTo(HaveOccurred(), "Failed to update RayCluster custom resource")
@@ -863,6 +875,9 @@ var _ = Context("Inside the default namespace", func() {
 			Eventually(
 				listResourceFunc(ctx, &workerPods, workerFilters...),
 				time.Second*3, time.Millisecond*500).Should(Equal(numWorkerPods), fmt.Sprintf("workerGroup %v", workerPods.Items))
+			for _, pod := range workerPods.Items {
+				Expect(pod.Labels[utils.RayWorkerReplicaIndexKey]).NotTo(Equal(deletedReplicaIndex))
+			}
 
 			// Ray Autoscaler should clean up WorkersToDelete after scaling process has finished.
 			// Call cleanUpWorkersToDelete to simulate the behavior of the Ray Autoscaler.
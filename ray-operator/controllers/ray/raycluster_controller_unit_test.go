@@ -17,7 +17,9 @@ package ray
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"testing"
@@ -1670,6 +1672,20 @@ func TestInconsistentRayClusterStatus(t *testing.T) {
 	newStatus = oldStatus.DeepCopy()
 	meta.SetStatusCondition(&newStatus.Conditions, metav1.Condition{Type: string(rayv1.RayClusterReplicaFailure), Status: metav1.ConditionTrue})
 	assert.True(t, r.inconsistentRayClusterStatus(ctx, oldStatus, *newStatus))
+
+	// Case 13: `WorkerGroupStatuses` is different => return true
+	newStatus = oldStatus.DeepCopy()
+	newStatus.WorkerGroupStatuses = []rayv1.WorkerGroupStatus{{GroupName: "small-group", OutdatedReplicas: 1}}
+	assert.True(t, r.inconsistentRayClusterStatus(ctx, oldStatus, *newStatus))
+
+	// Case 14: `WorkerGroupStatuses` were read back from the API server, so their quantities only differ in
+	// their internal representation => return false
+	oldStatus.WorkerGroupStatuses = []rayv1.WorkerGroupStatus{{GroupName: "small-group", DesiredMemory: *resource.NewQuantity(1<<30, resource.BinarySI)}}
+	statusJSON, err := json.Marshal(oldStatus)
+	assert.Nil(t, err)
+	newStatus = &rayv1.RayClusterStatus{}
+	assert.Nil(t, json.Unmarshal(statusJSON, newStatus))
+	assert.False(t, r.inconsistentRayClusterStatus(ctx, oldStatus, *newStatus))
 }
 
 func TestCalculateStatus(t *testing.T) {
@@ -1764,6 +1780,33 @@ func TestCalculateStatus(t *testing.T) {
 	newInstance, err = r.calculateStatus(ctx, testRayCluster, errors.Join(utils.ErrFailedCreateHeadPod, errors.New("invalid")))
 	assert.Nil(t, err)
 	assert.True(t, meta.IsStatusConditionPresentAndEqual(newInstance.Status.Conditions, string(rayv1.RayClusterReplicaFailure), metav1.ConditionTrue))
+
+	// Test RayClusterReplicaFailure diagnosed from a Pod whose image can't be pulled
+	headPod.Status.Phase = corev1.PodPending
+	headPod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name:  "ray-head",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+		},
+	}
+	runtimeObjects = []runtime.Object{headPod, headService}
+	fakeClient = clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
+	r.Client = fakeClient
+	newInstance, err = r.calculateStatus(ctx, testRayCluster, nil)
+	assert.Nil(t, err)
+	replicaFailureCondition := meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterReplicaFailure))
+	assert.NotNil(t, replicaFailureCondition)
+	assert.Equal(t, metav1.ConditionTrue, replicaFailureCondition.Status)
+	assert.Equal(t, rayv1.PodImagePullBackOff, replicaFailureCondition.Reason)
+
+	// The RayClusterReplicaFailure condition is removed once the Pod has recovered
+	headPod.Status.ContainerStatuses = nil
+	runtimeObjects = []runtime.Object{headPod, headService}
+	fakeClient = clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
+	r.Client = fakeClient
+	newInstance, err = r.calculateStatus(ctx, newInstance, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.RayClusterReplicaFailure)))
 }
 
 func TestRayClusterProvisionedCondition(t *testing.T) {
@@ -1864,6 +1907,198 @@ func TestRayClusterProvisionedCondition(t *testing.T) {
 	assert.Equal(t, rayClusterProvisionedCondition.Reason, rayv1.AllPodRunningAndReadyFirstTime)
 }
 
+func TestReconcile_HeadRecovery(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	newPods := func() []runtime.Object {
+		headPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "headNode",
+				Namespace: namespaceStr,
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:  instanceName,
+					utils.RayNodeTypeLabelKey: string(rayv1.HeadNode),
+				},
+			},
+			Spec:   corev1.PodSpec{Containers: []corev1.Container{{Name: "ray-head"}}},
+			Status: corev1.PodStatus{Phase: corev1.PodFailed},
+		}
+		workerPod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "workerNode",
+				Namespace: namespaceStr,
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:   instanceName,
+					utils.RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					utils.RayNodeGroupLabelKey: groupNameStr,
+				},
+			},
+			Spec:   corev1.PodSpec{Containers: []corev1.Container{{Name: "ray-worker"}}},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		return []runtime.Object{headPod, workerPod}
+	}
+	listPodNames := func(r *RayClusterReconciler) []string {
+		podList := corev1.PodList{}
+		err := r.List(ctx, &podList, client.InNamespace(namespaceStr))
+		assert.Nil(t, err)
+		names := []string{}
+		for _, pod := range podList.Items {
+			names = append(names, pod.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		lastHeadRestartTime *metav1.Time
+		name                string
+		backoffSeconds      string
+		expectedPods        []string
+		headRestarts        int32
+		gcsFaultTolerance   bool
+		expectedRestart     bool
+	}{
+		{
+			name:            "Worker Pods are restarted with the head Pod without GCS fault tolerance",
+			expectedPods:    []string{},
+			expectedRestart: true,
+		},
+		{
+			name:              "Worker Pods are kept with GCS fault tolerance",
+			gcsFaultTolerance: true,
+			expectedPods:      []string{"workerNode"},
+			expectedRestart:   true,
+		},
+		{
+			name:                "The head Pod is not restarted within the backoff",
+			backoffSeconds:      "60",
+			headRestarts:        1,
+			lastHeadRestartTime: &metav1.Time{Time: time.Now().Add(-30 * time.Second)},
+			expectedPods:        []string{"headNode", "workerNode"},
+		},
+		{
+			name:                "The head Pod is restarted after the backoff",
+			backoffSeconds:      "60",
+			headRestarts:        1,
+			lastHeadRestartTime: &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+			expectedPods:        []string{},
+			expectedRestart:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(utils.RAYCLUSTER_HEAD_RESTART_BACKOFF_SECONDS, tc.backoffSeconds)
+			cluster := testRayCluster.DeepCopy()
+			if tc.gcsFaultTolerance {
+				cluster.Annotations = map[string]string{utils.RayFTEnabledAnnotationKey: "true"}
+			}
+			cluster.Status.HeadRestarts = tc.headRestarts
+			cluster.Status.LastHeadRestartTime = tc.lastHeadRestartTime
+			r := &RayClusterReconciler{
+				Client:   clientFake.NewClientBuilder().WithRuntimeObjects(newPods()...).Build(),
+				Recorder: &record.FakeRecorder{},
+				Scheme:   scheme.Scheme,
+			}
+
+			err := r.reconcilePods(ctx, cluster)
+			assert.NotNil(t, err)
+			assert.ElementsMatch(t, tc.expectedPods, listPodNames(r))
+			if tc.expectedRestart {
+				assert.Equal(t, tc.headRestarts+1, cluster.Status.HeadRestarts)
+				assert.NotEqual(t, tc.lastHeadRestartTime, cluster.Status.LastHeadRestartTime)
+			} else {
+				assert.ErrorContains(t, err, "before restarting the head Pod headNode")
+				assert.Equal(t, tc.headRestarts, cluster.Status.HeadRestarts)
+				assert.Equal(t, tc.lastHeadRestartTime, cluster.Status.LastHeadRestartTime)
+			}
+		})
+	}
+}
+
+func TestGetHeadRestartBackoff(t *testing.T) {
+	tests := []struct {
+		backoffSeconds    string
+		maxBackoffSeconds string
+		expected          time.Duration
+		headRestarts      int32
+	}{
+		{backoffSeconds: "", headRestarts: 3, expected: 0},
+		{backoffSeconds: "10", headRestarts: 0, expected: 10 * time.Second},
+		{backoffSeconds: "10", headRestarts: 1, expected: 10 * time.Second},
+		{backoffSeconds: "10", headRestarts: 3, expected: 40 * time.Second},
+		{backoffSeconds: "10", headRestarts: 100, expected: 300 * time.Second},
+		{backoffSeconds: "10", maxBackoffSeconds: "30", headRestarts: 3, expected: 30 * time.Second},
+		{backoffSeconds: "invalid", headRestarts: 3, expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Setenv(utils.RAYCLUSTER_HEAD_RESTART_BACKOFF_SECONDS, tc.backoffSeconds)
+		t.Setenv(utils.RAYCLUSTER_HEAD_RESTART_MAX_BACKOFF_SECONDS, tc.maxBackoffSeconds)
+		assert.Equal(t, tc.expected, getHeadRestartBackoff(tc.headRestarts),
+			"backoff %q, max backoff %q, head restarts %d", tc.backoffSeconds, tc.maxBackoffSeconds, tc.headRestarts)
+	}
+}
+
+func TestHeadRecoveredCondition(t *testing.T) {
+	setupTest(t)
+	defer features.SetFeatureGateDuringTest(t, features.RayClusterStatusConditions, true)()
+
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	headPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "headNode",
+			Namespace: namespaceStr,
+			Labels: map[string]string{
+				utils.RayClusterLabelKey:  instanceName,
+				utils.RayNodeTypeLabelKey: string(rayv1.HeadNode),
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+	runtimeObjects := append([]runtime.Object{headPod}, testServices...)
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
+	ctx := context.Background()
+	r := &RayClusterReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+
+	// The condition is not added if the head Pod has never been restarted.
+	testRayCluster.Status.HeadRestarts = 0
+	newInstance, err := r.calculateStatus(ctx, testRayCluster, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.HeadRecovered)))
+
+	// The restarted head Pod is not ready yet.
+	testRayCluster.Status.HeadRestarts = 1
+	newInstance, err = r.calculateStatus(ctx, testRayCluster, nil)
+	assert.Nil(t, err)
+	condition := meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.HeadRecovered))
+	assert.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, rayv1.HeadPodRecovering, condition.Reason)
+
+	// The restarted head Pod is ready.
+	headPod.Status = corev1.PodStatus{
+		Phase:      corev1.PodRunning,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+	}
+	err = fakeClient.Status().Update(ctx, headPod)
+	assert.Nil(t, err)
+	newInstance, err = r.calculateStatus(ctx, testRayCluster, nil)
+	assert.Nil(t, err)
+	condition = meta.FindStatusCondition(newInstance.Status.Conditions, string(rayv1.HeadRecovered))
+	assert.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, rayv1.HeadPodRecovered, condition.Reason)
+}
+
 func TestStateTransitionTimes_NoStateChange(t *testing.T) {
 	setupTest(t)
 
@@ -2926,43 +3161,498 @@ func TestReconcile_NumOfHosts(t *testing.T) {
 	}
 }
 
-func TestSumGPUs(t *testing.T) {
-	nvidiaGPUResourceName := corev1.ResourceName("nvidia.com/gpu")
-	googleTPUResourceName := corev1.ResourceName("google.com/tpu")
+func TestReconcile_MultihostReplicaAtomicity(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	newCluster := func(replicas int32) *rayv1.RayCluster {
+		cluster := testRayCluster.DeepCopy()
+		cluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = nil
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To(replicas)
+		cluster.Spec.WorkerGroupSpecs[0].NumOfHosts = 2
+		return cluster
+	}
+	// newReconciler returns a reconciler whose worker group has 2 replicas of 2 hosts each.
+	newReconciler := func() *RayClusterReconciler {
+		r := &RayClusterReconciler{
+			Client:   clientFake.NewClientBuilder().WithRuntimeObjects(testPods[0]).Build(),
+			Recorder: &record.FakeRecorder{},
+			Scheme:   scheme.Scheme,
+		}
+		err := r.reconcilePods(ctx, newCluster(2))
+		assert.Nil(t, err)
+		return r
+	}
+	// listReplicas returns the names of the worker Pods grouped by their replica index.
+	listReplicas := func(r *RayClusterReconciler) map[string][]string {
+		podList := corev1.PodList{}
+		err := r.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+		assert.Nil(t, err)
+		replicas := make(map[string][]string)
+		for _, pod := range podList.Items {
+			index := pod.Labels[utils.RayWorkerReplicaIndexKey]
+			replicas[index] = append(replicas[index], pod.Name)
+		}
+		return replicas
+	}
+
+	t.Run("Hosts of a replica are created together and share the replica index", func(t *testing.T) {
+		r := newReconciler()
+		podList := corev1.PodList{}
+		err := r.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(podList.Items))
+		hosts := make(map[string][]string)
+		for _, pod := range podList.Items {
+			index := pod.Labels[utils.RayWorkerReplicaIndexKey]
+			hosts[index] = append(hosts[index], pod.Labels[utils.RayHostIndexKey])
+		}
+		assert.Equal(t, 2, len(hosts))
+		assert.ElementsMatch(t, []string{"0", "1"}, hosts["0"])
+		assert.ElementsMatch(t, []string{"0", "1"}, hosts["1"])
+	})
+
+	t.Run("A failed host recreates its whole replica", func(t *testing.T) {
+		r := newReconciler()
+		failedPodName := listReplicas(r)["0"][0]
+		pod := &corev1.Pod{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: failedPodName}, pod)
+		assert.Nil(t, err)
+		pod.Status.Phase = corev1.PodFailed
+		err = r.Status().Update(ctx, pod)
+		assert.Nil(t, err)
+
+		err = r.reconcilePods(ctx, newCluster(2))
+		assert.ErrorContains(t, err, "delete 2 unhealthy worker Pods")
+		replicas := listReplicas(r)
+		assert.NotContains(t, replicas, "0")
+		assert.Equal(t, 2, len(replicas["1"]))
+
+		// The replica is recreated with the smallest unused index.
+		err = r.reconcilePods(ctx, newCluster(2))
+		assert.Nil(t, err)
+		replicas = listReplicas(r)
+		assert.Equal(t, 2, len(replicas["0"]))
+		assert.Equal(t, 2, len(replicas["1"]))
+	})
+
+	t.Run("A missing host recreates its whole replica", func(t *testing.T) {
+		r := newReconciler()
+		pod := &corev1.Pod{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: listReplicas(r)["1"][0]}, pod)
+		assert.Nil(t, err)
+		err = r.Delete(ctx, pod)
+		assert.Nil(t, err)
+
+		err = r.reconcilePods(ctx, newCluster(2))
+		assert.Nil(t, err)
+		replicas := listReplicas(r)
+		assert.Equal(t, 2, len(replicas))
+		assert.Equal(t, 2, len(replicas["0"]))
+		assert.NotContains(t, replicas["1"], pod.Name)
+		assert.Equal(t, 2, len(replicas["2"]))
+	})
+
+	t.Run("A replica that is being created is neither deleted nor replaced", func(t *testing.T) {
+		r := newReconciler()
+		// Simulate the informer cache lagging behind the creation of replica 1 by hiding one of its hosts.
+		pod := &corev1.Pod{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: listReplicas(r)["1"][0]}, pod)
+		assert.Nil(t, err)
+		err = r.Delete(ctx, pod)
+		assert.Nil(t, err)
+		err = r.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: listReplicas(r)["1"][0]}, pod)
+		assert.Nil(t, err)
+		pod.CreationTimestamp = metav1.Now()
+		err = r.Update(ctx, pod)
+		assert.Nil(t, err)
+		replicas := listReplicas(r)
+
+		err = r.reconcilePods(ctx, newCluster(2))
+		assert.Nil(t, err)
+		assert.Equal(t, replicas, listReplicas(r))
+	})
+
+	t.Run("WorkersToDelete deletes every host of the replica", func(t *testing.T) {
+		r := newReconciler()
+		replicas := listReplicas(r)
+		cluster := newCluster(1)
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{replicas["1"][0]}
+
+		err := r.reconcilePods(ctx, cluster)
+		assert.Nil(t, err)
+		assert.Equal(t, map[string][]string{"0": replicas["0"]}, listReplicas(r))
+	})
+
+	t.Run("Scale-down deletes whole replicas", func(t *testing.T) {
+		r := newReconciler()
+		err := r.reconcilePods(ctx, newCluster(1))
+		assert.Nil(t, err)
+		replicas := listReplicas(r)
+		assert.Equal(t, 1, len(replicas))
+		for _, pods := range replicas {
+			assert.Equal(t, 2, len(pods))
+		}
+	})
+}
+
+func TestExpandToWorkerReplicas(t *testing.T) {
+	newPod := func(name string, replicaIndex string) corev1.Pod {
+		pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+		if replicaIndex != "" {
+			pod.Labels[utils.RayWorkerReplicaIndexKey] = replicaIndex
+		}
+		return pod
+	}
+	pods := []corev1.Pod{
+		newPod("a0", "0"), newPod("b0", "1"), newPod("a1", "0"), newPod("b1", "1"),
+		// Pods created before the replica index label are grouped in the order in which they are listed.
+		newPod("c", ""), newPod("d", ""), newPod("e", ""),
+	}
+
+	assert.Equal(t, []string{"a0", "a1"}, expandToWorkerReplicas([]string{"a1"}, pods, 2))
+	assert.Equal(t, []string{"a0", "a1", "b0", "b1"}, expandToWorkerReplicas([]string{"b0", "a0"}, pods, 2))
+	assert.Equal(t, []string{"c", "d"}, expandToWorkerReplicas([]string{"d"}, pods, 2))
+	assert.Equal(t, []string{"e"}, expandToWorkerReplicas([]string{"e"}, pods, 2))
+	assert.Equal(t, []string{"a0", "a1", "deleted"}, expandToWorkerReplicas([]string{"deleted", "a0"}, pods, 2))
+	assert.Empty(t, expandToWorkerReplicas(nil, pods, 2))
+}
+
+func TestReconcile_UpgradeStrategy(t *testing.T) {
+	setupTest(t)
+
+	// This test makes some assumptions about the testRayCluster object.
+	// (1) 1 workerGroup (2) disable autoscaling
+	assert.Equal(t, 1, len(testRayCluster.Spec.WorkerGroupSpecs), "This test assumes only one worker group.")
+
+	testRayCluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	testRayCluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	testRayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](4)
+
+	tests := map[string]struct {
+		upgradeStrategy          *rayv1.RayClusterUpgradeStrategy
+		expectedUpdatedReplicas  int32
+		expectedOutdatedReplicas int32
+		numOfHosts               int32
+		expectRequeue            bool
+	}{
+		"No upgrade strategy": {
+			// The existing worker Pods are kept as they are.
+			upgradeStrategy:          nil,
+			expectedUpdatedReplicas:  0,
+			expectedOutdatedReplicas: 4,
+		},
+		"Recreate": {
+			// All outdated worker Pods are deleted at once, and replaced once they are gone.
+			upgradeStrategy: &rayv1.RayClusterUpgradeStrategy{
+				Type: ptr.To(rayv1.Recreate),
+			},
+			expectedUpdatedReplicas:  4,
+			expectedOutdatedReplicas: 0,
+			expectRequeue:            true,
+		},
+		"RollingUpdate with maxSurge": {
+			// One worker Pod is created from the new template before any outdated worker Pod is deleted.
+			upgradeStrategy: &rayv1.RayClusterUpgradeStrategy{
+				Type: ptr.To(rayv1.RollingUpdate),
+				RollingUpdate: &rayv1.RollingUpdateConfig{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				},
+			},
+			expectedUpdatedReplicas:  1,
+			expectedOutdatedReplicas: 4,
+		},
+		"RollingUpdate with maxUnavailable": {
+			// Two outdated worker Pods are deleted, and the replacements are created in the next reconciliation.
+			upgradeStrategy: &rayv1.RayClusterUpgradeStrategy{
+				Type: ptr.To(rayv1.RollingUpdate),
+				RollingUpdate: &rayv1.RollingUpdateConfig{
+					MaxSurge:       ptr.To(intstr.FromInt32(0)),
+					MaxUnavailable: ptr.To(intstr.FromString("50%")),
+				},
+			},
+			expectedUpdatedReplicas:  0,
+			expectedOutdatedReplicas: 2,
+		},
+		"RollingUpdate of a multi-host worker group": {
+			// One replica with both of its hosts is created from the new template before any outdated replica is deleted.
+			upgradeStrategy: &rayv1.RayClusterUpgradeStrategy{
+				Type: ptr.To(rayv1.RollingUpdate),
+				RollingUpdate: &rayv1.RollingUpdateConfig{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				},
+			},
+			numOfHosts:               2,
+			expectedUpdatedReplicas:  2,
+			expectedOutdatedReplicas: 8,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cluster := testRayCluster.DeepCopy()
+			numOfHosts := max(tc.numOfHosts, 1)
+			cluster.Spec.WorkerGroupSpecs[0].NumOfHosts = numOfHosts
+
+			// The fake client will start with 1 head Pod and 0 worker Pods.
+			fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(testPods[0]).Build()
+			ctx := context.Background()
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:   fakeClient,
+				Recorder: &record.FakeRecorder{},
+				Scheme:   scheme.Scheme,
+			}
+
+			// Create the worker Pods and mark them as running and ready.
+			err := testRayClusterReconciler.reconcilePods(ctx, cluster)
+			assert.Nil(t, err, "Fail to reconcile Pods")
+			podList := corev1.PodList{}
+			err = fakeClient.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+			assert.Nil(t, err, "Fail to get pod list")
+			assert.Equal(t, 4*int(numOfHosts), len(podList.Items))
+			for _, pod := range podList.Items {
+				pod.Status.Phase = corev1.PodRunning
+				pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+				err = fakeClient.Status().Update(ctx, &pod)
+				assert.Nil(t, err, "Fail to update Pod status")
+			}
+
+			// Change the worker group template.
+			cluster.Spec.WorkerGroupSpecs[0].Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+			cluster.Spec.UpgradeStrategy = tc.upgradeStrategy
+			err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+			if tc.expectRequeue {
+				// No replacement is created in the same reconciliation that deletes the outdated Pods.
+				assert.ErrorIs(t, err, errWaitForWorkerPods)
+				err = fakeClient.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+				assert.Nil(t, err, "Fail to get pod list")
+				assert.Empty(t, podList.Items)
+				err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+			}
+			assert.Nil(t, err, "Fail to reconcile Pods")
+
+			err = fakeClient.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+			assert.Nil(t, err, "Fail to get pod list")
+			statuses := utils.CalculateWorkerGroupStatuses(ctx, cluster, podList)
+			assert.Equal(t, 1, len(statuses))
+			assert.Equal(t, groupNameStr, statuses[0].GroupName)
+			assert.Equal(t, tc.expectedUpdatedReplicas, statuses[0].UpdatedReplicas)
+			assert.Equal(t, tc.expectedOutdatedReplicas, statuses[0].OutdatedReplicas)
+		})
+	}
+}
+
+func TestReconcile_RemovedWorkerGroup(t *testing.T) {
+	setupTest(t)
+
+	// This test makes some assumptions about the testRayCluster object.
+	// (1) 1 workerGroup (2) disable autoscaling
+	assert.Equal(t, 1, len(testRayCluster.Spec.WorkerGroupSpecs), "This test assumes only one worker group.")
+
+	testRayCluster.Spec.EnableInTreeAutoscaling = ptr.To(false)
+	testRayCluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{}
+	testRayCluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](2)
 
 	tests := map[string]struct {
-		input    map[corev1.ResourceName]resource.Quantity
-		expected resource.Quantity
+		upgradeStrategy       *rayv1.RayClusterUpgradeStrategy
+		expectedNumWorkerPods int
 	}{
-		"no GPUs specified": {
-			map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU: resource.MustParse("1"),
+		"No upgrade strategy": {
+			// The worker Pods of the removed worker group are kept.
+			upgradeStrategy:       nil,
+			expectedNumWorkerPods: 2,
+		},
+		"Recreate": {
+			upgradeStrategy: &rayv1.RayClusterUpgradeStrategy{
+				Type: ptr.To(rayv1.Recreate),
 			},
-			resource.MustParse("0"),
+			expectedNumWorkerPods: 0,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cluster := testRayCluster.DeepCopy()
+
+			// The fake client will start with 1 head Pod and 0 worker Pods.
+			fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(testPods[0]).Build()
+			ctx := context.Background()
+			testRayClusterReconciler := &RayClusterReconciler{
+				Client:   fakeClient,
+				Recorder: &record.FakeRecorder{},
+				Scheme:   scheme.Scheme,
+			}
+
+			err := testRayClusterReconciler.reconcilePods(ctx, cluster)
+			assert.Nil(t, err, "Fail to reconcile Pods")
+			podList := corev1.PodList{}
+			err = fakeClient.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+			assert.Nil(t, err, "Fail to get pod list")
+			assert.Equal(t, 2, len(podList.Items))
+
+			// Remove the worker group.
+			cluster.Spec.WorkerGroupSpecs = nil
+			cluster.Spec.UpgradeStrategy = tc.upgradeStrategy
+			err = testRayClusterReconciler.reconcilePods(ctx, cluster)
+			assert.Nil(t, err, "Fail to reconcile Pods")
+
+			err = fakeClient.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+			assert.Nil(t, err, "Fail to get pod list")
+			assert.Equal(t, tc.expectedNumWorkerPods, len(podList.Items))
+		})
+	}
+}
+
+func TestGetRollingUpdateLimits(t *testing.T) {
+	tests := map[string]struct {
+		rollingUpdate          *rayv1.RollingUpdateConfig
+		numExpectedPods        int
+		expectedMaxSurge       int
+		expectedMaxUnavailable int
+	}{
+		"Default values": {
+			rollingUpdate:          nil,
+			numExpectedPods:        10,
+			expectedMaxSurge:       3,
+			expectedMaxUnavailable: 2,
+		},
+		"Absolute values": {
+			rollingUpdate: &rayv1.RollingUpdateConfig{
+				MaxSurge:       ptr.To(intstr.FromInt32(2)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			},
+			numExpectedPods:        10,
+			expectedMaxSurge:       2,
+			expectedMaxUnavailable: 0,
+		},
+		"Both values are rounded down to zero": {
+			rollingUpdate: &rayv1.RollingUpdateConfig{
+				MaxSurge:       ptr.To(intstr.FromInt32(0)),
+				MaxUnavailable: ptr.To(intstr.FromString("10%")),
+			},
+			numExpectedPods:        5,
+			expectedMaxSurge:       0,
+			expectedMaxUnavailable: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			maxSurge, maxUnavailable, err := getRollingUpdateLimits(tc.rollingUpdate, tc.numExpectedPods)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expectedMaxSurge, maxSurge)
+			assert.Equal(t, tc.expectedMaxUnavailable, maxUnavailable)
+		})
+	}
+}
+
+func TestGetRollingUpdateBatch(t *testing.T) {
+	newPod := func(name string, ready bool) corev1.Pod {
+		pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if ready {
+			pod.Status.Phase = corev1.PodRunning
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return pod
+	}
+	// newPods returns n single-host replicas.
+	newPods := func(prefix string, n int, ready bool) []workerReplica {
+		replicas := make([]workerReplica, 0, n)
+		for i := 0; i < n; i++ {
+			name := fmt.Sprintf("%s-%d", prefix, i)
+			replicas = append(replicas, workerReplica{index: name, pods: []corev1.Pod{newPod(name, ready)}})
+		}
+		return replicas
+	}
+
+	tests := map[string]struct {
+		updatedPods             []workerReplica
+		outdatedPods            []workerReplica
+		numExpectedPods         int
+		maxSurge                int
+		maxUnavailable          int
+		expectedNumPodsToCreate int
+		expectedNumPodsToDelete int
+	}{
+		"Surge before deleting": {
+			outdatedPods:            newPods("outdated", 4, true),
+			numExpectedPods:         4,
+			maxSurge:                1,
+			maxUnavailable:          0,
+			expectedNumPodsToCreate: 1,
+			expectedNumPodsToDelete: 0,
+		},
+		"Delete outdated Pods once the surge Pod is ready": {
+			updatedPods:             newPods("updated", 1, true),
+			outdatedPods:            newPods("outdated", 4, true),
+			numExpectedPods:         4,
+			maxSurge:                1,
+			maxUnavailable:          0,
+			expectedNumPodsToCreate: 0,
+			expectedNumPodsToDelete: 1,
 		},
-		"one GPU type specified": {
-			map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU:    resource.MustParse("1"),
-				nvidiaGPUResourceName: resource.MustParse("1"),
-				googleTPUResourceName: resource.MustParse("1"),
+		"Wait for the surge Pod to be ready": {
+			updatedPods:             newPods("updated", 1, false),
+			outdatedPods:            newPods("outdated", 4, true),
+			numExpectedPods:         4,
+			maxSurge:                1,
+			maxUnavailable:          0,
+			expectedNumPodsToCreate: 0,
+			expectedNumPodsToDelete: 0,
+		},
+		"Replace Pods that are deleted due to maxUnavailable": {
+			updatedPods:             newPods("updated", 1, true),
+			outdatedPods:            newPods("outdated", 2, true),
+			numExpectedPods:         4,
+			maxSurge:                0,
+			maxUnavailable:          1,
+			expectedNumPodsToCreate: 1,
+			expectedNumPodsToDelete: 0,
+		},
+		"Outdated Pods that are not ready are always deleted": {
+			outdatedPods:            append(newPods("outdated-ready", 2, true), newPods("outdated-pending", 2, false)...),
+			numExpectedPods:         4,
+			maxSurge:                0,
+			maxUnavailable:          1,
+			expectedNumPodsToCreate: 0,
+			expectedNumPodsToDelete: 2,
+		},
+		"A multi-host replica is only ready if all its hosts are ready": {
+			updatedPods: []workerReplica{
+				{index: "updated-0", pods: []corev1.Pod{newPod("updated-0-0", true), newPod("updated-0-1", false)}},
 			},
-			resource.MustParse("1"),
-		},
-		"multiple GPUs specified": {
-			map[corev1.ResourceName]resource.Quantity{
-				corev1.ResourceCPU:                 resource.MustParse("1"),
-				nvidiaGPUResourceName:              resource.MustParse("3"),
-				corev1.ResourceName("foo.bar/gpu"): resource.MustParse("2"),
-				googleTPUResourceName:              resource.MustParse("1"),
+			outdatedPods: []workerReplica{
+				{index: "outdated-0", pods: []corev1.Pod{newPod("outdated-0-0", true), newPod("outdated-0-1", true)}},
+				{index: "outdated-1", pods: []corev1.Pod{newPod("outdated-1-0", true), newPod("outdated-1-1", true)}},
 			},
-			resource.MustParse("5"),
+			numExpectedPods:         2,
+			maxSurge:                1,
+			maxUnavailable:          0,
+			expectedNumPodsToCreate: 0,
+			expectedNumPodsToDelete: 0,
+		},
+		"Scale down while updating": {
+			updatedPods:             newPods("updated", 2, true),
+			outdatedPods:            newPods("outdated", 2, true),
+			numExpectedPods:         2,
+			maxSurge:                1,
+			maxUnavailable:          0,
+			expectedNumPodsToCreate: 0,
+			expectedNumPodsToDelete: 2,
 		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			result := sumGPUs(tc.input)
-			assert.True(t, tc.expected.Equal(result), "GPU number is wrong")
+			numPodsToCreate, podsToDelete := getRollingUpdateBatch(tc.numExpectedPods, tc.maxSurge, tc.maxUnavailable, tc.updatedPods, tc.outdatedPods)
+			assert.Equal(t, tc.expectedNumPodsToCreate, numPodsToCreate)
+			assert.Equal(t, tc.expectedNumPodsToDelete, len(podsToDelete))
+			for _, replica := range podsToDelete {
+				assert.True(t, strings.HasPrefix(replica.index, "outdated"), "Only outdated replicas should be deleted")
+			}
 		})
 	}
 }
@@ -3027,6 +3717,288 @@ func TestDeleteAllPods(t *testing.T) {
 	assert.Subset(t, []string{"deleted", "other"}, []string{pods.Items[0].Name, pods.Items[1].Name})
 }
 
+func TestReconcile_DrainWorkerPods(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+	workerNodeIP := "10.0.0.1"
+
+	newCluster := func() *rayv1.RayCluster {
+		cluster := testRayCluster.DeepCopy()
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.DrainGracePeriodSeconds = ptr.To[int32](60)
+		return cluster
+	}
+	// newReconciler returns a reconciler whose dashboard reports a Ray node for pod1, the fake clients it uses, and
+	// the Pods it manages. `drainStartTime` is set as the drain start time annotation of pod1 if it is not empty.
+	newReconciler := func(cluster *rayv1.RayCluster, drainStartTime string) (*RayClusterReconciler, *utils.FakeRayDashboardClient, *utils.FakeRayGcsClient, *record.FakeRecorder) {
+		headSvcName, err := utils.GenerateHeadServiceName(utils.RayClusterCRD, cluster.Spec, cluster.Name)
+		assert.Nil(t, err)
+		headSvc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: headSvcName, Namespace: namespaceStr},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{Name: utils.DashboardPortName, Port: utils.DefaultDashboardPort},
+					// The GCS server port is found from the head rayStartParams, whatever its Service port is named.
+					{Name: "gcs-server", Port: utils.DefaultRedisPort},
+				},
+			},
+		}
+		objects := []runtime.Object{headSvc}
+		for _, obj := range testPods {
+			pod := obj.(*corev1.Pod).DeepCopy()
+			if pod.Name == "pod1" {
+				pod.Status.PodIP = workerNodeIP
+				if drainStartTime != "" {
+					pod.Annotations = map[string]string{utils.RayNodeDrainStartTimeAnnotationKey: drainStartTime}
+				}
+			}
+			objects = append(objects, pod)
+		}
+		fakeDashboardClient := &utils.FakeRayDashboardClient{}
+		fakeDashboardClient.SetNodes([]utils.RayNodeInfo{{NodeId: "node1", NodeIP: workerNodeIP, State: "ALIVE"}})
+		fakeGcsClient := &utils.FakeRayGcsClient{}
+		recorder := record.NewFakeRecorder(100)
+		return &RayClusterReconciler{
+			Client:   clientFake.NewClientBuilder().WithRuntimeObjects(objects...).Build(),
+			Recorder: recorder,
+			Scheme:   scheme.Scheme,
+			dashboardClientFunc: func() utils.RayDashboardClientInterface {
+				return fakeDashboardClient
+			},
+			gcsClient: fakeGcsClient,
+		}, fakeDashboardClient, fakeGcsClient, recorder
+	}
+	getPod := func(r *RayClusterReconciler, name string) (*corev1.Pod, error) {
+		pod := &corev1.Pod{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: namespaceStr, Name: name}, pod)
+		return pod, err
+	}
+	hasEvent := func(recorder *record.FakeRecorder, eventType utils.K8sEventType) bool {
+		for len(recorder.Events) > 0 {
+			if strings.Contains(<-recorder.Events, string(eventType)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("WorkersToDelete are deleted after their Ray nodes are drained", func(t *testing.T) {
+		cluster := newCluster()
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{"pod1"}
+		r, fakeDashboardClient, fakeGcsClient, recorder := newReconciler(cluster, "")
+
+		// The first reconciliation asks Ray to drain the node and keeps the Pod.
+		err := r.reconcilePods(ctx, cluster)
+		assert.ErrorContains(t, err, "1 worker Pods are draining")
+		assert.Equal(t, []string{"node1"}, fakeGcsClient.GetDrainedNodes())
+		assert.Equal(t, []string{utils.GenerateFQDNServiceName(ctx, *cluster, cluster.Namespace) + ":6379"}, fakeGcsClient.GetGcsAddresses())
+		assert.True(t, hasEvent(recorder, utils.DrainingWorkerPod))
+		pod, err := getPod(r, "pod1")
+		assert.Nil(t, err)
+		assert.Contains(t, pod.Annotations, utils.RayNodeDrainStartTimeAnnotationKey)
+
+		// The Pod is kept while the Ray node is alive, even if the autoscaler removes it from WorkersToDelete.
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = nil
+		err = r.reconcilePods(ctx, cluster)
+		assert.ErrorContains(t, err, "1 worker Pods are draining")
+		_, err = getPod(r, "pod1")
+		assert.Nil(t, err)
+
+		// The Pod is deleted once the Ray node is dead.
+		fakeDashboardClient.SetNodes([]utils.RayNodeInfo{{NodeId: "node1", NodeIP: workerNodeIP, State: utils.RayNodeStateDead}})
+		err = r.reconcilePods(ctx, cluster)
+		assert.Nil(t, err)
+		assert.True(t, hasEvent(recorder, utils.DrainedWorkerPod))
+		_, err = getPod(r, "pod1")
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Equal(t, []string{"node1"}, fakeGcsClient.GetDrainedNodes())
+	})
+
+	t.Run("Worker Pods are deleted when the drain grace period elapses", func(t *testing.T) {
+		cluster := newCluster()
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{"pod1"}
+		r, _, fakeGcsClient, recorder := newReconciler(cluster, time.Now().Add(-2*time.Minute).UTC().Format(time.RFC3339))
+
+		err := r.reconcilePods(ctx, cluster)
+		assert.Nil(t, err)
+		assert.True(t, hasEvent(recorder, utils.DrainedWorkerPod))
+		_, err = getPod(r, "pod1")
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Empty(t, fakeGcsClient.GetDrainedNodes())
+	})
+
+	t.Run("Worker Pods are deleted without draining if the grace period is not set", func(t *testing.T) {
+		cluster := newCluster()
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.DrainGracePeriodSeconds = nil
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = []string{"pod1"}
+		r, _, fakeGcsClient, _ := newReconciler(cluster, "")
+
+		err := r.reconcilePods(ctx, cluster)
+		assert.Nil(t, err)
+		_, err = getPod(r, "pod1")
+		assert.True(t, k8serrors.IsNotFound(err))
+		assert.Empty(t, fakeGcsClient.GetDrainedNodes())
+	})
+
+	t.Run("Random scale-down drains the Ray nodes", func(t *testing.T) {
+		cluster := newCluster()
+		cluster.Spec.EnableInTreeAutoscaling = nil
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = nil
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](4)
+		r, _, fakeGcsClient, _ := newReconciler(cluster, "")
+
+		// pod1 is the first Pod in the list, so it is the one selected for the random deletion.
+		err := r.reconcilePods(ctx, cluster)
+		assert.ErrorContains(t, err, "1 worker Pods are draining")
+		assert.Equal(t, []string{"node1"}, fakeGcsClient.GetDrainedNodes())
+
+		// The draining Pod is not counted as a running Pod, so no other Pod is selected.
+		err = r.reconcilePods(ctx, cluster)
+		assert.ErrorContains(t, err, "1 worker Pods are draining")
+		podList := corev1.PodList{}
+		err = r.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+		assert.Nil(t, err)
+		assert.Equal(t, 5, len(podList.Items))
+	})
+
+	t.Run("Recreate drains the outdated worker Pods before replacing them", func(t *testing.T) {
+		cluster := newCluster()
+		cluster.Spec.EnableInTreeAutoscaling = nil
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = nil
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](5)
+		cluster.Spec.UpgradeStrategy = &rayv1.RayClusterUpgradeStrategy{Type: ptr.To(rayv1.Recreate)}
+		r, fakeDashboardClient, fakeGcsClient, _ := newReconciler(cluster, "")
+		pod, err := getPod(r, "pod1")
+		assert.Nil(t, err)
+		pod.Annotations = map[string]string{utils.RayPodTemplateHashKey: "outdated-hash"}
+		err = r.Update(ctx, pod)
+		assert.Nil(t, err)
+
+		// The outdated Pod is drained, and no replacement is created while it drains. Waiting for it isn't an error.
+		err = r.reconcilePods(ctx, cluster)
+		assert.ErrorIs(t, err, errWaitForWorkerPods)
+		assert.Equal(t, []string{"node1"}, fakeGcsClient.GetDrainedNodes())
+		podList := corev1.PodList{}
+		err = r.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+		assert.Nil(t, err)
+		assert.Equal(t, 5, len(podList.Items))
+
+		// The outdated Pod is deleted once its Ray node is dead, and replaced in the next reconciliation.
+		fakeDashboardClient.SetNodes([]utils.RayNodeInfo{{NodeId: "node1", NodeIP: workerNodeIP, State: utils.RayNodeStateDead}})
+		err = r.reconcilePods(ctx, cluster)
+		assert.ErrorIs(t, err, errWaitForWorkerPods)
+		_, err = getPod(r, "pod1")
+		assert.True(t, k8serrors.IsNotFound(err))
+		err = r.reconcilePods(ctx, cluster)
+		assert.Nil(t, err)
+		err = r.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+		assert.Nil(t, err)
+		assert.Equal(t, 5, len(podList.Items))
+	})
+
+	t.Run("RollingUpdate drains the outdated worker Pods", func(t *testing.T) {
+		cluster := newCluster()
+		cluster.Spec.EnableInTreeAutoscaling = nil
+		cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = nil
+		cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](5)
+		cluster.Spec.UpgradeStrategy = &rayv1.RayClusterUpgradeStrategy{
+			Type: ptr.To(rayv1.RollingUpdate),
+			RollingUpdate: &rayv1.RollingUpdateConfig{
+				MaxSurge:       ptr.To(intstr.FromInt32(0)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(1)),
+			},
+		}
+		r, _, fakeGcsClient, _ := newReconciler(cluster, "")
+		pod, err := getPod(r, "pod1")
+		assert.Nil(t, err)
+		pod.Annotations = map[string]string{utils.RayPodTemplateHashKey: "outdated-hash"}
+		err = r.Update(ctx, pod)
+		assert.Nil(t, err)
+
+		err = r.reconcilePods(ctx, cluster)
+		assert.ErrorIs(t, err, errWaitForWorkerPods)
+		assert.Equal(t, []string{"node1"}, fakeGcsClient.GetDrainedNodes())
+		_, err = getPod(r, "pod1")
+		assert.Nil(t, err)
+	})
+
+	t.Run("Suspension waits for the Ray nodes to drain", func(t *testing.T) {
+		features.SetFeatureGateDuringTest(t, features.RayClusterStatusConditions, false)
+		cluster := newCluster()
+		cluster.Spec.Suspend = ptr.To(true)
+		r, fakeDashboardClient, fakeGcsClient, _ := newReconciler(cluster, "")
+
+		err := r.reconcilePods(ctx, cluster)
+		assert.ErrorIs(t, err, errWaitForWorkerPods)
+		assert.ErrorContains(t, err, "1 worker Pods are draining before suspending the RayCluster")
+		assert.Equal(t, []string{"node1"}, fakeGcsClient.GetDrainedNodes())
+		podList := corev1.PodList{}
+		err = r.List(ctx, &podList, client.InNamespace(namespaceStr))
+		assert.Nil(t, err)
+		assert.Equal(t, len(testPods), len(podList.Items))
+
+		fakeDashboardClient.SetNodes([]utils.RayNodeInfo{{NodeId: "node1", NodeIP: workerNodeIP, State: utils.RayNodeStateDead}})
+		err = r.reconcilePods(ctx, cluster)
+		assert.Nil(t, err)
+		err = r.List(ctx, &podList, client.InNamespace(namespaceStr))
+		assert.Nil(t, err)
+		assert.Empty(t, podList.Items)
+	})
+}
+
+func TestReconcile_VictimSelectionPolicy(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	cluster := testRayCluster.DeepCopy()
+	cluster.Spec.EnableInTreeAutoscaling = nil
+	cluster.Spec.WorkerGroupSpecs[0].Replicas = ptr.To[int32](3)
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.WorkersToDelete = nil
+	cluster.Spec.WorkerGroupSpecs[0].ScaleStrategy.VictimSelectionPolicy = ptr.To(rayv1.IdleNodeVictimSelection)
+
+	headSvcName, err := utils.GenerateHeadServiceName(utils.RayClusterCRD, cluster.Spec, cluster.Name)
+	assert.Nil(t, err)
+	objects := []runtime.Object{&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: headSvcName, Namespace: namespaceStr},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: utils.DashboardPortName, Port: utils.DefaultDashboardPort}},
+		},
+	}}
+	// All worker Pods are ready except pod5, and the Ray node in pod3 is idle.
+	for i, obj := range testPods {
+		pod := obj.(*corev1.Pod).DeepCopy()
+		pod.Status.PodIP = fmt.Sprintf("10.0.0.%d", i)
+		if pod.Name != "pod5" {
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		objects = append(objects, pod)
+	}
+	fakeDashboardClient := &utils.FakeRayDashboardClient{}
+	fakeDashboardClient.SetNodes([]utils.RayNodeInfo{
+		{NodeId: "node2", NodeIP: "10.0.0.2", State: "ALIVE"},
+		{NodeId: "node3", NodeIP: "10.0.0.3", State: "ALIVE", StateSnapshot: utils.RayNodeStateSnapshot{State: utils.RayNodeSnapshotStateIdle, IdleDurationMs: 60000}},
+	})
+	r := &RayClusterReconciler{
+		Client:   clientFake.NewClientBuilder().WithRuntimeObjects(objects...).Build(),
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+		dashboardClientFunc: func() utils.RayDashboardClientInterface {
+			return fakeDashboardClient
+		},
+	}
+
+	err = r.reconcilePods(ctx, cluster)
+	assert.Nil(t, err)
+
+	podList := corev1.PodList{}
+	err = r.List(ctx, &podList, &client.ListOptions{LabelSelector: workerSelector, Namespace: namespaceStr})
+	assert.Nil(t, err)
+	podNames := []string{}
+	for _, pod := range podList.Items {
+		podNames = append(podNames, pod.Name)
+	}
+	assert.ElementsMatch(t, []string{"pod1", "pod2", "pod4"}, podNames)
+}
+
 func TestEvents_FailedPodCreation(t *testing.T) {
 	tests := []struct {
 		errInject error
@@ -0,0 +1,387 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const (
+	RayClusterPoolDefaultRequeueDuration = 3 * time.Second
+	// RayClusterPoolCreationExpectationTimeout is how long a created RayCluster that hasn't been observed in the informer
+	// cache is counted as an idle RayCluster of the pool.
+	RayClusterPoolCreationExpectationTimeout = time.Minute
+)
+
+// RayClusterPoolReconciler reconciles a RayClusterPool object
+type RayClusterPoolReconciler struct {
+	client.Client
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+	expectations rayClusterPoolExpectations
+}
+
+// rayClusterPoolExpectations records the RayClusters created by each RayClusterPool that haven't been observed in the
+// informer cache yet. Without them, a reconciliation based on a stale cache would create more RayClusters than `Spec.Size`.
+type rayClusterPoolExpectations struct {
+	createdRayClusters map[types.NamespacedName]map[string]time.Time
+	mu                 sync.Mutex
+}
+
+func (e *rayClusterPoolExpectations) expectCreation(rayClusterPool types.NamespacedName, rayClusterName string, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.createdRayClusters == nil {
+		e.createdRayClusters = map[types.NamespacedName]map[string]time.Time{}
+	}
+	if e.createdRayClusters[rayClusterPool] == nil {
+		e.createdRayClusters[rayClusterPool] = map[string]time.Time{}
+	}
+	e.createdRayClusters[rayClusterPool][rayClusterName] = now
+}
+
+// numPendingCreations forgets the created RayClusters that have been observed in rayClusters or whose expectations have
+// expired, and returns the number of the remaining ones.
+func (e *rayClusterPoolExpectations) numPendingCreations(rayClusterPool types.NamespacedName, rayClusters []*rayv1.RayCluster, now time.Time) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	createdRayClusters := e.createdRayClusters[rayClusterPool]
+	for _, rayCluster := range rayClusters {
+		delete(createdRayClusters, rayCluster.Name)
+	}
+	for name, createdTime := range createdRayClusters {
+		if now.Sub(createdTime) > RayClusterPoolCreationExpectationTimeout {
+			delete(createdRayClusters, name)
+		}
+	}
+	if len(createdRayClusters) == 0 {
+		delete(e.createdRayClusters, rayClusterPool)
+	}
+	return len(createdRayClusters)
+}
+
+func (e *rayClusterPoolExpectations) forget(rayClusterPool types.NamespacedName) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.createdRayClusters, rayClusterPool)
+}
+
+// NewRayClusterPoolReconciler returns a new reconcile.Reconciler
+func NewRayClusterPoolReconciler(_ context.Context, mgr manager.Manager) *RayClusterPoolReconciler {
+	return &RayClusterPoolReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("rayclusterpool-controller"),
+	}
+}
+
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile keeps `Spec.Size` idle RayClusters in a RayClusterPool. The RayClusters claimed by RayJobs that no longer
+// exist are released, the idle RayClusters created from an outdated template are replaced, and new RayClusters are
+// created whenever RayJobs claim idle ones.
+func (r *RayClusterPoolReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	rayClusterPool := &rayv1.RayClusterPool{}
+	if err := r.Get(ctx, request.NamespacedName, rayClusterPool); err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request. Stop reconciliation.
+			logger.Info("RayClusterPool resource not found. Ignoring since object must be deleted")
+			r.expectations.forget(request.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get RayClusterPool")
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+
+	if !rayClusterPool.DeletionTimestamp.IsZero() {
+		// The RayClusters of the pool are garbage collected by Kubernetes through their owner references.
+		logger.Info("RayClusterPool is being deleted", "DeletionTimestamp", rayClusterPool.DeletionTimestamp)
+		return ctrl.Result{}, nil
+	}
+
+	if err := validateRayClusterPoolSpec(rayClusterPool); err != nil {
+		logger.Error(err, "The RayClusterPool spec is invalid")
+		r.Recorder.Eventf(rayClusterPool, corev1.EventTypeWarning, string(utils.InvalidRayClusterPoolSpec),
+			"The RayClusterPool spec is invalid %s/%s: %v", rayClusterPool.Namespace, rayClusterPool.Name, err)
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+	templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+
+	// Please do NOT modify `originalRayClusterPool` in the following code.
+	originalRayClusterPool := rayClusterPool.DeepCopy()
+
+	rayClusters, err := listRayClusterPoolRayClusters(ctx, r.Client, rayClusterPool)
+	if err != nil {
+		logger.Error(err, "Failed to list the RayClusters of the RayClusterPool")
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+
+	var idleRayClusters, claimedRayClusters []*rayv1.RayCluster
+	for _, rayCluster := range rayClusters {
+		if !rayCluster.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if rayJobName, isClaimed := rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey]; isClaimed {
+			// A RayCluster stays claimed until the RayJob that claimed it releases it, unless the RayJob no longer
+			// exists, e.g., because it was deleted before it finished.
+			isRayJobDeleted, err := r.isRayJobDeleted(ctx, rayCluster.Namespace, rayJobName)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+			}
+			if !isRayJobDeleted {
+				claimedRayClusters = append(claimedRayClusters, rayCluster)
+				continue
+			}
+			logger.Info("The RayJob that claimed the RayCluster doesn't exist. Release the RayCluster.", "RayCluster", rayCluster.Name, "RayJob", rayJobName)
+			if err := r.releaseRayCluster(ctx, rayClusterPool, rayCluster); err != nil {
+				return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+			}
+			if _, isClaimed := rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey]; isClaimed {
+				// The RayCluster has been deleted instead of being returned to the pool.
+				continue
+			}
+		}
+		if rayCluster.Annotations[utils.RayClusterPoolTemplateHashAnnotationKey] != templateHash {
+			logger.Info("Replace the idle RayCluster created from an outdated template", "RayCluster", rayCluster.Name)
+			if err := r.deleteRayCluster(ctx, rayClusterPool, rayCluster); err != nil {
+				return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+			}
+			continue
+		}
+		idleRayClusters = append(idleRayClusters, rayCluster)
+	}
+
+	// The RayClusters created by previous reconciliations that aren't in the informer cache yet are counted as idle ones.
+	numPendingCreations := r.expectations.numPendingCreations(request.NamespacedName, rayClusters, time.Now())
+	if idleRayClusters, err = r.scaleIdleRayClusters(ctx, rayClusterPool, idleRayClusters, numPendingCreations, templateHash); err != nil {
+		return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+	}
+
+	updateRayClusterPoolStatus(rayClusterPool, idleRayClusters, claimedRayClusters)
+	if !equality.Semantic.DeepEqual(originalRayClusterPool.Status, rayClusterPool.Status) {
+		if err := r.Status().Update(ctx, rayClusterPool); err != nil {
+			logger.Info("Failed to update RayClusterPool status", "error", err)
+			return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, err
+		}
+	}
+	// Requeue periodically because the deletion of a claiming RayJob doesn't trigger a reconciliation of the pool.
+	return ctrl.Result{RequeueAfter: RayClusterPoolDefaultRequeueDuration}, nil
+}
+
+func (r *RayClusterPoolReconciler) isRayJobDeleted(ctx context.Context, namespace string, name string) (bool, error) {
+	rayJob := &rayv1.RayJob{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, rayJob); err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	return false, nil
+}
+
+// releaseRayCluster releases a claimed RayCluster according to the release policy of the pool. The RayCluster is either
+// returned to the pool by removing its claimed-by annotation, or deleted.
+func (r *RayClusterPoolReconciler) releaseRayCluster(ctx context.Context, rayClusterPool *rayv1.RayClusterPool, rayCluster *rayv1.RayCluster) error {
+	shouldReuse, err := shouldReuseRayClusterPoolRayCluster(rayClusterPool, rayCluster)
+	if err != nil {
+		return err
+	}
+	if !shouldReuse {
+		return r.deleteRayCluster(ctx, rayClusterPool, rayCluster)
+	}
+	rayJobName := rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey]
+	delete(rayCluster.Annotations, utils.RayClusterPoolClaimedByAnnotationKey)
+	if err := r.Update(ctx, rayCluster); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(rayClusterPool, corev1.EventTypeNormal, string(utils.ReleasedRayCluster),
+		"Returned RayCluster %s/%s claimed by the deleted RayJob %s to the pool", rayCluster.Namespace, rayCluster.Name, rayJobName)
+	return nil
+}
+
+// scaleIdleRayClusters creates or deletes idle RayClusters so that the pool has `Spec.Size` of them, and returns the idle
+// RayClusters after scaling. The idle RayClusters that aren't `Ready` are deleted first. The RayClusters whose creations
+// haven't been observed yet are counted towards `Spec.Size` when scaling up.
+func (r *RayClusterPoolReconciler) scaleIdleRayClusters(ctx context.Context, rayClusterPool *rayv1.RayClusterPool, idleRayClusters []*rayv1.RayCluster, numPendingCreations int, templateHash string) ([]*rayv1.RayCluster, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	size := int(rayClusterPool.Spec.Size)
+
+	if numPendingCreations > 0 {
+		logger.Info("Wait for the created RayClusters to be observed", "numPendingCreations", numPendingCreations)
+	}
+	for len(idleRayClusters)+numPendingCreations < size {
+		rayCluster, err := r.constructRayClusterForRayClusterPool(rayClusterPool, templateHash)
+		if err != nil {
+			return idleRayClusters, err
+		}
+		if err := r.Create(ctx, rayCluster); err != nil {
+			r.Recorder.Eventf(rayClusterPool, corev1.EventTypeWarning, string(utils.FailedToCreateRayCluster), "Failed to create RayCluster %s/%s: %v", rayCluster.Namespace, rayCluster.Name, err)
+			return idleRayClusters, err
+		}
+		r.expectations.expectCreation(types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: rayClusterPool.Name}, rayCluster.Name, time.Now())
+		logger.Info("Created an idle RayCluster for the pool", "RayCluster", rayCluster.Name)
+		r.Recorder.Eventf(rayClusterPool, corev1.EventTypeNormal, string(utils.CreatedRayCluster), "Created RayCluster %s/%s", rayCluster.Namespace, rayCluster.Name)
+		idleRayClusters = append(idleRayClusters, rayCluster)
+	}
+
+	if len(idleRayClusters) > size {
+		sort.SliceStable(idleRayClusters, func(i, j int) bool {
+			return idleRayClusters[i].Status.State != rayv1.Ready && idleRayClusters[j].Status.State == rayv1.Ready //nolint:staticcheck // https://github.com/ray-project/kuberay/pull/2288
+		})
+		for _, rayCluster := range idleRayClusters[:len(idleRayClusters)-size] {
+			logger.Info("Delete the idle RayCluster that exceeds the pool size", "RayCluster", rayCluster.Name, "Size", size)
+			if err := r.deleteRayCluster(ctx, rayClusterPool, rayCluster); err != nil {
+				return idleRayClusters, err
+			}
+		}
+		idleRayClusters = idleRayClusters[len(idleRayClusters)-size:]
+	}
+	return idleRayClusters, nil
+}
+
+// deleteRayCluster deletes the RayCluster only if it hasn't changed since it was listed. Otherwise, e.g., if a RayJob has
+// claimed the RayCluster in the meantime, the deletion fails with a conflict and the pool is reconciled again.
+func (r *RayClusterPoolReconciler) deleteRayCluster(ctx context.Context, rayClusterPool *rayv1.RayClusterPool, rayCluster *rayv1.RayCluster) error {
+	if err := r.Delete(ctx, rayCluster, client.Preconditions{ResourceVersion: &rayCluster.ResourceVersion}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if errors.IsConflict(err) {
+			return fmt.Errorf("RayCluster %s/%s has changed since it was listed: %w", rayCluster.Namespace, rayCluster.Name, err)
+		}
+		r.Recorder.Eventf(rayClusterPool, corev1.EventTypeWarning, string(utils.FailedToDeleteRayCluster), "Failed to delete cluster %s/%s: %v", rayCluster.Namespace, rayCluster.Name, err)
+		return err
+	}
+	r.Recorder.Eventf(rayClusterPool, corev1.EventTypeNormal, string(utils.DeletedRayCluster), "Deleted cluster %s/%s", rayCluster.Namespace, rayCluster.Name)
+	return nil
+}
+
+func (r *RayClusterPoolReconciler) constructRayClusterForRayClusterPool(rayClusterPool *rayv1.RayClusterPool, templateHash string) (*rayv1.RayCluster, error) {
+	labels := make(map[string]string, len(rayClusterPool.Labels)+2)
+	for key, value := range rayClusterPool.Labels {
+		labels[key] = value
+	}
+	labels[utils.RayOriginatedFromCRNameLabelKey] = rayClusterPool.Name
+	labels[utils.RayOriginatedFromCRDLabelKey] = utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD)
+
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: labels,
+			Annotations: map[string]string{
+				utils.RayClusterPoolTemplateHashAnnotationKey: templateHash,
+			},
+			Name:      utils.GenerateRayClusterName(rayClusterPool.Name),
+			Namespace: rayClusterPool.Namespace,
+		},
+		Spec: *rayClusterPool.Spec.Template.DeepCopy(),
+	}
+
+	// Set the ownership in order to do the garbage collection by k8s.
+	if err := ctrl.SetControllerReference(rayClusterPool, rayCluster, r.Scheme); err != nil {
+		return nil, err
+	}
+	return rayCluster, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RayClusterPoolReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurrency int) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayv1.RayClusterPool{}).
+		Owns(&rayv1.RayCluster{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: reconcileConcurrency,
+			LogConstructor: func(request *reconcile.Request) logr.Logger {
+				logger := ctrl.Log.WithName("controllers").WithName("RayClusterPool")
+				if request != nil {
+					logger = logger.WithValues("RayClusterPool", request.NamespacedName)
+				}
+				return logger
+			},
+		}).
+		Complete(r)
+}
+
+func updateRayClusterPoolStatus(rayClusterPool *rayv1.RayClusterPool, idleRayClusters []*rayv1.RayCluster, claimedRayClusters []*rayv1.RayCluster) {
+	status := &rayClusterPool.Status
+	status.ObservedGeneration = rayClusterPool.Generation
+	status.Idle = int32(len(idleRayClusters))       //nolint:gosec // The number of RayClusters in a pool doesn't overflow int32.
+	status.Claimed = int32(len(claimedRayClusters)) //nolint:gosec // The number of RayClusters in a pool doesn't overflow int32.
+	status.Ready = 0
+	for _, rayCluster := range idleRayClusters {
+		if rayCluster.Status.State == rayv1.Ready { //nolint:staticcheck // https://github.com/ray-project/kuberay/pull/2288
+			status.Ready++
+		}
+	}
+}
+
+// listRayClusterPoolRayClusters returns the RayClusters controlled by the RayClusterPool.
+func listRayClusterPoolRayClusters(ctx context.Context, c client.Client, rayClusterPool *rayv1.RayClusterPool) ([]*rayv1.RayCluster, error) {
+	rayClusterList := rayv1.RayClusterList{}
+	if err := c.List(ctx, &rayClusterList, common.RayClusterPoolRayClustersAssociationOptions(rayClusterPool).ToListOptions()...); err != nil {
+		return nil, err
+	}
+	rayClusters := make([]*rayv1.RayCluster, 0, len(rayClusterList.Items))
+	for i := range rayClusterList.Items {
+		if rayCluster := &rayClusterList.Items[i]; metav1.IsControlledBy(rayCluster, rayClusterPool) {
+			rayClusters = append(rayClusters, rayCluster)
+		}
+	}
+	return rayClusters, nil
+}
+
+// shouldReuseRayClusterPoolRayCluster returns whether a released RayCluster is returned to the pool. Otherwise, it is deleted.
+// Only the RayClusters created from the current template are reused, so that a RayJob never claims an outdated RayCluster.
+func shouldReuseRayClusterPoolRayCluster(rayClusterPool *rayv1.RayClusterPool, rayCluster *rayv1.RayCluster) (bool, error) {
+	if rayClusterPool.Spec.ReleasePolicy != rayv1.ReuseRayClusterPoolReleasePolicy {
+		return false, nil
+	}
+	templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+	if err != nil {
+		return false, err
+	}
+	return rayCluster.Annotations[utils.RayClusterPoolTemplateHashAnnotationKey] == templateHash, nil
+}
+
+func validateRayClusterPoolSpec(rayClusterPool *rayv1.RayClusterPool) error {
+	if rayClusterPool.Spec.Size < 0 {
+		return fmt.Errorf("size must be a non-negative integer")
+	}
+	if policy := rayClusterPool.Spec.ReleasePolicy; policy != "" && policy != rayv1.DeleteRayClusterPoolReleasePolicy && policy != rayv1.ReuseRayClusterPoolReleasePolicy {
+		return fmt.Errorf("releasePolicy value %s is invalid, valid options are %s or %s", policy, rayv1.DeleteRayClusterPoolReleasePolicy, rayv1.ReuseRayClusterPoolReleasePolicy)
+	}
+	// The RayClusters of a pool share the template, so they can't share the name of the head service.
+	if headSvc := rayClusterPool.Spec.Template.HeadGroupSpec.HeadService; headSvc != nil && headSvc.Name != "" {
+		return fmt.Errorf("template.headGroupSpec.headService.metadata.name should not be set")
+	}
+	return nil
+}
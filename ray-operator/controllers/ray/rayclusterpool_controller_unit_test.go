@@ -0,0 +1,565 @@
+package ray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	utils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestConstructRayClusterForRayClusterPool(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size: 2,
+			Template: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	rayCluster, err := (&RayClusterPoolReconciler{Scheme: newScheme}).constructRayClusterForRayClusterPool(rayClusterPool, "test-hash")
+	assert.NoError(t, err)
+
+	assert.Contains(t, rayCluster.Name, rayClusterPool.Name)
+	assert.Equal(t, "test", rayCluster.Labels["app"])
+	assert.Equal(t, rayClusterPool.Name, rayCluster.Labels[utils.RayOriginatedFromCRNameLabelKey])
+	assert.Equal(t, utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD), rayCluster.Labels[utils.RayOriginatedFromCRDLabelKey])
+	assert.Equal(t, "test-hash", rayCluster.Annotations[utils.RayClusterPoolTemplateHashAnnotationKey])
+	assert.NotContains(t, rayCluster.Annotations, utils.RayClusterPoolClaimedByAnnotationKey)
+	assert.True(t, metav1.IsControlledBy(rayCluster, rayClusterPool))
+	assert.Equal(t, rayClusterPool.Spec.Template, rayCluster.Spec)
+}
+
+func TestValidateRayClusterPoolSpec(t *testing.T) {
+	tests := map[string]struct {
+		mutate      func(rayClusterPool *rayv1.RayClusterPool)
+		expectError bool
+	}{
+		"valid RayClusterPool": {
+			mutate:      func(_ *rayv1.RayClusterPool) {},
+			expectError: false,
+		},
+		"negative size": {
+			mutate: func(rayClusterPool *rayv1.RayClusterPool) {
+				rayClusterPool.Spec.Size = -1
+			},
+			expectError: true,
+		},
+		"invalid release policy": {
+			mutate: func(rayClusterPool *rayv1.RayClusterPool) {
+				rayClusterPool.Spec.ReleasePolicy = "Keep"
+			},
+			expectError: true,
+		},
+		"head service name is set": {
+			mutate: func(rayClusterPool *rayv1.RayClusterPool) {
+				rayClusterPool.Spec.Template.HeadGroupSpec.HeadService = &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "head-svc"},
+				}
+			},
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayClusterPool := &rayv1.RayClusterPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-rayclusterpool",
+					Namespace: "default",
+					UID:       "test-rayclusterpool-uid",
+					Labels:    map[string]string{"app": "test"},
+				},
+				Spec: rayv1.RayClusterPoolSpec{
+					Size: 2,
+					Template: rayv1.RayClusterSpec{
+						HeadGroupSpec: rayv1.HeadGroupSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+								},
+							},
+						},
+					},
+				},
+			}
+			tc.mutate(rayClusterPool)
+			err := validateRayClusterPoolSpec(rayClusterPool)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReconcileRayClusterPool_Refill(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size: 2,
+			Template: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithObjects(rayClusterPool).WithStatusSubresource(rayClusterPool).Build()
+	r := &RayClusterPoolReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+	namespacedName := types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: rayClusterPool.Name}
+	rayClusterList := rayv1.RayClusterList{}
+
+	// The pool creates `Size` idle RayClusters from its template.
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.List(ctx, &rayClusterList, client.InNamespace(rayClusterPool.Namespace)))
+	assert.Len(t, rayClusterList.Items, 2)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayClusterPool))
+	assert.Equal(t, int32(2), rayClusterPool.Status.Idle)
+	assert.Equal(t, int32(0), rayClusterPool.Status.Ready)
+	assert.Equal(t, int32(0), rayClusterPool.Status.Claimed)
+
+	// A RayJob claims one of the RayClusters, and the pool creates a new one to replace it.
+	rayJob := &rayv1.RayJob{ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: rayClusterPool.Namespace}}
+	assert.NoError(t, fakeClient.Create(ctx, rayJob))
+	claimedRayCluster := rayClusterList.Items[0]
+	claimedRayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = rayJob.Name
+	assert.NoError(t, fakeClient.Update(ctx, &claimedRayCluster))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.List(ctx, &rayClusterList, client.InNamespace(rayClusterPool.Namespace)))
+	assert.Len(t, rayClusterList.Items, 3)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayClusterPool))
+	assert.Equal(t, int32(2), rayClusterPool.Status.Idle)
+	assert.Equal(t, int32(1), rayClusterPool.Status.Claimed)
+
+	// The pool deletes the idle RayClusters that exceed its size, and keeps the claimed one.
+	rayClusterPool.Spec.Size = 0
+	assert.NoError(t, fakeClient.Update(ctx, rayClusterPool))
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.List(ctx, &rayClusterList, client.InNamespace(rayClusterPool.Namespace)))
+	assert.Len(t, rayClusterList.Items, 1)
+	assert.Equal(t, claimedRayCluster.Name, rayClusterList.Items[0].Name)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayClusterPool))
+	assert.Equal(t, int32(0), rayClusterPool.Status.Idle)
+	assert.Equal(t, int32(1), rayClusterPool.Status.Claimed)
+}
+
+func TestScaleIdleRayClusters_PendingCreations(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size: 2,
+			Template: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithObjects(rayClusterPool).WithStatusSubresource(rayClusterPool).Build()
+	r := &RayClusterPoolReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+	namespacedName := types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: rayClusterPool.Name}
+
+	idleRayClusters, err := r.scaleIdleRayClusters(ctx, rayClusterPool, nil, 0, "test-hash")
+	assert.NoError(t, err)
+	assert.Len(t, idleRayClusters, 2)
+
+	// The informer cache hasn't observed the created RayClusters yet, so they are counted as idle ones.
+	numPendingCreations := r.expectations.numPendingCreations(namespacedName, nil, time.Now())
+	assert.Equal(t, 2, numPendingCreations)
+	idleRayClusters, err = r.scaleIdleRayClusters(ctx, rayClusterPool, nil, numPendingCreations, "test-hash")
+	assert.NoError(t, err)
+	assert.Empty(t, idleRayClusters)
+	rayClusterList := rayv1.RayClusterList{}
+	assert.NoError(t, fakeClient.List(ctx, &rayClusterList, client.InNamespace(rayClusterPool.Namespace)))
+	assert.Len(t, rayClusterList.Items, 2)
+
+	// The expectations are forgotten once the RayClusters are observed or the expectations expire.
+	assert.Equal(t, 1, r.expectations.numPendingCreations(namespacedName, []*rayv1.RayCluster{&rayClusterList.Items[0]}, time.Now()))
+	assert.Equal(t, 0, r.expectations.numPendingCreations(namespacedName, nil, time.Now().Add(2*RayClusterPoolCreationExpectationTimeout)))
+}
+
+func TestReconcileRayClusterPool_DeleteExcessRayClustersNotReadyFirst(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size: 1,
+			Template: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	// newRayCluster returns a RayCluster of the pool created from the current template of the pool. If `claimedBy`
+	// isn't empty, the RayCluster is claimed by the RayJob with that name.
+	newRayCluster := func(name string, state rayv1.ClusterState, claimedBy string) *rayv1.RayCluster {
+		templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+		assert.NoError(t, err)
+		rayCluster := &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: rayClusterPool.Namespace,
+				Labels: map[string]string{
+					utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+					utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+				},
+				Annotations: map[string]string{
+					utils.RayClusterPoolTemplateHashAnnotationKey: templateHash,
+				},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rayClusterPool, rayv1.GroupVersion.WithKind("RayClusterPool"))},
+			},
+			Spec:   *rayClusterPool.Spec.Template.DeepCopy(),
+			Status: rayv1.RayClusterStatus{State: state},
+		}
+		if claimedBy != "" {
+			rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = claimedBy
+		}
+		return rayCluster
+	}
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(rayClusterPool, newRayCluster("not-ready", "", ""), newRayCluster("ready", rayv1.Ready, "")).
+		WithStatusSubresource(rayClusterPool).
+		Build()
+	r := &RayClusterPoolReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+	namespacedName := types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: rayClusterPool.Name}
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	rayClusterList := rayv1.RayClusterList{}
+	assert.NoError(t, fakeClient.List(ctx, &rayClusterList, client.InNamespace(rayClusterPool.Namespace)))
+	assert.Len(t, rayClusterList.Items, 1)
+	assert.Equal(t, "ready", rayClusterList.Items[0].Name)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayClusterPool))
+	assert.Equal(t, int32(1), rayClusterPool.Status.Idle)
+	assert.Equal(t, int32(1), rayClusterPool.Status.Ready)
+}
+
+func TestDeleteRayCluster_ChangedSinceListed(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size: 0,
+			Template: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	// newRayCluster returns a RayCluster of the pool created from the current template of the pool. If `claimedBy`
+	// isn't empty, the RayCluster is claimed by the RayJob with that name.
+	newRayCluster := func(name string, state rayv1.ClusterState, claimedBy string) *rayv1.RayCluster {
+		templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+		assert.NoError(t, err)
+		rayCluster := &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: rayClusterPool.Namespace,
+				Labels: map[string]string{
+					utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+					utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+				},
+				Annotations: map[string]string{
+					utils.RayClusterPoolTemplateHashAnnotationKey: templateHash,
+				},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rayClusterPool, rayv1.GroupVersion.WithKind("RayClusterPool"))},
+			},
+			Spec:   *rayClusterPool.Spec.Template.DeepCopy(),
+			Status: rayv1.RayClusterStatus{State: state},
+		}
+		if claimedBy != "" {
+			rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = claimedBy
+		}
+		return rayCluster
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithObjects(rayClusterPool, newRayCluster("idle", rayv1.Ready, "")).Build()
+	r := &RayClusterPoolReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+
+	// A RayJob claims the idle RayCluster after the pool listed it.
+	staleRayCluster := &rayv1.RayCluster{}
+	assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: "idle"}, staleRayCluster))
+	claimedRayCluster := staleRayCluster.DeepCopy()
+	claimedRayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = "test-rayjob"
+	assert.NoError(t, fakeClient.Update(ctx, claimedRayCluster))
+
+	err := r.deleteRayCluster(ctx, rayClusterPool, staleRayCluster)
+	assert.True(t, k8serrors.IsConflict(err))
+	assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: "idle"}, claimedRayCluster))
+}
+
+func TestReconcileRayClusterPool_ReplaceOutdatedRayClusters(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size: 1,
+			Template: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	// newRayCluster returns a RayCluster of the pool created from the current template of the pool. If `claimedBy`
+	// isn't empty, the RayCluster is claimed by the RayJob with that name.
+	newRayCluster := func(name string, state rayv1.ClusterState, claimedBy string) *rayv1.RayCluster {
+		templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+		assert.NoError(t, err)
+		rayCluster := &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: rayClusterPool.Namespace,
+				Labels: map[string]string{
+					utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+					utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+				},
+				Annotations: map[string]string{
+					utils.RayClusterPoolTemplateHashAnnotationKey: templateHash,
+				},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rayClusterPool, rayv1.GroupVersion.WithKind("RayClusterPool"))},
+			},
+			Spec:   *rayClusterPool.Spec.Template.DeepCopy(),
+			Status: rayv1.RayClusterStatus{State: state},
+		}
+		if claimedBy != "" {
+			rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = claimedBy
+		}
+		return rayCluster
+	}
+	idleRayCluster := newRayCluster("idle", rayv1.Ready, "")
+	claimedRayCluster := newRayCluster("claimed", rayv1.Ready, "test-rayjob")
+	rayJob := &rayv1.RayJob{ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: rayClusterPool.Namespace}}
+
+	// Change the template after the RayClusters were created.
+	rayClusterPool.Spec.Template.HeadGroupSpec.Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(rayClusterPool, idleRayCluster, claimedRayCluster, rayJob).
+		WithStatusSubresource(rayClusterPool).
+		Build()
+	r := &RayClusterPoolReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+	namespacedName := types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: rayClusterPool.Name}
+
+	// The outdated idle RayCluster is replaced, while the claimed one is kept until it is released.
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	rayClusterList := rayv1.RayClusterList{}
+	assert.NoError(t, fakeClient.List(ctx, &rayClusterList, client.InNamespace(rayClusterPool.Namespace)))
+	assert.Len(t, rayClusterList.Items, 2)
+	for _, rayCluster := range rayClusterList.Items {
+		assert.NotEqual(t, "idle", rayCluster.Name)
+		if rayCluster.Name != "claimed" {
+			assert.Equal(t, "rayproject/ray:2.10.0", rayCluster.Spec.HeadGroupSpec.Template.Spec.Containers[0].Image)
+		}
+	}
+}
+
+func TestReconcileRayClusterPool_ReleaseRayClustersOfDeletedRayJobs(t *testing.T) {
+	tests := map[string]struct {
+		releasePolicy       rayv1.RayClusterPoolReleasePolicy
+		expectClusterExists bool
+	}{
+		"Delete": {
+			releasePolicy:       rayv1.DeleteRayClusterPoolReleasePolicy,
+			expectClusterExists: false,
+		},
+		"Reuse": {
+			releasePolicy:       rayv1.ReuseRayClusterPoolReleasePolicy,
+			expectClusterExists: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			newScheme := runtime.NewScheme()
+			_ = rayv1.AddToScheme(newScheme)
+
+			rayClusterPool := &rayv1.RayClusterPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-rayclusterpool",
+					Namespace: "default",
+					UID:       "test-rayclusterpool-uid",
+					Labels:    map[string]string{"app": "test"},
+				},
+				Spec: rayv1.RayClusterPoolSpec{
+					Size: 1,
+					Template: rayv1.RayClusterSpec{
+						HeadGroupSpec: rayv1.HeadGroupSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+								},
+							},
+						},
+					},
+				},
+			}
+			rayClusterPool.Spec.ReleasePolicy = tc.releasePolicy
+			templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+			assert.NoError(t, err)
+			// The RayJob that claimed the RayCluster doesn't exist.
+			rayCluster := &rayv1.RayCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "claimed",
+					Namespace: rayClusterPool.Namespace,
+					Labels: map[string]string{
+						utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+						utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+					},
+					Annotations: map[string]string{
+						utils.RayClusterPoolTemplateHashAnnotationKey: templateHash,
+						utils.RayClusterPoolClaimedByAnnotationKey:    "deleted-rayjob",
+					},
+					OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rayClusterPool, rayv1.GroupVersion.WithKind("RayClusterPool"))},
+				},
+				Spec:   *rayClusterPool.Spec.Template.DeepCopy(),
+				Status: rayv1.RayClusterStatus{State: rayv1.Ready},
+			}
+			fakeClient := clientFake.NewClientBuilder().
+				WithScheme(newScheme).
+				WithObjects(rayClusterPool, rayCluster).
+				WithStatusSubresource(rayClusterPool).
+				Build()
+			r := &RayClusterPoolReconciler{
+				Client:   fakeClient,
+				Scheme:   newScheme,
+				Recorder: record.NewFakeRecorder(100),
+			}
+			ctx := context.Background()
+			namespacedName := types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: rayClusterPool.Name}
+
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+			assert.NoError(t, err)
+			assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayClusterPool))
+			assert.Equal(t, int32(0), rayClusterPool.Status.Claimed)
+			assert.Equal(t, int32(1), rayClusterPool.Status.Idle)
+			rayClusterList := rayv1.RayClusterList{}
+			assert.NoError(t, fakeClient.List(ctx, &rayClusterList, client.InNamespace(rayClusterPool.Namespace)))
+			assert.Len(t, rayClusterList.Items, 1)
+			err = fakeClient.Get(ctx, types.NamespacedName{Namespace: rayClusterPool.Namespace, Name: "claimed"}, rayCluster)
+			if tc.expectClusterExists {
+				assert.NoError(t, err)
+				assert.NotContains(t, rayCluster.Annotations, utils.RayClusterPoolClaimedByAnnotationKey)
+			} else {
+				assert.True(t, k8serrors.IsNotFound(err))
+			}
+		})
+	}
+}
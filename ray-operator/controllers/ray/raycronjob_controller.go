@@ -0,0 +1,376 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const (
+	RayCronJobDefaultRequeueDuration = 3 * time.Second
+	// maxMissedSchedules is the number of missed runs after which the RayCronJob stops counting them, like batchv1 CronJobs.
+	maxMissedSchedules = 100
+)
+
+// RayCronJobReconciler reconciles a RayCronJob object
+type RayCronJobReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+
+	clock clock.Clock
+}
+
+// NewRayCronJobReconciler returns a new reconcile.Reconciler
+func NewRayCronJobReconciler(_ context.Context, mgr manager.Manager) *RayCronJobReconciler {
+	return &RayCronJobReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("raycronjob-controller"),
+		clock:    clock.RealClock{},
+	}
+}
+
+// +kubebuilder:rbac:groups=ray.io,resources=raycronjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=raycronjobs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=raycronjobs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates the RayJobs of a RayCronJob according to its schedule, tracks the RayJobs that are still
+// running, and deletes the finished RayJobs that exceed the history limits.
+func (r *RayCronJobReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	rayCronJob := &rayv1.RayCronJob{}
+	if err := r.Get(ctx, request.NamespacedName, rayCronJob); err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request. Stop reconciliation.
+			logger.Info("RayCronJob resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get RayCronJob")
+		return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+	}
+
+	if !rayCronJob.DeletionTimestamp.IsZero() {
+		// The RayJobs created by the RayCronJob are garbage collected by Kubernetes through their owner references.
+		logger.Info("RayCronJob is being deleted", "DeletionTimestamp", rayCronJob.DeletionTimestamp)
+		return ctrl.Result{}, nil
+	}
+
+	if err := validateRayCronJobSpec(rayCronJob); err != nil {
+		logger.Error(err, "The RayCronJob spec is invalid")
+		r.Recorder.Eventf(rayCronJob, corev1.EventTypeWarning, string(utils.InvalidRayCronJobSpec),
+			"The RayCronJob spec is invalid %s/%s: %v", rayCronJob.Namespace, rayCronJob.Name, err)
+		return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+	}
+	schedule, err := cron.ParseStandard(rayCronJob.Spec.Schedule)
+	if err != nil {
+		return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+	}
+
+	// Please do NOT modify `originalRayCronJob` in the following code.
+	originalRayCronJob := rayCronJob.DeepCopy()
+
+	rayJobList := rayv1.RayJobList{}
+	if err := r.List(ctx, &rayJobList, common.RayCronJobRayJobsAssociationOptions(rayCronJob).ToListOptions()...); err != nil {
+		logger.Error(err, "Failed to list the RayJobs of the RayCronJob")
+		return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+	}
+
+	var activeJobs, successfulJobs, failedJobs []*rayv1.RayJob
+	for i := range rayJobList.Items {
+		rayJob := &rayJobList.Items[i]
+		if !metav1.IsControlledBy(rayJob, rayCronJob) {
+			continue
+		}
+		switch {
+		case isRayJobSucceeded(rayJob):
+			successfulJobs = append(successfulJobs, rayJob)
+		case isRayJobFinished(rayJob):
+			failedJobs = append(failedJobs, rayJob)
+		case rayJob.DeletionTimestamp.IsZero():
+			activeJobs = append(activeJobs, rayJob)
+		}
+	}
+	updateRayCronJobStatusFromRayJobs(rayCronJob, activeJobs, successfulJobs)
+
+	if err := r.deleteOldRayJobs(ctx, rayCronJob, successfulJobs, rayCronJob.Spec.SuccessfulJobsHistoryLimit); err != nil {
+		return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+	}
+	if err := r.deleteOldRayJobs(ctx, rayCronJob, failedJobs, rayCronJob.Spec.FailedJobsHistoryLimit); err != nil {
+		return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+	}
+
+	now := r.clock.Now()
+	if scheduledTime, numMissed := getMostRecentScheduleTime(rayCronJob, schedule, now); scheduledTime != nil {
+		if numMissed > 1 {
+			logger.Info("Multiple runs of the RayCronJob were missed. Only the most recent one is started.",
+				"numMissed", numMissed, "scheduledTime", scheduledTime)
+		}
+		if err := r.scheduleRayJob(ctx, rayCronJob, activeJobs, *scheduledTime, numMissed); err != nil {
+			return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+		}
+	}
+
+	rayCronJob.Status.ObservedGeneration = rayCronJob.Generation
+	if !equality.Semantic.DeepEqual(originalRayCronJob.Status, rayCronJob.Status) {
+		if err := r.Status().Update(ctx, rayCronJob); err != nil {
+			logger.Info("Failed to update RayCronJob status", "error", err)
+			return ctrl.Result{RequeueAfter: RayCronJobDefaultRequeueDuration}, err
+		}
+	}
+
+	// Requeue the RayCronJob at its next scheduled time. Changes to the RayJobs it owns also trigger a reconciliation.
+	nextScheduleTime := schedule.Next(now)
+	logger.Info("Requeue the RayCronJob at the next scheduled time", "nextScheduleTime", nextScheduleTime)
+	return ctrl.Result{RequeueAfter: nextScheduleTime.Sub(now)}, nil
+}
+
+// scheduleRayJob creates the RayJob of the run scheduled at `scheduledTime` according to the concurrency policy,
+// and records the run in the status of the RayCronJob. `numMissed` is the number of runs that were due, including
+// the one scheduled at `scheduledTime`.
+func (r *RayCronJobReconciler) scheduleRayJob(ctx context.Context, rayCronJob *rayv1.RayCronJob, activeJobs []*rayv1.RayJob, scheduledTime time.Time, numMissed int) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	if len(activeJobs) > 0 {
+		switch rayCronJob.Spec.ConcurrencyPolicy {
+		case rayv1.ForbidConcurrent:
+			// Don't update `LastScheduleTime` so that the run is started once the active RayJobs finish, as long as
+			// the starting deadline hasn't passed.
+			logger.Info("Skip the scheduled run because the RayCronJob doesn't allow concurrent runs", "scheduledTime", scheduledTime, "activeJobs", rayCronJob.Status.Active)
+			r.Recorder.Eventf(rayCronJob, corev1.EventTypeNormal, string(utils.RayJobAlreadyActive),
+				"Skip the run scheduled at %s because RayJobs %v are still running", scheduledTime.Format(time.RFC3339), rayCronJob.Status.Active)
+			return nil
+		case rayv1.ReplaceConcurrent:
+			for _, rayJob := range activeJobs {
+				if err := r.deleteRayJob(ctx, rayCronJob, rayJob); err != nil {
+					return err
+				}
+			}
+			rayCronJob.Status.Active = nil
+		}
+	}
+
+	rayJob, err := r.constructRayJobForRayCronJob(rayCronJob, scheduledTime)
+	if err != nil {
+		return err
+	}
+	if err := r.Create(ctx, rayJob); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			r.Recorder.Eventf(rayCronJob, corev1.EventTypeWarning, string(utils.FailedToCreateRayJob), "Failed to create RayJob %s/%s: %v", rayJob.Namespace, rayJob.Name, err)
+			return err
+		}
+		// The RayJob was created in a previous reconciliation, but the status update failed.
+		logger.Info("The RayJob of the scheduled run already exists", "RayJob", rayJob.Name)
+	} else {
+		logger.Info("Created a RayJob for the scheduled run", "RayJob", rayJob.Name, "scheduledTime", scheduledTime)
+		r.Recorder.Eventf(rayCronJob, corev1.EventTypeNormal, string(utils.CreatedRayJob), "Created RayJob %s/%s", rayJob.Namespace, rayJob.Name)
+		// The missed runs are only reported when the RayJob is created, so that they are reported once per scheduled
+		// time even if the run is delayed by the concurrency policy.
+		if numMissed > maxMissedSchedules {
+			r.Recorder.Eventf(rayCronJob, corev1.EventTypeWarning, string(utils.MissedSchedule),
+				"Missed more than %d runs of RayCronJob %s/%s, only the run scheduled at %s is started. Set or decrease startingDeadlineSeconds or check clock skew",
+				maxMissedSchedules, rayCronJob.Namespace, rayCronJob.Name, scheduledTime.Format(time.RFC3339))
+		} else if numMissed > 1 {
+			r.Recorder.Eventf(rayCronJob, corev1.EventTypeWarning, string(utils.MissedSchedule),
+				"Missed %d runs of RayCronJob %s/%s, only the run scheduled at %s is started", numMissed, rayCronJob.Namespace, rayCronJob.Name, scheduledTime.Format(time.RFC3339))
+		}
+	}
+
+	if !slices.Contains(rayCronJob.Status.Active, rayJob.Name) {
+		rayCronJob.Status.Active = append(rayCronJob.Status.Active, rayJob.Name)
+		sort.Strings(rayCronJob.Status.Active)
+	}
+	rayCronJob.Status.LastScheduleTime = &metav1.Time{Time: scheduledTime}
+	return nil
+}
+
+func (r *RayCronJobReconciler) constructRayJobForRayCronJob(rayCronJob *rayv1.RayCronJob, scheduledTime time.Time) (*rayv1.RayJob, error) {
+	labels := make(map[string]string, len(rayCronJob.Labels)+2)
+	for key, value := range rayCronJob.Labels {
+		labels[key] = value
+	}
+	labels[utils.RayOriginatedFromCRNameLabelKey] = rayCronJob.Name
+	labels[utils.RayOriginatedFromCRDLabelKey] = utils.RayOriginatedFromCRDLabelValue(utils.RayCronJobCRD)
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: labels,
+			Annotations: map[string]string{
+				utils.RayCronJobScheduledTimestampAnnotationKey: scheduledTime.Format(time.RFC3339),
+			},
+			Name:      getRayJobNameForScheduledTime(rayCronJob, scheduledTime),
+			Namespace: rayCronJob.Namespace,
+		},
+		Spec: *rayCronJob.Spec.JobTemplate.DeepCopy(),
+	}
+
+	// Set the ownership in order to do the garbage collection by k8s.
+	if err := ctrl.SetControllerReference(rayCronJob, rayJob, r.Scheme); err != nil {
+		return nil, err
+	}
+	return rayJob, nil
+}
+
+// deleteOldRayJobs deletes the oldest finished RayJobs so that at most `limit` of them are kept.
+// If `limit` is nil, all the finished RayJobs are kept.
+func (r *RayCronJobReconciler) deleteOldRayJobs(ctx context.Context, rayCronJob *rayv1.RayCronJob, rayJobs []*rayv1.RayJob, limit *int32) error {
+	if limit == nil || len(rayJobs) <= int(*limit) {
+		return nil
+	}
+	sort.SliceStable(rayJobs, func(i, j int) bool {
+		return getRayJobFinishTime(rayJobs[i]).Before(getRayJobFinishTime(rayJobs[j]))
+	})
+	for _, rayJob := range rayJobs[:len(rayJobs)-int(*limit)] {
+		if !rayJob.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.deleteRayJob(ctx, rayCronJob, rayJob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *RayCronJobReconciler) deleteRayJob(ctx context.Context, rayCronJob *rayv1.RayCronJob, rayJob *rayv1.RayJob) error {
+	logger := ctrl.LoggerFrom(ctx)
+	if err := r.Delete(ctx, rayJob, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		r.Recorder.Eventf(rayCronJob, corev1.EventTypeWarning, string(utils.FailedToDeleteRayJob), "Failed to delete RayJob %s/%s: %v", rayJob.Namespace, rayJob.Name, err)
+		return err
+	}
+	logger.Info("Deleted a RayJob of the RayCronJob", "RayJob", rayJob.Name)
+	r.Recorder.Eventf(rayCronJob, corev1.EventTypeNormal, string(utils.DeletedRayJob), "Deleted RayJob %s/%s", rayJob.Namespace, rayJob.Name)
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RayCronJobReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurrency int) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayv1.RayCronJob{}).
+		Owns(&rayv1.RayJob{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: reconcileConcurrency,
+			LogConstructor: func(request *reconcile.Request) logr.Logger {
+				logger := ctrl.Log.WithName("controllers").WithName("RayCronJob")
+				if request != nil {
+					logger = logger.WithValues("RayCronJob", request.NamespacedName)
+				}
+				return logger
+			},
+		}).
+		Complete(r)
+}
+
+// updateRayCronJobStatusFromRayJobs updates `Status.Active` and `Status.LastSuccessfulTime` from the RayJobs of the RayCronJob.
+func updateRayCronJobStatusFromRayJobs(rayCronJob *rayv1.RayCronJob, activeJobs []*rayv1.RayJob, successfulJobs []*rayv1.RayJob) {
+	rayCronJob.Status.Active = nil
+	for _, rayJob := range activeJobs {
+		rayCronJob.Status.Active = append(rayCronJob.Status.Active, rayJob.Name)
+	}
+	sort.Strings(rayCronJob.Status.Active)
+
+	for _, rayJob := range successfulJobs {
+		finishTime := getRayJobFinishTime(rayJob)
+		if rayCronJob.Status.LastSuccessfulTime == nil || finishTime.After(rayCronJob.Status.LastSuccessfulTime.Time) {
+			rayCronJob.Status.LastSuccessfulTime = &metav1.Time{Time: finishTime}
+		}
+	}
+}
+
+// getMostRecentScheduleTime returns the most recent scheduled time of the RayCronJob that is not later than `now` and
+// hasn't been started yet, together with the number of such runs. It returns nil if there is no run to start.
+// At most maxMissedSchedules + 1 runs are counted, so that a RayCronJob that missed its schedule for a long time
+// doesn't iterate over all of its missed runs.
+func getMostRecentScheduleTime(rayCronJob *rayv1.RayCronJob, schedule cron.Schedule, now time.Time) (*time.Time, int) {
+	earliestTime := rayCronJob.CreationTimestamp.Time
+	if rayCronJob.Status.LastScheduleTime != nil {
+		earliestTime = rayCronJob.Status.LastScheduleTime.Time
+	}
+	if rayCronJob.Spec.StartingDeadlineSeconds != nil {
+		// The runs scheduled before the starting deadline can't be started anymore.
+		if deadline := now.Add(-time.Duration(*rayCronJob.Spec.StartingDeadlineSeconds) * time.Second); deadline.After(earliestTime) {
+			earliestTime = deadline
+		}
+	}
+
+	var mostRecentTime *time.Time
+	var maxInterval time.Duration
+	numMissed := 0
+	for t := schedule.Next(earliestTime); !t.After(now); t = schedule.Next(t) {
+		if mostRecentTime != nil {
+			maxInterval = max(maxInterval, t.Sub(*mostRecentTime))
+		}
+		scheduledTime := t
+		mostRecentTime = &scheduledTime
+		numMissed++
+		if numMissed > maxMissedSchedules {
+			// Stop counting, and only look for the most recent run among the runs scheduled in the last two
+			// intervals between the runs seen so far.
+			for t := schedule.Next(now.Add(-2 * maxInterval)); !t.After(now); t = schedule.Next(t) {
+				scheduledTime := t
+				mostRecentTime = &scheduledTime
+			}
+			break
+		}
+	}
+	return mostRecentTime, numMissed
+}
+
+// getRayJobNameForScheduledTime returns a deterministic name for the RayJob of a scheduled run so that the same run
+// is never created twice.
+func getRayJobNameForScheduledTime(rayCronJob *rayv1.RayCronJob, scheduledTime time.Time) string {
+	return fmt.Sprintf("%s-%d", rayCronJob.Name, scheduledTime.Unix()/60)
+}
+
+func getRayJobFinishTime(rayJob *rayv1.RayJob) time.Time {
+	if rayJob.Status.EndTime != nil {
+		return rayJob.Status.EndTime.Time
+	}
+	return rayJob.CreationTimestamp.Time
+}
+
+func isRayJobFinished(rayJob *rayv1.RayJob) bool {
+	return rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusComplete || rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed
+}
+
+func isRayJobSucceeded(rayJob *rayv1.RayJob) bool {
+	return rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusComplete && rayJob.Status.JobStatus == rayv1.JobStatusSucceeded
+}
+
+func validateRayCronJobSpec(rayCronJob *rayv1.RayCronJob) error {
+	if _, err := cron.ParseStandard(rayCronJob.Spec.Schedule); err != nil {
+		return fmt.Errorf("invalid schedule %q: %w", rayCronJob.Spec.Schedule, err)
+	}
+	if rayCronJob.Spec.StartingDeadlineSeconds != nil && *rayCronJob.Spec.StartingDeadlineSeconds < 0 {
+		return fmt.Errorf("startingDeadlineSeconds must be a non-negative integer")
+	}
+	if err := validateRayJobSpec(&rayv1.RayJob{Spec: rayCronJob.Spec.JobTemplate}); err != nil {
+		return fmt.Errorf("invalid jobTemplate: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,381 @@
+package ray
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	utils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestGetMostRecentScheduleTime(t *testing.T) {
+	schedule, err := cron.ParseStandard("*/10 * * * *")
+	assert.NoError(t, err)
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		lastScheduleTime        *time.Time
+		startingDeadlineSeconds *int64
+		expectedTime            *time.Time
+		now                     time.Time
+		expectedNumMissed       int
+	}{
+		"No run is due yet": {
+			now: base.Add(9 * time.Minute),
+		},
+		"A single run is due": {
+			now:               base.Add(12 * time.Minute),
+			expectedTime:      ptr.To(base.Add(10 * time.Minute)),
+			expectedNumMissed: 1,
+		},
+		"Multiple runs are missed": {
+			now:               base.Add(45 * time.Minute),
+			expectedTime:      ptr.To(base.Add(40 * time.Minute)),
+			expectedNumMissed: 4,
+		},
+		"Runs before the last schedule time are ignored": {
+			lastScheduleTime:  ptr.To(base.Add(30 * time.Minute)),
+			now:               base.Add(45 * time.Minute),
+			expectedTime:      ptr.To(base.Add(40 * time.Minute)),
+			expectedNumMissed: 1,
+		},
+		"Runs before the starting deadline are ignored": {
+			startingDeadlineSeconds: ptr.To[int64](600),
+			now:                     base.Add(45 * time.Minute),
+			expectedTime:            ptr.To(base.Add(40 * time.Minute)),
+			expectedNumMissed:       1,
+		},
+		"The starting deadline of the most recent run has passed": {
+			startingDeadlineSeconds: ptr.To[int64](60),
+			now:                     base.Add(45 * time.Minute),
+		},
+		"Missed runs are counted up to the limit": {
+			now:               base.Add(365 * 24 * time.Hour).Add(5 * time.Minute),
+			expectedTime:      ptr.To(base.Add(365 * 24 * time.Hour)),
+			expectedNumMissed: maxMissedSchedules + 1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayCronJob := &rayv1.RayCronJob{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.Time{Time: base}},
+				Spec:       rayv1.RayCronJobSpec{StartingDeadlineSeconds: tc.startingDeadlineSeconds},
+			}
+			if tc.lastScheduleTime != nil {
+				rayCronJob.Status.LastScheduleTime = &metav1.Time{Time: *tc.lastScheduleTime}
+			}
+			scheduledTime, numMissed := getMostRecentScheduleTime(rayCronJob, schedule, tc.now)
+			assert.Equal(t, tc.expectedTime, scheduledTime)
+			assert.Equal(t, tc.expectedNumMissed, numMissed)
+		})
+	}
+}
+
+func TestValidateRayCronJobSpec(t *testing.T) {
+	rayCronJob := &rayv1.RayCronJob{
+		Spec: rayv1.RayCronJobSpec{
+			Schedule: "* * * * *",
+			JobTemplate: rayv1.RayJobSpec{
+				Entrypoint:      "python test.py",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+	}
+	assert.NoError(t, validateRayCronJobSpec(rayCronJob))
+
+	invalidSchedule := rayCronJob.DeepCopy()
+	invalidSchedule.Spec.Schedule = "not a schedule"
+	assert.ErrorContains(t, validateRayCronJobSpec(invalidSchedule), "invalid schedule")
+
+	invalidJobTemplate := rayCronJob.DeepCopy()
+	invalidJobTemplate.Spec.JobTemplate.Suspend = true
+	assert.ErrorContains(t, validateRayCronJobSpec(invalidJobTemplate), "invalid jobTemplate")
+}
+
+func TestReconcileRayCronJob_CreateRayJob(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	ctx := context.Background()
+
+	rayCronJob := &rayv1.RayCronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-raycronjob",
+			Namespace:         "default",
+			CreationTimestamp: metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)},
+			Labels:            map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayCronJobSpec{
+			Schedule:          "* * * * *",
+			ConcurrencyPolicy: rayv1.AllowConcurrent,
+			JobTemplate: rayv1.RayJobSpec{
+				Entrypoint:      "python test.py",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+	}
+	namespacedName := types.NamespacedName{Namespace: rayCronJob.Namespace, Name: rayCronJob.Name}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithObjects(rayCronJob).WithStatusSubresource(rayCronJob).Build()
+	recorder := record.NewFakeRecorder(100)
+	r := &RayCronJobReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: recorder,
+		clock:    clocktesting.NewFakeClock(time.Date(2024, 1, 1, 0, 5, 10, 0, time.UTC)),
+	}
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.Equal(t, 50*time.Second, result.RequeueAfter)
+
+	// Only the most recent of the missed runs is started.
+	scheduledTime := time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)
+	rayJobs := rayv1.RayJobList{}
+	assert.NoError(t, fakeClient.List(ctx, &rayJobs, client.InNamespace(rayCronJob.Namespace)))
+	assert.Len(t, rayJobs.Items, 1)
+	rayJob := rayJobs.Items[0]
+	assert.Equal(t, getRayJobNameForScheduledTime(rayCronJob, scheduledTime), rayJob.Name)
+	assert.Equal(t, "test", rayJob.Labels["app"])
+	assert.Equal(t, rayCronJob.Name, rayJob.Labels[utils.RayOriginatedFromCRNameLabelKey])
+	assert.Equal(t, utils.RayOriginatedFromCRDLabelValue(utils.RayCronJobCRD), rayJob.Labels[utils.RayOriginatedFromCRDLabelKey])
+	assert.Equal(t, scheduledTime.Format(time.RFC3339), rayJob.Annotations[utils.RayCronJobScheduledTimestampAnnotationKey])
+	assert.Equal(t, rayCronJob.Spec.JobTemplate, rayJob.Spec)
+
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayCronJob))
+	assert.True(t, metav1.IsControlledBy(&rayJob, rayCronJob))
+	assert.Equal(t, []string{rayJob.Name}, rayCronJob.Status.Active)
+	assert.NotNil(t, rayCronJob.Status.LastScheduleTime)
+	assert.True(t, rayCronJob.Status.LastScheduleTime.Time.Equal(scheduledTime))
+
+	// Reconciling again before the next scheduled time doesn't create another RayJob.
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.List(ctx, &rayJobs, client.InNamespace(rayCronJob.Namespace)))
+	assert.Len(t, rayJobs.Items, 1)
+}
+
+func TestReconcileRayCronJob_ConcurrencyPolicy(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	ctx := context.Background()
+
+	lastScheduledTime := time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)
+	scheduledTime := time.Date(2024, 1, 1, 0, 2, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		concurrencyPolicy rayv1.ConcurrencyPolicy
+		expectedRayJobs   []time.Time
+		expectScheduled   bool
+	}{
+		"Allow creates a new RayJob while the previous one is running": {
+			concurrencyPolicy: rayv1.AllowConcurrent,
+			expectedRayJobs:   []time.Time{lastScheduledTime, scheduledTime},
+			expectScheduled:   true,
+		},
+		"Forbid skips the run while the previous RayJob is running": {
+			concurrencyPolicy: rayv1.ForbidConcurrent,
+			expectedRayJobs:   []time.Time{lastScheduledTime},
+			expectScheduled:   false,
+		},
+		"Replace deletes the previous RayJob": {
+			concurrencyPolicy: rayv1.ReplaceConcurrent,
+			expectedRayJobs:   []time.Time{scheduledTime},
+			expectScheduled:   true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayCronJob := &rayv1.RayCronJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "test-raycronjob",
+					Namespace:         "default",
+					UID:               "test-raycronjob-uid",
+					CreationTimestamp: metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+				},
+				Spec: rayv1.RayCronJobSpec{
+					Schedule:          "* * * * *",
+					ConcurrencyPolicy: tc.concurrencyPolicy,
+					JobTemplate: rayv1.RayJobSpec{
+						Entrypoint:      "python test.py",
+						ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+					},
+				},
+				Status: rayv1.RayCronJobStatus{LastScheduleTime: &metav1.Time{Time: lastScheduledTime}},
+			}
+			namespacedName := types.NamespacedName{Namespace: rayCronJob.Namespace, Name: rayCronJob.Name}
+			r := &RayCronJobReconciler{
+				Scheme:   newScheme,
+				Recorder: record.NewFakeRecorder(100),
+				clock:    clocktesting.NewFakeClock(scheduledTime.Add(10 * time.Second)),
+			}
+			activeRayJob, err := r.constructRayJobForRayCronJob(rayCronJob, lastScheduledTime)
+			assert.NoError(t, err)
+			activeRayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusRunning
+			r.Client = clientFake.NewClientBuilder().WithScheme(newScheme).WithObjects(rayCronJob, activeRayJob).WithStatusSubresource(rayCronJob).Build()
+
+			_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+			assert.NoError(t, err)
+
+			rayJobs := rayv1.RayJobList{}
+			assert.NoError(t, r.List(ctx, &rayJobs, client.InNamespace(rayCronJob.Namespace)))
+			names := []string{}
+			for _, rayJob := range rayJobs.Items {
+				names = append(names, rayJob.Name)
+			}
+			expectedNames := []string{}
+			for _, expected := range tc.expectedRayJobs {
+				expectedNames = append(expectedNames, getRayJobNameForScheduledTime(rayCronJob, expected))
+			}
+			assert.ElementsMatch(t, expectedNames, names)
+			assert.NoError(t, r.Get(ctx, namespacedName, rayCronJob))
+			assert.ElementsMatch(t, expectedNames, rayCronJob.Status.Active)
+			if tc.expectScheduled {
+				assert.True(t, rayCronJob.Status.LastScheduleTime.Time.Equal(scheduledTime))
+			} else {
+				assert.True(t, rayCronJob.Status.LastScheduleTime.Time.Equal(lastScheduledTime))
+			}
+		})
+	}
+}
+
+func TestReconcileRayCronJob_MissedScheduleEvent(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	ctx := context.Background()
+
+	rayCronJob := &rayv1.RayCronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-raycronjob",
+			Namespace:         "default",
+			UID:               "test-raycronjob-uid",
+			CreationTimestamp: metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		Spec: rayv1.RayCronJobSpec{
+			Schedule:          "* * * * *",
+			ConcurrencyPolicy: rayv1.ForbidConcurrent,
+			JobTemplate: rayv1.RayJobSpec{
+				Entrypoint:      "python test.py",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+		Status: rayv1.RayCronJobStatus{LastScheduleTime: &metav1.Time{Time: time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC)}},
+	}
+	namespacedName := types.NamespacedName{Namespace: rayCronJob.Namespace, Name: rayCronJob.Name}
+	recorder := record.NewFakeRecorder(100)
+	fakeClock := clocktesting.NewFakeClock(time.Date(2024, 1, 1, 0, 5, 10, 0, time.UTC))
+	r := &RayCronJobReconciler{Scheme: newScheme, Recorder: recorder, clock: fakeClock}
+	activeRayJob, err := r.constructRayJobForRayCronJob(rayCronJob, rayCronJob.Status.LastScheduleTime.Time)
+	assert.NoError(t, err)
+	activeRayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusRunning
+	r.Client = clientFake.NewClientBuilder().WithScheme(newScheme).WithObjects(rayCronJob, activeRayJob).WithStatusSubresource(rayCronJob, activeRayJob).Build()
+	countMissedScheduleEvents := func() int {
+		n := 0
+		for len(recorder.Events) > 0 {
+			if strings.Contains(<-recorder.Events, string(utils.MissedSchedule)) {
+				n++
+			}
+		}
+		return n
+	}
+
+	// The missed runs are not reported while the active RayJob blocks them.
+	for i := 0; i < 3; i++ {
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 0, countMissedScheduleEvents())
+
+	// The missed runs are reported once when the most recent one is started.
+	activeRayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+	activeRayJob.Status.JobStatus = rayv1.JobStatusSucceeded
+	assert.NoError(t, r.Status().Update(ctx, activeRayJob))
+	for i := 0; i < 3; i++ {
+		_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, countMissedScheduleEvents())
+}
+
+func TestReconcileRayCronJob_HistoryLimits(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	ctx := context.Background()
+
+	lastScheduledTime := time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)
+	rayCronJob := &rayv1.RayCronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "test-raycronjob",
+			Namespace:         "default",
+			UID:               "test-raycronjob-uid",
+			CreationTimestamp: metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		Spec: rayv1.RayCronJobSpec{
+			Schedule:                   "* * * * *",
+			SuccessfulJobsHistoryLimit: ptr.To[int32](1),
+			FailedJobsHistoryLimit:     ptr.To[int32](1),
+			JobTemplate: rayv1.RayJobSpec{
+				Entrypoint:      "python test.py",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+		Status: rayv1.RayCronJobStatus{LastScheduleTime: &metav1.Time{Time: lastScheduledTime}},
+	}
+	namespacedName := types.NamespacedName{Namespace: rayCronJob.Namespace, Name: rayCronJob.Name}
+	r := &RayCronJobReconciler{
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+		clock:    clocktesting.NewFakeClock(lastScheduledTime.Add(10 * time.Second)),
+	}
+
+	objects := []client.Object{rayCronJob}
+	for i, status := range []rayv1.JobStatus{rayv1.JobStatusSucceeded, rayv1.JobStatusFailed, rayv1.JobStatusSucceeded, rayv1.JobStatusFailed, rayv1.JobStatusSucceeded} {
+		scheduledTime := time.Date(2024, 1, 1, 0, i+1, 0, 0, time.UTC)
+		rayJob, err := r.constructRayJobForRayCronJob(rayCronJob, scheduledTime)
+		assert.NoError(t, err)
+		rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+		rayJob.Status.JobStatus = status
+		rayJob.Status.EndTime = &metav1.Time{Time: scheduledTime.Add(30 * time.Second)}
+		objects = append(objects, rayJob)
+	}
+	// RayJobs that aren't controlled by the RayCronJob are never deleted.
+	objects = append(objects, &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated-rayjob", Namespace: rayCronJob.Namespace, Labels: objects[1].GetLabels()},
+		Status:     rayv1.RayJobStatus{JobDeploymentStatus: rayv1.JobDeploymentStatusComplete, JobStatus: rayv1.JobStatusSucceeded},
+	})
+	r.Client = clientFake.NewClientBuilder().WithScheme(newScheme).WithObjects(objects...).WithStatusSubresource(rayCronJob).Build()
+
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+
+	rayJobs := rayv1.RayJobList{}
+	assert.NoError(t, r.List(ctx, &rayJobs, client.InNamespace(rayCronJob.Namespace)))
+	names := []string{}
+	for _, rayJob := range rayJobs.Items {
+		names = append(names, rayJob.Name)
+	}
+	// Only the most recent successful RayJob and the most recent failed RayJob are kept.
+	assert.ElementsMatch(t, []string{
+		getRayJobNameForScheduledTime(rayCronJob, time.Date(2024, 1, 1, 0, 4, 0, 0, time.UTC)),
+		getRayJobNameForScheduledTime(rayCronJob, time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC)),
+		"unrelated-rayjob",
+	}, names)
+	assert.NoError(t, r.Get(ctx, namespacedName, rayCronJob))
+	assert.Empty(t, rayCronJob.Status.Active)
+	assert.NotNil(t, rayCronJob.Status.LastSuccessfulTime)
+	assert.True(t, rayCronJob.Status.LastSuccessfulTime.Time.Equal(lastScheduledTime.Add(30*time.Second)))
+}
@@ -3,15 +3,22 @@ package ray
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
+	"math"
 	"os"
+	"path"
+	"reflect"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-logr/logr"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -19,11 +26,13 @@ import (
 
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+	"github.com/ray-project/kuberay/ray-operator/pkg/features"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -33,8 +42,41 @@ const (
 	RayJobDefaultRequeueDuration    = 3 * time.Second
 	RayJobDefaultClusterSelectorKey = "ray.io/cluster"
 	PythonUnbufferedEnvVarName      = "PYTHONUNBUFFERED"
+	// RayJobMaxAttempts is the maximum number of attempts kept in `Status.Attempts`.
+	RayJobMaxAttempts = 10
+	// DefaultRayJobLogsTailBytes is the default number of bytes of the driver logs retained in a ConfigMap.
+	DefaultRayJobLogsTailBytes = 65536
+	// RayJobLogsFileName is the key of the driver logs in the ConfigMap that retains them.
+	RayJobLogsFileName = "driver.log"
+	// RayJobLogsVolumeMountPath is where the PersistentVolumeClaim that retains the driver logs is mounted.
+	RayJobLogsVolumeMountPath = "/ray-logs"
+	// RayJobHeadPodRestartThreshold is the number of restarts after which a crash-looping or OOMKilled head Pod fails the
+	// RayJob before `InitializationDeadlineSeconds` has passed.
+	RayJobHeadPodRestartThreshold = 3
+
+	// Definition of an index field for the name of the ConfigMap that a RayJob reads its runtime environment from
+	rayJobRuntimeEnvConfigMapIndexField = "spec.runtimeEnvFrom.name"
 )
 
+// rayClusterPodsFailureReasons maps the reasons of the RayCluster ReplicaFailure condition diagnosed from the RayCluster
+// Pods to the reasons why the RayJob fails.
+var rayClusterPodsFailureReasons = map[string]rayv1.JobFailedReason{
+	rayv1.PodImagePullBackOff:     rayv1.ImagePullBackOff,
+	rayv1.PodUnschedulable:        rayv1.Unschedulable,
+	rayv1.PodOOMKilled:            rayv1.OOMKilled,
+	rayv1.HeadPodCrashLoopBackOff: rayv1.HeadPodCrashLoop,
+}
+
+// rayClusterPodsFailureEventTypes maps the reasons diagnosed from the RayCluster Pods to the events emitted when the
+// RayJob fails for them.
+var rayClusterPodsFailureEventTypes = map[rayv1.JobFailedReason]utils.K8sEventType{
+	rayv1.ImagePullBackOff:           utils.RayClusterPodImagePullBackOff,
+	rayv1.Unschedulable:              utils.RayClusterPodUnschedulable,
+	rayv1.OOMKilled:                  utils.RayClusterPodOOMKilled,
+	rayv1.HeadPodCrashLoop:           utils.RayClusterHeadPodCrashLoop,
+	rayv1.ClusterProvisioningTimeout: utils.RayClusterProvisioningTimeout,
+}
+
 // RayJobReconciler reconciles a RayJob object
 type RayJobReconciler struct {
 	client.Client
@@ -45,7 +87,10 @@ type RayJobReconciler struct {
 }
 
 // NewRayJobReconciler returns a new reconcile.Reconciler
-func NewRayJobReconciler(_ context.Context, mgr manager.Manager, provider utils.ClientProvider) *RayJobReconciler {
+func NewRayJobReconciler(ctx context.Context, mgr manager.Manager, provider utils.ClientProvider) *RayJobReconciler {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &rayv1.RayJob{}, rayJobRuntimeEnvConfigMapIndexField, rayJobRuntimeEnvConfigMapName); err != nil {
+		panic(err)
+	}
 	dashboardClientFunc := provider.GetDashboardClient(mgr)
 	return &RayJobReconciler{
 		Client:              mgr.GetClient(),
@@ -58,6 +103,8 @@ func NewRayJobReconciler(_ context.Context, mgr manager.Manager, provider utils.
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=ray.io,resources=rayjobs/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayclusterpools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;list;watch;create;update;patch;delete
@@ -152,12 +199,42 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 			}
 		}
+		// A RayJob with dependencies stays in `Blocked` until all the RayJobs in `DependsOn` have finished.
+		if len(rayJobInstance.Spec.DependsOn) != 0 {
+			logger.Info("JobDeploymentStatusNew. Transition the status to `Blocked`.", "DependsOn", rayJobInstance.Spec.DependsOn)
+			rayJobInstance.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusBlocked
+			break
+		}
 		// Set `Status.JobDeploymentStatus` to `JobDeploymentStatusInitializing`, and initialize `Status.JobId`
 		// and `Status.RayClusterName` prior to avoid duplicate job submissions and cluster creations.
 		logger.Info("JobDeploymentStatusNew")
 		if err = r.initRayJobStatusIfNeed(ctx, rayJobInstance); err != nil {
 			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 		}
+	case rayv1.JobDeploymentStatusBlocked:
+		if shouldUpdate := r.updateStatusToSuspendingIfNeeded(ctx, rayJobInstance); shouldUpdate {
+			break
+		}
+
+		if shouldUpdate := r.checkActiveDeadlineAndUpdateStatusIfNeeded(ctx, rayJobInstance); shouldUpdate {
+			break
+		}
+
+		isBlocked, err := r.checkDependenciesAndUpdateStatusIfNeeded(ctx, rayJobInstance)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+		}
+		if isBlocked {
+			logger.Info("Wait for the RayJobs in dependsOn to finish", "DependsOn", rayJobInstance.Spec.DependsOn)
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
+		}
+		if rayJobInstance.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed {
+			break
+		}
+		logger.Info("All RayJobs in dependsOn have finished. Transition the status from `Blocked` to `Initializing`.")
+		if err = r.initRayJobStatusIfNeed(ctx, rayJobInstance); err != nil {
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+		}
 	case rayv1.JobDeploymentStatusInitializing:
 		if shouldUpdate := r.updateStatusToSuspendingIfNeeded(ctx, rayJobInstance); shouldUpdate {
 			break
@@ -167,6 +244,19 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			break
 		}
 
+		// In the RayClusterPool mode, the RayJob claims an idle RayCluster of the pool instead of creating one.
+		if rayJobInstance.Spec.RayClusterPoolName != "" && rayJobInstance.Status.RayClusterName == "" {
+			rayClusterName, err := r.claimRayClusterFromPool(ctx, rayJobInstance)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+			if rayClusterName == "" {
+				logger.Info("No idle RayCluster in the pool. Wait for the pool to create one.", "RayClusterPool", rayJobInstance.Spec.RayClusterPoolName)
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
+			}
+			rayJobInstance.Status.RayClusterName = rayClusterName
+		}
+
 		var rayClusterInstance *rayv1.RayCluster
 		if rayClusterInstance, err = r.getOrCreateRayClusterInstance(ctx, rayJobInstance); err != nil {
 			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
@@ -175,6 +265,13 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		// Check the current status of RayCluster before submitting.
 		if clientURL := rayJobInstance.Status.DashboardURL; clientURL == "" {
 			if rayClusterInstance.Status.State != rayv1.Ready { //nolint:staticcheck // https://github.com/ray-project/kuberay/pull/2288
+				shouldUpdate, err := r.checkRayClusterPodsAndUpdateStatusIfNeeded(ctx, rayJobInstance, rayClusterInstance)
+				if err != nil {
+					return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+				}
+				if shouldUpdate {
+					break
+				}
 				logger.Info("Wait for the RayCluster.Status.State to be ready before submitting the job.", "RayCluster", rayClusterInstance.Name, "State", rayClusterInstance.Status.State) //nolint:staticcheck // https://github.com/ray-project/kuberay/pull/2288
 				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 			}
@@ -230,7 +327,11 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 				logger.Error(err, "Failed to get the submitter Kubernetes Job for RayJob", "NamespacedName", namespacedName)
 				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 			}
-			if shouldUpdate := r.checkK8sJobAndUpdateStatusIfNeeded(ctx, rayJobInstance, job); shouldUpdate {
+			shouldUpdate, err := r.checkK8sJobAndUpdateStatusIfNeeded(ctx, rayJobInstance, job)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+			if shouldUpdate {
 				break
 			}
 		}
@@ -253,7 +354,11 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			// If the Ray job was not found, GetJobInfo returns a BadRequest error.
 			if rayJobInstance.Spec.SubmissionMode == rayv1.HTTPMode && errors.IsBadRequest(err) {
 				logger.Info("The Ray job was not found. Submit a Ray job via an HTTP request.", "JobId", rayJobInstance.Status.JobId)
-				if _, err := rayDashboardClient.SubmitJob(ctx, rayJobInstance); err != nil {
+				rayJobWithRuntimeEnv, err := r.resolveRuntimeEnvYAML(ctx, rayJobInstance)
+				if err != nil {
+					return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+				}
+				if _, err := rayDashboardClient.SubmitJob(ctx, rayJobWithRuntimeEnv); err != nil {
 					logger.Error(err, "Failed to submit the Ray job", "JobId", rayJobInstance.Status.JobId)
 					return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
 				}
@@ -277,6 +382,17 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 			isJobTerminal = isJobTerminal && finished
 		}
 
+		if isJobTerminal && rayJobInstance.Spec.LogRetention != nil {
+			// Retain the driver logs before the status transitions, because the RayCluster may be deleted
+			// right after the RayJob becomes `Complete` or `Failed`. Retaining the logs is best-effort, so a
+			// failure doesn't keep the RayJob from finishing.
+			if err := r.retainRayJobLogs(ctx, rayJobInstance, rayClusterInstance, rayDashboardClient); err != nil {
+				logger.Error(err, "Failed to retain the driver logs", "JobId", rayJobInstance.Status.JobId)
+				r.Recorder.Eventf(rayJobInstance, corev1.EventTypeWarning, string(utils.FailedToRetainRayJobLogs),
+					"Failed to retain the driver logs of Ray job %s: %v", rayJobInstance.Status.JobId, err)
+			}
+		}
+
 		if isJobTerminal {
 			jobDeploymentStatus = rayv1.JobDeploymentStatusComplete
 			if jobInfo.JobStatus == rayv1.JobStatusFailed {
@@ -297,9 +413,38 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		// cleaned up at all. To keep the atomicity, if a RayJob is in the `Suspending` status, we should delete all of its
 		// associated resources and then transition the status to `Suspended` no matter the value of the `suspend` flag.
 
-		// TODO (kevin85421): Currently, Ray doesn't have a best practice to stop a Ray job gracefully. At this moment,
-		// KubeRay doesn't stop the Ray job before suspending the RayJob. If users want to stop the Ray job by SIGTERM,
-		// users need to set the Pod's preStop hook by themselves.
+		// If `SuspendGracePeriodSeconds` is set, KubeRay stops the Ray job and waits for it to be stopped before deleting
+		// the RayCluster so that the Ray job has a chance to clean up, e.g. to flush its checkpoints.
+		if rayJobInstance.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusSuspending {
+			if isJobStopped := r.stopRayJobBeforeSuspension(ctx, rayJobInstance); !isJobStopped {
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
+			}
+		}
+		if rayJobInstance.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusRetrying && isSameClusterRetry(rayJobInstance) {
+			// Keep the RayCluster and resubmit the Ray job to it with a new submission ID. The submitter Kubernetes Job
+			// has the same name for every attempt, so it still needs to be deleted before the next attempt.
+			isJobDeleted, err := r.deleteSubmitterJob(ctx, rayJobInstance)
+			if err != nil {
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+			if !isJobDeleted {
+				logger.Info("Wait for the submitter Kubernetes Job to be deleted before resubmitting the Ray job to the same RayCluster.")
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
+			}
+
+			logger.Info("Retry the Ray job on the same RayCluster", "RayCluster", rayJobInstance.Status.RayClusterName)
+			rayJobInstance.Status.JobId = utils.GenerateRayJobId(rayJobInstance.Name)
+			rayJobInstance.Status.Message = ""
+			rayJobInstance.Status.Reason = ""
+			rayJobInstance.Status.JobStatus = rayv1.JobStatusNew
+			rayJobInstance.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusNew
+			break
+		}
+
+		if isLogsRetained, err := r.isRayJobLogRetentionFinished(ctx, rayJobInstance); err != nil || !isLogsRetained {
+			logger.Info("Wait for the driver logs to be written to the PersistentVolumeClaim before deleting the RayCluster")
+			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+		}
 		isClusterDeleted, err := r.deleteClusterResources(ctx, rayJobInstance)
 		if err != nil {
 			return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
@@ -321,6 +466,7 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		rayJobInstance.Status.JobId = ""
 		rayJobInstance.Status.Message = ""
 		rayJobInstance.Status.Reason = ""
+		rayJobInstance.Status.SuspendStartTime = nil
 		// Reset the JobStatus to JobStatusNew and transition the JobDeploymentStatus to `Suspended`.
 		rayJobInstance.Status.JobStatus = rayv1.JobStatusNew
 
@@ -341,15 +487,16 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
 	case rayv1.JobDeploymentStatusComplete, rayv1.JobDeploymentStatusFailed:
 		// If this RayJob uses an existing RayCluster (i.e., ClusterSelector is set), we should not delete the RayCluster.
-		logger.Info(string(rayJobInstance.Status.JobDeploymentStatus), "RayJob", rayJobInstance.Name, "ShutdownAfterJobFinishes", rayJobInstance.Spec.ShutdownAfterJobFinishes, "ClusterSelector", rayJobInstance.Spec.ClusterSelector)
-		if rayJobInstance.Spec.ShutdownAfterJobFinishes && len(rayJobInstance.Spec.ClusterSelector) == 0 {
+		deletionPolicy := getDeletionPolicy(rayJobInstance)
+		logger.Info(string(rayJobInstance.Status.JobDeploymentStatus), "RayJob", rayJobInstance.Name, "DeletionPolicy", deletionPolicy, "ClusterSelector", rayJobInstance.Spec.ClusterSelector)
+		if deletionPolicy != rayv1.DeleteNoneDeletionPolicy {
 			ttlSeconds := rayJobInstance.Spec.TTLSecondsAfterFinished
 			nowTime := time.Now()
 			shutdownTime := rayJobInstance.Status.EndTime.Add(time.Duration(ttlSeconds) * time.Second)
 			logger.Info(
 				"RayJob deployment status",
 				"jobDeploymentStatus", rayJobInstance.Status.JobDeploymentStatus,
-				"shutdownAfterJobFinishes", rayJobInstance.Spec.ShutdownAfterJobFinishes,
+				"deletionPolicy", deletionPolicy,
 				"ttlSecondsAfterFinished", ttlSeconds,
 				"Status.endTime", rayJobInstance.Status.EndTime,
 				"Now", nowTime,
@@ -359,10 +506,17 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 				logger.Info("shutdownTime not reached, requeue this RayJob for n seconds", "seconds", delta)
 				return ctrl.Result{RequeueAfter: time.Duration(delta) * time.Second}, nil
 			}
-			if s := os.Getenv(utils.DELETE_RAYJOB_CR_AFTER_JOB_FINISHES); strings.ToLower(s) == "true" {
+			if isLogsRetained, err := r.isRayJobLogRetentionFinished(ctx, rayJobInstance); err != nil || !isLogsRetained {
+				logger.Info("Wait for the driver logs to be written to the PersistentVolumeClaim before deleting the RayCluster")
+				return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, err
+			}
+			switch deletionPolicy {
+			case rayv1.DeleteSelfDeletionPolicy:
 				err = r.Client.Delete(ctx, rayJobInstance)
 				logger.Info("RayJob is deleted")
-			} else {
+			case rayv1.DeleteWorkersDeletionPolicy:
+				err = r.deleteWorkerGroups(ctx, rayJobInstance)
+			default:
 				// We only need to delete the RayCluster. We don't need to delete the submitter Kubernetes Job so that users can still access
 				// the driver logs. In addition, a completed Kubernetes Job does not actually use any compute resources.
 				_, err = r.deleteClusterResources(ctx, rayJobInstance)
@@ -378,6 +532,7 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		logger.Info("Unknown JobDeploymentStatus", "JobDeploymentStatus", rayJobInstance.Status.JobDeploymentStatus)
 		return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
 	}
+	recordAttemptIfNeeded(originalRayJobInstance, rayJobInstance)
 	checkBackoffLimitAndUpdateStatusIfNeeded(ctx, rayJobInstance)
 
 	// This is the only place where we update the RayJob status. Please do NOT add any code
@@ -389,6 +544,37 @@ func (r *RayJobReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	return ctrl.Result{RequeueAfter: RayJobDefaultRequeueDuration}, nil
 }
 
+// recordAttemptIfNeeded appends the current attempt to `Status.Attempts` when the RayJob transitions to `Complete`, `Failed`,
+// or `Suspending` in this reconciliation, and drops the oldest attempts beyond `RayJobMaxAttempts`. It must be called before
+// `checkBackoffLimitAndUpdateStatusIfNeeded`, which may transition a failed RayJob to `Retrying`.
+func recordAttemptIfNeeded(oldRayJob *rayv1.RayJob, rayJob *rayv1.RayJob) {
+	status := rayJob.Status.JobDeploymentStatus
+	if status == oldRayJob.Status.JobDeploymentStatus {
+		return
+	}
+	if status != rayv1.JobDeploymentStatusComplete && status != rayv1.JobDeploymentStatusFailed && status != rayv1.JobDeploymentStatusSuspending {
+		return
+	}
+	rayJob.Status.Attempts = append(rayJob.Status.Attempts, rayv1.RayJobAttempt{
+		JobId:          rayJob.Status.JobId,
+		RayClusterName: rayJob.Status.RayClusterName,
+		SubmissionMode: rayJob.Spec.SubmissionMode,
+		JobStatus:      rayJob.Status.JobStatus,
+		Reason:         rayJob.Status.Reason,
+		Message:        rayJob.Status.Message,
+		StartTime:      rayJob.Status.StartTime,
+		EndTime:        &metav1.Time{Time: time.Now()},
+	})
+	if n := len(rayJob.Status.Attempts); n > RayJobMaxAttempts {
+		rayJob.Status.Attempts = rayJob.Status.Attempts[n-RayJobMaxAttempts:]
+	}
+}
+
+// isSameClusterRetry returns whether a failed Ray job should be retried on the existing RayCluster.
+func isSameClusterRetry(rayJob *rayv1.RayJob) bool {
+	return rayJob.Spec.RetryPolicy != nil && *rayJob.Spec.RetryPolicy == rayv1.SameClusterRetry
+}
+
 // checkBackoffLimitAndUpdateStatusIfNeeded determines if a RayJob is eligible for retry based on the configured backoff limit,
 // the job's success status, and its failure status. If eligible, sets the JobDeploymentStatus to Retrying.
 func checkBackoffLimitAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1.RayJob) {
@@ -416,9 +602,9 @@ func checkBackoffLimitAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1
 	rayJob.Status.Succeeded = ptr.To[int32](succeededCount)
 
 	if rayJob.Status.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed && rayJob.Spec.BackoffLimit != nil && *rayJob.Status.Failed < *rayJob.Spec.BackoffLimit+1 {
-		if rayJob.Status.Reason == rayv1.DeadlineExceeded {
+		if rayJob.Status.Reason == rayv1.DeadlineExceeded || rayJob.Status.Reason == rayv1.DependencyFailed {
 			logger.Info(
-				"RayJob is not eligible for retry due to failure with DeadlineExceeded",
+				"RayJob is not eligible for retry due to failure with "+string(rayJob.Status.Reason),
 				"backoffLimit", *rayJob.Spec.BackoffLimit,
 				"succeeded", *rayJob.Status.Succeeded,
 				"failed", *rayJob.Status.Failed,
@@ -467,7 +653,11 @@ func (r *RayJobReconciler) getSubmitterTemplate(ctx context.Context, rayJobInsta
 
 	// If the command in the submitter pod template isn't set, use the default command.
 	if len(submitterTemplate.Spec.Containers[utils.RayContainerIndex].Command) == 0 {
-		k8sJobCommand, err := common.GetK8sJobCommand(rayJobInstance)
+		rayJobWithRuntimeEnv, err := r.resolveRuntimeEnvYAML(ctx, rayJobInstance)
+		if err != nil {
+			return corev1.PodTemplateSpec{}, err
+		}
+		k8sJobCommand, err := common.GetK8sJobCommand(rayJobWithRuntimeEnv)
 		if err != nil {
 			return corev1.PodTemplateSpec{}, err
 		}
@@ -498,6 +688,25 @@ func (r *RayJobReconciler) getSubmitterTemplate(ctx context.Context, rayJobInsta
 	return submitterTemplate, nil
 }
 
+// resolveRuntimeEnvYAML returns the RayJob to submit. If the runtime environment is referenced from a ConfigMap,
+// it returns a copy of the RayJob with `RuntimeEnvYAML` set to the content of the ConfigMap key.
+func (r *RayJobReconciler) resolveRuntimeEnvYAML(ctx context.Context, rayJobInstance *rayv1.RayJob) (*rayv1.RayJob, error) {
+	selector := rayJobInstance.Spec.RuntimeEnvFrom
+	if selector == nil {
+		return rayJobInstance, nil
+	}
+	runtimeEnvYAML, err := utils.GetConfigMapKeyValue(ctx, r.Client, rayJobInstance.Namespace, selector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the runtime environment from ConfigMap %s/%s: %w", rayJobInstance.Namespace, selector.Name, err)
+	}
+	if _, err := utils.UnmarshalRuntimeEnvYAML(runtimeEnvYAML); err != nil {
+		return nil, err
+	}
+	rayJobWithRuntimeEnv := rayJobInstance.DeepCopy()
+	rayJobWithRuntimeEnv.Spec.RuntimeEnvYAML = runtimeEnvYAML
+	return rayJobWithRuntimeEnv, nil
+}
+
 // createNewK8sJob creates a new Kubernetes Job. It returns an error.
 func (r *RayJobReconciler) createNewK8sJob(ctx context.Context, rayJobInstance *rayv1.RayJob, submitterTemplate corev1.PodTemplateSpec) error {
 	logger := ctrl.LoggerFrom(ctx)
@@ -578,10 +787,223 @@ func (r *RayJobReconciler) deleteSubmitterJob(ctx context.Context, rayJobInstanc
 	return isJobDeleted, nil
 }
 
+// stopRayJobBeforeSuspension stops the Ray job of a `Suspending` RayJob and returns whether the Ray job has stopped
+// or the grace period, which starts at `Status.SuspendStartTime`, has expired. The RayCluster can only be deleted
+// after this function returns true.
+func (r *RayJobReconciler) stopRayJobBeforeSuspension(ctx context.Context, rayJobInstance *rayv1.RayJob) bool {
+	logger := ctrl.LoggerFrom(ctx)
+	gracePeriodSeconds := rayJobInstance.Spec.SuspendGracePeriodSeconds
+	if gracePeriodSeconds == nil || *gracePeriodSeconds == 0 || rayJobInstance.Status.SuspendStartTime == nil {
+		return true
+	}
+	// The Ray job has not been submitted yet or has already finished, so there is nothing to stop.
+	if rayJobInstance.Status.JobId == "" || rayJobInstance.Status.DashboardURL == "" || rayv1.IsJobTerminal(rayJobInstance.Status.JobStatus) {
+		return true
+	}
+	if deadline := rayJobInstance.Status.SuspendStartTime.Add(time.Duration(*gracePeriodSeconds) * time.Second); time.Now().After(deadline) {
+		logger.Info("The grace period to stop the Ray job has expired. Delete the RayCluster anyway.",
+			"JobId", rayJobInstance.Status.JobId, "SuspendGracePeriodSeconds", *gracePeriodSeconds)
+		return true
+	}
+
+	rayClusterInstance := &rayv1.RayCluster{}
+	if err := r.Get(ctx, common.RayJobRayClusterNamespacedName(rayJobInstance), rayClusterInstance); err != nil {
+		if errors.IsNotFound(err) {
+			return true
+		}
+		logger.Error(err, "Failed to get RayCluster")
+		return false
+	}
+	rayDashboardClient := r.dashboardClientFunc()
+	if err := rayDashboardClient.InitClient(ctx, rayJobInstance.Status.DashboardURL, rayClusterInstance); err != nil {
+		logger.Error(err, "Failed to initialize dashboard client")
+		return false
+	}
+	jobInfo, err := rayDashboardClient.GetJobInfo(ctx, rayJobInstance.Status.JobId)
+	if err != nil {
+		// If the Ray job was not found, GetJobInfo returns a BadRequest error.
+		if errors.IsBadRequest(err) {
+			return true
+		}
+		logger.Error(err, "Failed to get job info", "JobId", rayJobInstance.Status.JobId)
+		return false
+	}
+	if rayv1.IsJobTerminal(jobInfo.JobStatus) {
+		logger.Info("The Ray job has stopped. Delete the RayCluster.", "JobId", rayJobInstance.Status.JobId, "JobStatus", jobInfo.JobStatus)
+		return true
+	}
+
+	logger.Info("Stop the Ray job before suspending the RayJob", "JobId", rayJobInstance.Status.JobId, "JobStatus", jobInfo.JobStatus)
+	if err := rayDashboardClient.StopJob(ctx, rayJobInstance.Status.JobId); err != nil {
+		logger.Error(err, "Failed to stop the Ray job", "JobId", rayJobInstance.Status.JobId)
+	}
+	return false
+}
+
+// retainRayJobLogs stores the driver logs of the current Ray job according to `Spec.LogRetention` and records their
+// location in `Status.LogLocation`. The tail of the logs is fetched from the dashboard and stored in a ConfigMap owned
+// by the RayJob. The full logs are written to a PersistentVolumeClaim by a Kubernetes Job that runs `ray job logs`,
+// because the KubeRay operator can't mount volumes itself.
+func (r *RayJobReconciler) retainRayJobLogs(ctx context.Context, rayJobInstance *rayv1.RayJob, rayClusterInstance *rayv1.RayCluster, rayDashboardClient utils.RayDashboardClientInterface) error {
+	logger := ctrl.LoggerFrom(ctx)
+	logRetention := rayJobInstance.Spec.LogRetention
+	name := rayJobLogsName(rayJobInstance)
+
+	var obj client.Object
+	var logLocation *rayv1.RayJobLogLocation
+	kind := "ConfigMap"
+	if logRetention.ConfigMap != nil {
+		logs, err := rayDashboardClient.GetJobLog(ctx, rayJobInstance.Status.JobId)
+		if err != nil {
+			return err
+		}
+		tailBytes := DefaultRayJobLogsTailBytes
+		if logRetention.ConfigMap.TailBytes != nil {
+			tailBytes = int(*logRetention.ConfigMap.TailBytes)
+		}
+		obj = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: rayJobInstance.Namespace,
+				Labels:    rayJobLogsLabels(rayJobInstance),
+			},
+			Data: map[string]string{
+				RayJobLogsFileName: tailOfLogs(ptr.Deref(logs, ""), tailBytes),
+			},
+		}
+		logLocation = &rayv1.RayJobLogLocation{ConfigMapName: name, Path: RayJobLogsFileName}
+	} else {
+		kind = "Kubernetes Job"
+		obj = newRayJobLogsWriterJob(rayJobInstance, rayClusterInstance, name)
+		logLocation = &rayv1.RayJobLogLocation{
+			PersistentVolumeClaimName: logRetention.PersistentVolumeClaim.ClaimName,
+			Path:                      path.Join(logRetention.PersistentVolumeClaim.SubPath, name+".log"),
+		}
+	}
+
+	// Set the ownership in order to do the garbage collection by k8s.
+	if err := ctrl.SetControllerReference(rayJobInstance, obj, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Client.Create(ctx, obj); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return err
+		}
+		logger.Info("The driver logs have already been retained", "name", name)
+	} else {
+		r.Recorder.Eventf(rayJobInstance, corev1.EventTypeNormal, string(utils.RetainedRayJobLogs), "Created %s %s/%s to retain the driver logs of Ray job %s", kind, obj.GetNamespace(), obj.GetName(), rayJobInstance.Status.JobId)
+	}
+	rayJobInstance.Status.LogLocation = logLocation
+	return nil
+}
+
+// isRayJobLogRetentionFinished returns whether the Kubernetes Job that writes the driver logs to a PersistentVolumeClaim
+// has finished, so that the RayCluster can be deleted. It returns true if the logs are not retained on a PersistentVolumeClaim.
+func (r *RayJobReconciler) isRayJobLogRetentionFinished(ctx context.Context, rayJobInstance *rayv1.RayJob) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	if rayJobInstance.Status.LogLocation == nil || rayJobInstance.Status.LogLocation.PersistentVolumeClaimName == "" {
+		return true, nil
+	}
+	job := &batchv1.Job{}
+	namespacedName := types.NamespacedName{Namespace: rayJobInstance.Namespace, Name: rayJobLogsName(rayJobInstance)}
+	if err := r.Client.Get(ctx, namespacedName, job); err != nil {
+		if errors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+	condition, finished := utils.IsJobFinished(job)
+	if finished && condition == batchv1.JobFailed {
+		logger.Info("Failed to write the driver logs to the PersistentVolumeClaim", "Kubernetes Job", job.Name)
+	}
+	return finished, nil
+}
+
+func newRayJobLogsWriterJob(rayJobInstance *rayv1.RayJob, rayClusterInstance *rayv1.RayCluster, name string) *batchv1.Job {
+	pvc := rayJobInstance.Spec.LogRetention.PersistentVolumeClaim
+	address := rayJobInstance.Status.DashboardURL
+	if !strings.HasPrefix(address, "http://") {
+		address = "http://" + address
+	}
+	command := fmt.Sprintf("ray job logs --address %s %s > %s",
+		address, shellescape(rayJobInstance.Status.JobId), path.Join(RayJobLogsVolumeMountPath, name+".log"))
+	template := common.GetDefaultSubmitterTemplate(rayClusterInstance)
+	container := &template.Spec.Containers[utils.RayContainerIndex]
+	container.Name = "ray-job-logs"
+	container.Command = []string{"/bin/bash", "-c", "--"}
+	container.Args = []string{command}
+	container.VolumeMounts = []corev1.VolumeMount{{Name: "ray-job-logs", MountPath: RayJobLogsVolumeMountPath, SubPath: pvc.SubPath}}
+	template.Spec.Volumes = []corev1.Volume{{
+		Name: "ray-job-logs",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.ClaimName},
+		},
+	}}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: rayJobInstance.Namespace,
+			Labels:    rayJobLogsLabels(rayJobInstance),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.To[int32](2),
+			Template:     template,
+		},
+	}
+}
+
+// rayJobLogsName returns the name of the ConfigMap or the Kubernetes Job that retains the driver logs of the current
+// Ray job. The name is derived from the job ID so that the logs of each attempt are kept separately.
+func rayJobLogsName(rayJobInstance *rayv1.RayJob) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(rayJobInstance.Status.JobId))
+	return fmt.Sprintf("%s-logs-%08x", rayJobInstance.Name, hash.Sum32())
+}
+
+func rayJobLogsLabels(rayJobInstance *rayv1.RayJob) map[string]string {
+	return map[string]string{
+		utils.RayOriginatedFromCRNameLabelKey: rayJobInstance.Name,
+		utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayJobCRD),
+		utils.KubernetesCreatedByLabelKey:     utils.ComponentName,
+	}
+}
+
+// tailOfLogs returns at most the last `maxBytes` bytes of `logs` without splitting a UTF-8 character.
+func tailOfLogs(logs string, maxBytes int) string {
+	if len(logs) <= maxBytes {
+		return logs
+	}
+	logs = logs[len(logs)-maxBytes:]
+	for len(logs) > 0 && !utf8.RuneStart(logs[0]) {
+		logs = logs[1:]
+	}
+	return logs
+}
+
+// shellescape quotes `s` so that it is passed to the shell as a single word.
+func shellescape(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
 // deleteClusterResources deletes the RayCluster associated with the RayJob to release the compute resources.
 func (r *RayJobReconciler) deleteClusterResources(ctx context.Context, rayJobInstance *rayv1.RayJob) (bool, error) {
 	logger := ctrl.LoggerFrom(ctx)
+	if rayJobInstance.Spec.RayClusterPoolName != "" {
+		// `Status.RayClusterName` may not have been persisted yet when the RayJob claimed the RayCluster, so the
+		// RayCluster is found through its annotation instead.
+		return r.releaseRayClusterToPool(ctx, rayJobInstance)
+	}
 	clusterIdentifier := common.RayJobRayClusterNamespacedName(rayJobInstance)
+	if clusterIdentifier.Name == "" {
+		// The RayJob finished before a RayCluster was created, e.g., because a RayJob in `DependsOn` failed.
+		logger.Info("The RayJob doesn't have an associated RayCluster")
+		return true, nil
+	}
+	if rayJobInstance.Spec.ClusterPoolSelector != nil {
+		// The RayClusters in the pool are shared by other RayJobs, so they are never deleted by a RayJob.
+		logger.Info("The RayCluster in the pool is not deleted", "RayCluster", clusterIdentifier)
+		return true, nil
+	}
 
 	var isClusterDeleted bool
 	cluster := rayv1.RayCluster{}
@@ -611,6 +1033,79 @@ func (r *RayJobReconciler) deleteClusterResources(ctx context.Context, rayJobIns
 	return isClusterDeleted, nil
 }
 
+// deleteWorkerGroups scales all worker groups of the RayCluster to zero and keeps the head Pod so that users can still
+// debug the Ray job. `MaxReplicas` is also set to zero so that the Ray Autoscaler doesn't scale the worker groups up again.
+func (r *RayJobReconciler) deleteWorkerGroups(ctx context.Context, rayJobInstance *rayv1.RayJob) error {
+	logger := ctrl.LoggerFrom(ctx)
+	clusterIdentifier := common.RayJobRayClusterNamespacedName(rayJobInstance)
+	if clusterIdentifier.Name == "" {
+		logger.Info("The RayJob doesn't have an associated RayCluster")
+		return nil
+	}
+
+	cluster := rayv1.RayCluster{}
+	if err := r.Get(ctx, clusterIdentifier, &cluster); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("The associated RayCluster for RayJob has been already deleted and it can not be found", "RayCluster", clusterIdentifier)
+			return nil
+		}
+		return err
+	}
+	if !cluster.DeletionTimestamp.IsZero() {
+		logger.Info("The deletion of the associated RayCluster for RayJob is ongoing.", "RayCluster", cluster.Name)
+		return nil
+	}
+
+	isScaledDown := true
+	for i := range cluster.Spec.WorkerGroupSpecs {
+		workerGroupSpec := &cluster.Spec.WorkerGroupSpecs[i]
+		if ptr.Deref(workerGroupSpec.Replicas, 0) == 0 && ptr.Deref(workerGroupSpec.MinReplicas, 0) == 0 && ptr.Deref(workerGroupSpec.MaxReplicas, 0) == 0 {
+			continue
+		}
+		isScaledDown = false
+		workerGroupSpec.Replicas = ptr.To[int32](0)
+		workerGroupSpec.MinReplicas = ptr.To[int32](0)
+		workerGroupSpec.MaxReplicas = ptr.To[int32](0)
+	}
+	if isScaledDown {
+		return nil
+	}
+	if err := r.Update(ctx, &cluster); err != nil {
+		r.Recorder.Eventf(rayJobInstance, corev1.EventTypeWarning, string(utils.FailedToDeleteRayClusterWorkers), "Failed to delete the workers of cluster %s/%s: %v", cluster.Namespace, cluster.Name, err)
+		return err
+	}
+	logger.Info("The worker groups of the associated RayCluster for RayJob are scaled to zero", "RayCluster", clusterIdentifier)
+	r.Recorder.Eventf(rayJobInstance, corev1.EventTypeNormal, string(utils.DeletedRayClusterWorkers), "Deleted the workers of cluster %s/%s", cluster.Namespace, cluster.Name)
+	return nil
+}
+
+// getDeletionPolicy returns the deletion policy for a finished RayJob. `DeletionPolicy` chooses between its rules depending
+// on whether the Ray job succeeded. Otherwise, `ShutdownAfterJobFinishes` and the `DELETE_RAYJOB_CR_AFTER_JOB_FINISHES`
+// environment variable of the operator are translated into the corresponding policy.
+func getDeletionPolicy(rayJob *rayv1.RayJob) rayv1.DeletionPolicyType {
+	if deletionPolicy := rayJob.Spec.DeletionPolicy; deletionPolicy != nil {
+		if isRayJobSucceeded(rayJob) {
+			return deletionPolicy.OnSuccess
+		}
+		return deletionPolicy.OnFailure
+	}
+	if usesExistingRayCluster(rayJob) {
+		return rayv1.DeleteNoneDeletionPolicy
+	}
+	if !rayJob.Spec.ShutdownAfterJobFinishes {
+		// A RayCluster claimed from a RayClusterPool is always released to the pool, whose release policy decides
+		// whether the RayCluster is reused or deleted.
+		if rayJob.Spec.RayClusterPoolName != "" {
+			return rayv1.DeleteClusterDeletionPolicy
+		}
+		return rayv1.DeleteNoneDeletionPolicy
+	}
+	if s := os.Getenv(utils.DELETE_RAYJOB_CR_AFTER_JOB_FINISHES); strings.ToLower(s) == "true" {
+		return rayv1.DeleteSelfDeletionPolicy
+	}
+	return rayv1.DeleteClusterDeletionPolicy
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *RayJobReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurrency int) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -618,6 +1113,8 @@ func (r *RayJobReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurren
 		Owns(&rayv1.RayCluster{}).
 		Owns(&corev1.Service{}).
 		Owns(&batchv1.Job{}).
+		// Only the metadata of the ConfigMaps is cached. The runtime environment is read from the API server.
+		WatchesMetadata(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.rayJobsForConfigMap)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: reconcileConcurrency,
 			LogConstructor: func(request *reconcile.Request) logr.Logger {
@@ -631,6 +1128,29 @@ func (r *RayJobReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurren
 		Complete(r)
 }
 
+// rayJobsForConfigMap maps a ConfigMap to the RayJobs that read their runtime environment from it.
+func (r *RayJobReconciler) rayJobsForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	rayJobList := rayv1.RayJobList{}
+	if err := r.List(ctx, &rayJobList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{rayJobRuntimeEnvConfigMapIndexField: obj.GetName()}); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list RayJobs for ConfigMap", "ConfigMap", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, rayJob := range rayJobList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: rayJob.Namespace, Name: rayJob.Name}})
+	}
+	return requests
+}
+
+// rayJobRuntimeEnvConfigMapName indexes a RayJob by the name of the ConfigMap that it reads its runtime environment from.
+func rayJobRuntimeEnvConfigMapName(obj client.Object) []string {
+	rayJob := obj.(*rayv1.RayJob)
+	if selector := rayJob.Spec.RuntimeEnvFrom; selector != nil {
+		return []string{selector.Name}
+	}
+	return nil
+}
+
 // This function is the sole place where `JobDeploymentStatusInitializing` is defined. It initializes `Status.JobId` and `Status.RayClusterName`
 // prior to job submissions and RayCluster creations. This is used to avoid duplicate job submissions and cluster creations. In addition, this
 // function also sets `Status.StartTime` to support `ActiveDeadlineSeconds`.
@@ -662,7 +1182,20 @@ func (r *RayJobReconciler) initRayJobStatusIfNeed(ctx context.Context, rayJob *r
 				return fmt.Errorf("failed to get cluster name in ClusterSelector map, the default key is %v", RayJobDefaultClusterSelectorKey)
 			}
 			rayJob.Status.RayClusterName = useValue
-		} else {
+		} else if rayJob.Spec.ClusterPoolSelector != nil {
+			rayClusterName, err := r.selectRayClusterFromPool(ctx, rayJob)
+			if err != nil {
+				return err
+			}
+			if rayClusterName == "" {
+				// Keep the current JobDeploymentStatus so that the RayCluster is selected again in the next reconciliation.
+				logger.Info("No RayCluster in the pool can accept the Ray job. Wait for a RayCluster to become available.", "ClusterPoolSelector", rayJob.Spec.ClusterPoolSelector)
+				return nil
+			}
+			logger.Info("Selected the least-loaded RayCluster in the pool", "RayCluster", rayClusterName)
+			rayJob.Status.RayClusterName = rayClusterName
+		} else if rayJob.Spec.RayClusterPoolName == "" {
+			// In the RayClusterPool mode, the RayCluster is claimed from the pool in the `Initializing` status instead.
 			rayJob.Status.RayClusterName = utils.GenerateRayClusterName(rayJob.Name)
 		}
 	}
@@ -680,10 +1213,16 @@ func (r *RayJobReconciler) updateRayJobStatus(ctx context.Context, oldRayJob *ra
 	oldRayJobStatus := oldRayJob.Status
 	newRayJobStatus := newRayJob.Status
 	logger.Info("updateRayJobStatus", "oldRayJobStatus", oldRayJobStatus, "newRayJobStatus", newRayJobStatus)
+	// The conditions are derived from JobStatus and JobDeploymentStatus, so they are also updated for
+	// RayJobs that were created before the feature gate was enabled.
+	if features.Enabled(features.RayJobAndRayServiceStatusConditions) {
+		setRayJobConditions(newRayJob)
+	}
 	// If a status field is crucial for the RayJob state machine, it MUST be
 	// updated with a distinct JobStatus or JobDeploymentStatus value.
 	if oldRayJobStatus.JobStatus != newRayJobStatus.JobStatus ||
-		oldRayJobStatus.JobDeploymentStatus != newRayJobStatus.JobDeploymentStatus {
+		oldRayJobStatus.JobDeploymentStatus != newRayJobStatus.JobDeploymentStatus ||
+		!reflect.DeepEqual(oldRayJobStatus.Conditions, newRayJob.Status.Conditions) {
 
 		if newRayJobStatus.JobDeploymentStatus == rayv1.JobDeploymentStatusComplete || newRayJobStatus.JobDeploymentStatus == rayv1.JobDeploymentStatusFailed {
 			newRayJob.Status.EndTime = &metav1.Time{Time: time.Now()}
@@ -698,6 +1237,58 @@ func (r *RayJobReconciler) updateRayJobStatus(ctx context.Context, oldRayJob *ra
 	return nil
 }
 
+// setRayJobConditions sets the conditions of the RayJob according to its JobStatus and JobDeploymentStatus.
+// The reason of a condition that is false is the current JobDeploymentStatus, e.g. `Initializing`.
+func setRayJobConditions(rayJob *rayv1.RayJob) {
+	deploymentStatus := rayJob.Status.JobDeploymentStatus
+	reason := string(deploymentStatus)
+	if deploymentStatus == rayv1.JobDeploymentStatusNew {
+		reason = "New"
+	}
+	setCondition := func(conditionType rayv1.RayJobConditionType, status metav1.ConditionStatus, reason string, message string) {
+		meta.SetStatusCondition(&rayJob.Status.Conditions, metav1.Condition{
+			Type:               string(conditionType),
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: rayJob.Generation,
+		})
+	}
+
+	switch deploymentStatus {
+	case rayv1.JobDeploymentStatusRunning:
+		setCondition(rayv1.RayJobClusterReady, metav1.ConditionTrue, string(rayv1.Ready), fmt.Sprintf("RayCluster %s is ready", rayJob.Status.RayClusterName))
+		setCondition(rayv1.RayJobSubmitted, metav1.ConditionTrue, reason, fmt.Sprintf("Ray job %s has been submitted", rayJob.Status.JobId))
+	case rayv1.JobDeploymentStatusWaiting:
+		setCondition(rayv1.RayJobClusterReady, metav1.ConditionTrue, string(rayv1.Ready), fmt.Sprintf("RayCluster %s is ready", rayJob.Status.RayClusterName))
+		setCondition(rayv1.RayJobSubmitted, metav1.ConditionFalse, reason, "Waiting for the Ray job to be submitted in the InteractiveMode")
+	case rayv1.JobDeploymentStatusComplete, rayv1.JobDeploymentStatusFailed:
+		// The RayCluster may have been deleted after the RayJob finished, so the ClusterReady and
+		// JobSubmitted conditions keep the values they had while the RayJob was running.
+	default:
+		setCondition(rayv1.RayJobClusterReady, metav1.ConditionFalse, reason, "")
+		setCondition(rayv1.RayJobSubmitted, metav1.ConditionFalse, reason, "")
+	}
+
+	switch deploymentStatus {
+	case rayv1.JobDeploymentStatusComplete:
+		setCondition(rayv1.RayJobFinished, metav1.ConditionTrue, reason, fmt.Sprintf("Ray job finished with status %s", rayJob.Status.JobStatus))
+	case rayv1.JobDeploymentStatusFailed:
+		if rayJob.Status.Reason != "" {
+			reason = string(rayJob.Status.Reason)
+		}
+		setCondition(rayv1.RayJobFinished, metav1.ConditionTrue, reason, rayJob.Status.Message)
+	default:
+		setCondition(rayv1.RayJobFinished, metav1.ConditionFalse, reason, "")
+	}
+
+	if deploymentStatus == rayv1.JobDeploymentStatusSuspended {
+		setCondition(rayv1.RayJobSuspended, metav1.ConditionTrue, reason, "")
+	} else {
+		setCondition(rayv1.RayJobSuspended, metav1.ConditionFalse, reason, "")
+	}
+}
+
 func (r *RayJobReconciler) getOrCreateRayClusterInstance(ctx context.Context, rayJobInstance *rayv1.RayJob) (*rayv1.RayCluster, error) {
 	logger := ctrl.LoggerFrom(ctx)
 	rayClusterNamespacedName := common.RayJobRayClusterNamespacedName(rayJobInstance)
@@ -707,10 +1298,13 @@ func (r *RayJobReconciler) getOrCreateRayClusterInstance(ctx context.Context, ra
 	if err := r.Get(ctx, rayClusterNamespacedName, rayClusterInstance); err != nil {
 		if errors.IsNotFound(err) {
 			logger.Info("RayCluster not found", "RayCluster", rayClusterNamespacedName)
-			if len(rayJobInstance.Spec.ClusterSelector) != 0 {
+			if usesExistingRayCluster(rayJobInstance) {
 				err := fmt.Errorf("we have choosed the cluster selector mode, failed to find the cluster named %v, err: %w", rayClusterNamespacedName.Name, err)
 				return nil, err
 			}
+			if rayJobInstance.Spec.RayClusterPoolName != "" {
+				return nil, fmt.Errorf("failed to find the RayCluster %v claimed from RayClusterPool %v: %w", rayClusterNamespacedName.Name, rayJobInstance.Spec.RayClusterPoolName, err)
+			}
 
 			logger.Info("RayCluster not found, creating RayCluster!", "RayCluster", rayClusterNamespacedName)
 			rayClusterInstance, err = r.constructRayClusterForRayJob(rayJobInstance, rayClusterNamespacedName.Name)
@@ -729,8 +1323,8 @@ func (r *RayJobReconciler) getOrCreateRayClusterInstance(ctx context.Context, ra
 	logger.Info("Found the associated RayCluster for RayJob", "RayCluster", rayClusterNamespacedName)
 
 	// Verify that RayJob is not in cluster selector mode first to avoid nil pointer dereference error during spec comparison.
-	// This is checked by ensuring len(rayJobInstance.Spec.ClusterSelector) equals 0.
-	if len(rayJobInstance.Spec.ClusterSelector) == 0 && !utils.CompareJsonStruct(rayClusterInstance.Spec, *rayJobInstance.Spec.RayClusterSpec) {
+	// This is checked by ensuring the RayJob doesn't use an existing RayCluster.
+	if rayJobInstance.Spec.RayClusterSpec != nil && !usesExistingRayCluster(rayJobInstance) && !utils.CompareJsonStruct(rayClusterInstance.Spec, *rayJobInstance.Spec.RayClusterSpec) {
 		logger.Info("Disregard changes in RayClusterSpec of RayJob")
 	}
 
@@ -767,10 +1361,11 @@ func (r *RayJobReconciler) updateStatusToSuspendingIfNeeded(ctx context.Context,
 	if !rayJob.Spec.Suspend {
 		return false
 	}
-	// In KubeRay, only `Running` and `Initializing` are allowed to transition to `Suspending`.
+	// In KubeRay, only `Running`, `Initializing`, and `Blocked` are allowed to transition to `Suspending`.
 	validTransitions := map[rayv1.JobDeploymentStatus]struct{}{
 		rayv1.JobDeploymentStatusRunning:      {},
 		rayv1.JobDeploymentStatusInitializing: {},
+		rayv1.JobDeploymentStatusBlocked:      {},
 	}
 	if _, ok := validTransitions[rayJob.Status.JobDeploymentStatus]; !ok {
 		logger.Info("The current status is not allowed to transition to `Suspending`", "JobDeploymentStatus", rayJob.Status.JobDeploymentStatus)
@@ -778,55 +1373,464 @@ func (r *RayJobReconciler) updateStatusToSuspendingIfNeeded(ctx context.Context,
 	}
 	logger.Info("Try to transition the status to `Suspending`", "oldStatus", rayJob.Status.JobDeploymentStatus)
 	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusSuspending
+	if gracePeriodSeconds := rayJob.Spec.SuspendGracePeriodSeconds; gracePeriodSeconds != nil && *gracePeriodSeconds > 0 {
+		rayJob.Status.SuspendStartTime = &metav1.Time{Time: time.Now()}
+	}
 	return true
 }
 
-func (r *RayJobReconciler) checkK8sJobAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1.RayJob, job *batchv1.Job) bool {
+func (r *RayJobReconciler) checkK8sJobAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1.RayJob, job *batchv1.Job) (bool, error) {
 	logger := ctrl.LoggerFrom(ctx)
 	for _, cond := range job.Status.Conditions {
 		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
 			logger.Info("The submitter Kubernetes Job has failed. Attempting to transition the status to `Failed`.", "Submitter K8s Job", job.Name, "Reason", cond.Reason, "Message", cond.Message)
-			rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
 			// The submitter Job needs to wait for the user code to finish and retrieve its logs.
 			// Therefore, a failed Submitter Job indicates that the submission itself has failed or the user code has thrown an error.
 			// If the failure is due to user code, the JobStatus and Job message will be updated accordingly from the previous reconciliation.
 			if rayJob.Status.JobStatus == rayv1.JobStatusFailed {
+				rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
 				rayJob.Status.Reason = rayv1.AppFailed
+				return true, nil
+			}
+
+			// Distinguish the submitter Pods that couldn't run at all, for example, because they were evicted or
+			// OOMKilled, from the submissions that have failed.
+			message, err := r.diagnoseSubmitterPodsFailure(ctx, job)
+			if err != nil {
+				return false, err
+			}
+			rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+			if message != "" {
+				rayJob.Status.Reason = rayv1.SubmitterPodFailed
+				rayJob.Status.Message = fmt.Sprintf("The submitter Pod has failed. Reason: %s. Message: %s", cond.Reason, message)
+				r.Recorder.Eventf(rayJob, corev1.EventTypeWarning, string(utils.RayJobSubmitterPodFailed), "%s", rayJob.Status.Message)
 			} else {
 				rayJob.Status.Reason = rayv1.SubmissionFailed
 				rayJob.Status.Message = fmt.Sprintf("Job submission has failed. Reason: %s. Message: %s", cond.Reason, cond.Message)
 			}
-			return true
+			return true, nil
 		}
 	}
-	return false
+	return false, nil
+}
+
+// diagnoseSubmitterPodsFailure returns a message describing the failure of the first submitter Pod that was evicted or
+// failed for a reason diagnosed by utils.DiagnosePodFailure. It returns an empty message if no such Pod is found.
+func (r *RayJobReconciler) diagnoseSubmitterPodsFailure(ctx context.Context, job *batchv1.Job) (string, error) {
+	pods := corev1.PodList{}
+	if err := r.Client.List(ctx, &pods, client.InNamespace(job.Namespace), client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+		return "", err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Reason == "Evicted" {
+			return fmt.Sprintf("Pod %s/%s was evicted: %s", pod.Namespace, pod.Name, pod.Status.Message), nil
+		}
+		if reason, message := utils.DiagnosePodFailure(pod); reason != "" {
+			return message, nil
+		}
+	}
+	return "", nil
+}
+
+// checkRayClusterPodsAndUpdateStatusIfNeeded diagnoses the Pods of a RayCluster that isn't ready yet and transitions the
+// status to `Failed` if the RayCluster is unlikely to become ready. A crash-looping or OOMKilled head Pod fails the RayJob
+// once it has restarted `RayJobHeadPodRestartThreshold` times, because a single crash may be transient. The other failures,
+// which may be resolved by the autoscaler or by retrying the image pull, only fail the RayJob after
+// `InitializationDeadlineSeconds` has passed.
+func (r *RayJobReconciler) checkRayClusterPodsAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1.RayJob, rayCluster *rayv1.RayCluster) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	pods := corev1.PodList{}
+	if err := r.Client.List(ctx, &pods, client.InNamespace(rayCluster.Namespace), client.MatchingLabels{utils.RayClusterLabelKey: rayCluster.Name}); err != nil {
+		return false, err
+	}
+
+	var reason rayv1.JobFailedReason
+	var message string
+	for i := range pods.Items {
+		headPod := &pods.Items[i]
+		if headPod.Labels[utils.RayNodeTypeLabelKey] != string(rayv1.HeadNode) {
+			continue
+		}
+		headPodReason, headPodMessage := utils.DiagnosePodFailure(headPod)
+		if headPodReason != rayv1.HeadPodCrashLoopBackOff && headPodReason != rayv1.PodOOMKilled {
+			break
+		}
+		var restartCount int32
+		for _, status := range headPod.Status.ContainerStatuses {
+			restartCount = max(restartCount, status.RestartCount)
+		}
+		if restartCount >= RayJobHeadPodRestartThreshold {
+			reason, message = rayClusterPodsFailureReasons[headPodReason], headPodMessage
+		} else {
+			logger.Info("The head Pod has failed. Wait for it to restart.", "Pod", headPod.Name, "Reason", headPodReason, "RestartCount", restartCount)
+		}
+		break
+	}
+
+	if reason == "" {
+		deadlineSeconds := rayJob.Spec.InitializationDeadlineSeconds
+		if deadlineSeconds == nil || time.Now().Before(rayJob.Status.StartTime.Add(time.Duration(*deadlineSeconds)*time.Second)) {
+			return false, nil
+		}
+		var rayClusterReason string
+		if rayClusterReason, message = utils.DiagnoseRayClusterPodsFailure(pods.Items); rayClusterReason != "" {
+			reason = rayClusterPodsFailureReasons[rayClusterReason]
+		} else {
+			reason = rayv1.ClusterProvisioningTimeout
+			message = fmt.Sprintf("The RayCluster %s/%s is still %q", rayCluster.Namespace, rayCluster.Name, rayCluster.Status.State) //nolint:staticcheck // https://github.com/ray-project/kuberay/pull/2288
+		}
+		message = fmt.Sprintf("The RayCluster didn't become ready within the initializationDeadlineSeconds (%d). %s", *deadlineSeconds, message)
+	}
+
+	logger.Info("The RayCluster can't become ready. Transition the status to `Failed`.", "RayCluster", rayCluster.Name, "Reason", reason, "Message", message)
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+	rayJob.Status.Reason = reason
+	rayJob.Status.Message = message
+	r.Recorder.Eventf(rayJob, corev1.EventTypeWarning, string(rayClusterPodsFailureEventTypes[reason]), "%s", message)
+	return true, nil
 }
 
 func (r *RayJobReconciler) checkActiveDeadlineAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1.RayJob) bool {
 	logger := ctrl.LoggerFrom(ctx)
-	if rayJob.Spec.ActiveDeadlineSeconds == nil || time.Now().Before(rayJob.Status.StartTime.Add(time.Duration(*rayJob.Spec.ActiveDeadlineSeconds)*time.Second)) {
+	// A RayJob blocked by `DependsOn` hasn't started yet, so its deadline is counted from its creation.
+	startTime := rayJob.Status.StartTime
+	if startTime == nil {
+		startTime = &rayJob.CreationTimestamp
+	}
+	if rayJob.Spec.ActiveDeadlineSeconds == nil || time.Now().Before(startTime.Add(time.Duration(*rayJob.Spec.ActiveDeadlineSeconds)*time.Second)) {
 		return false
 	}
 
-	logger.Info("The RayJob has passed the activeDeadlineSeconds. Transition the status to `Failed`.", "StartTime", rayJob.Status.StartTime, "ActiveDeadlineSeconds", *rayJob.Spec.ActiveDeadlineSeconds)
+	logger.Info("The RayJob has passed the activeDeadlineSeconds. Transition the status to `Failed`.", "StartTime", startTime, "ActiveDeadlineSeconds", *rayJob.Spec.ActiveDeadlineSeconds)
 	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
 	rayJob.Status.Reason = rayv1.DeadlineExceeded
-	rayJob.Status.Message = fmt.Sprintf("The RayJob has passed the activeDeadlineSeconds. StartTime: %v. ActiveDeadlineSeconds: %d", rayJob.Status.StartTime, *rayJob.Spec.ActiveDeadlineSeconds)
+	rayJob.Status.Message = fmt.Sprintf("The RayJob has passed the activeDeadlineSeconds. StartTime: %v. ActiveDeadlineSeconds: %d", startTime, *rayJob.Spec.ActiveDeadlineSeconds)
 	return true
 }
 
+// checkDependenciesAndUpdateStatusIfNeeded checks the RayJobs in `DependsOn` and returns whether the RayJob is still blocked.
+// If any of them has finished in a state other than the required one, it transitions the status to `Failed` instead.
+func (r *RayJobReconciler) checkDependenciesAndUpdateStatusIfNeeded(ctx context.Context, rayJob *rayv1.RayJob) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	isBlocked := false
+	for _, dependency := range rayJob.Spec.DependsOn {
+		dependencyRayJob := &rayv1.RayJob{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: rayJob.Namespace, Name: dependency.Name}, dependencyRayJob); err != nil {
+			if errors.IsNotFound(err) {
+				// The RayJob may not have been created yet, so the RayJob stays blocked until activeDeadlineSeconds passes.
+				logger.Info("The RayJob in dependsOn is not found", "RayJob", dependency.Name)
+				r.Recorder.Eventf(rayJob, corev1.EventTypeWarning, string(utils.RayJobDependencyNotFound),
+					"The RayJob %s/%s in dependsOn is not found", rayJob.Namespace, dependency.Name)
+				isBlocked = true
+				continue
+			}
+			return true, err
+		}
+		if !isRayJobFinished(dependencyRayJob) {
+			isBlocked = true
+			continue
+		}
+		if !isRayJobDependencySatisfied(dependency, dependencyRayJob) {
+			logger.Info("The RayJob in dependsOn has finished in an unexpected state. Transition the status to `Failed`.",
+				"RayJob", dependency.Name, "Condition", dependency.Condition,
+				"JobDeploymentStatus", dependencyRayJob.Status.JobDeploymentStatus, "JobStatus", dependencyRayJob.Status.JobStatus)
+			rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+			rayJob.Status.Reason = rayv1.DependencyFailed
+			rayJob.Status.Message = fmt.Sprintf("The RayJob %s in dependsOn finished with JobDeploymentStatus %s and JobStatus %s, which doesn't satisfy the condition %s",
+				dependency.Name, dependencyRayJob.Status.JobDeploymentStatus, dependencyRayJob.Status.JobStatus, dependencyCondition(dependency))
+			return false, nil
+		}
+	}
+	return isBlocked, nil
+}
+
+// isRayJobDependencySatisfied returns whether a finished RayJob satisfies the condition of the dependency.
+func isRayJobDependencySatisfied(dependency rayv1.RayJobDependency, rayJob *rayv1.RayJob) bool {
+	switch dependencyCondition(dependency) {
+	case rayv1.RayJobDependencyAny:
+		return true
+	case rayv1.RayJobDependencyFailed:
+		return !isRayJobSucceeded(rayJob)
+	default:
+		return isRayJobSucceeded(rayJob)
+	}
+}
+
+func dependencyCondition(dependency rayv1.RayJobDependency) rayv1.RayJobDependencyCondition {
+	if dependency.Condition == "" {
+		return rayv1.RayJobDependencyComplete
+	}
+	return dependency.Condition
+}
+
+// usesExistingRayCluster returns whether the RayJob runs on a RayCluster that it doesn't create, i.e., a RayCluster
+// selected by ClusterSelector or ClusterPoolSelector.
+func usesExistingRayCluster(rayJob *rayv1.RayJob) bool {
+	return len(rayJob.Spec.ClusterSelector) != 0 || rayJob.Spec.ClusterPoolSelector != nil
+}
+
+// selectRayClusterFromPool returns the name of the `Ready` RayCluster selected by ClusterPoolSelector that runs the fewest
+// Ray jobs. The RayClusters that have reached MaxConcurrentJobsPerCluster are skipped. If no RayCluster in the pool can
+// accept the Ray job, an empty name is returned.
+func (r *RayJobReconciler) selectRayClusterFromPool(ctx context.Context, rayJob *rayv1.RayJob) (string, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	selector, err := metav1.LabelSelectorAsSelector(rayJob.Spec.ClusterPoolSelector)
+	if err != nil {
+		return "", err
+	}
+	rayClusterList := rayv1.RayClusterList{}
+	if err := r.List(ctx, &rayClusterList, client.InNamespace(rayJob.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return "", err
+	}
+
+	// The RayJobs that have selected a RayCluster but haven't submitted their Ray jobs yet are invisible to the Ray
+	// dashboard, so they are counted from their statuses. Otherwise, concurrent RayJobs could all select the same RayCluster.
+	rayJobList := rayv1.RayJobList{}
+	if err := r.List(ctx, &rayJobList, client.InNamespace(rayJob.Namespace)); err != nil {
+		return "", err
+	}
+	assignedRayJobs := map[string][]*rayv1.RayJob{}
+	for i := range rayJobList.Items {
+		assignedRayJob := &rayJobList.Items[i]
+		if assignedRayJob.UID == rayJob.UID || assignedRayJob.Status.RayClusterName == "" || isRayJobDeploymentStatusFinished(assignedRayJob.Status.JobDeploymentStatus) {
+			continue
+		}
+		assignedRayJobs[assignedRayJob.Status.RayClusterName] = append(assignedRayJobs[assignedRayJob.Status.RayClusterName], assignedRayJob)
+	}
+
+	selectedRayClusterName := ""
+	minNumRunningJobs := math.MaxInt
+	for i := range rayClusterList.Items {
+		rayCluster := &rayClusterList.Items[i]
+		if rayCluster.Status.State != rayv1.Ready || !rayCluster.DeletionTimestamp.IsZero() { //nolint:staticcheck // https://github.com/ray-project/kuberay/pull/2288
+			continue
+		}
+		numRunningJobs, err := r.countRunningRayJobs(ctx, rayCluster, assignedRayJobs[rayCluster.Name])
+		if err != nil {
+			// A RayCluster whose dashboard can't be reached is skipped instead of blocking the selection.
+			logger.Info("Failed to list the Ray jobs of the RayCluster in the pool", "RayCluster", rayCluster.Name, "error", err)
+			continue
+		}
+		if maxJobs := rayJob.Spec.MaxConcurrentJobsPerCluster; maxJobs != nil && numRunningJobs >= int(*maxJobs) {
+			logger.Info("The RayCluster in the pool has reached maxConcurrentJobsPerCluster", "RayCluster", rayCluster.Name, "runningJobs", numRunningJobs)
+			continue
+		}
+		if numRunningJobs < minNumRunningJobs {
+			selectedRayClusterName = rayCluster.Name
+			minNumRunningJobs = numRunningJobs
+		}
+	}
+	return selectedRayClusterName, nil
+}
+
+// countRunningRayJobs returns the number of Ray jobs on the RayCluster that haven't reached a terminal status. The unfinished
+// RayJobs assigned to the RayCluster whose Ray jobs are unknown to the Ray dashboard, e.g., because they haven't been submitted
+// yet, are counted as well.
+func (r *RayJobReconciler) countRunningRayJobs(ctx context.Context, rayCluster *rayv1.RayCluster, assignedRayJobs []*rayv1.RayJob) (int, error) {
+	dashboardURL, err := utils.FetchHeadServiceURL(ctx, r.Client, rayCluster, utils.DashboardPortName)
+	if err != nil {
+		return 0, err
+	}
+	rayDashboardClient := r.dashboardClientFunc()
+	if err := rayDashboardClient.InitClient(ctx, dashboardURL, rayCluster); err != nil {
+		return 0, err
+	}
+	jobInfos, err := rayDashboardClient.ListJobs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	numRunningJobs := 0
+	submissionIds := map[string]struct{}{}
+	if jobInfos != nil {
+		for _, jobInfo := range *jobInfos {
+			submissionIds[jobInfo.SubmissionId] = struct{}{}
+			if !rayv1.IsJobTerminal(jobInfo.JobStatus) {
+				numRunningJobs++
+			}
+		}
+	}
+	for _, rayJob := range assignedRayJobs {
+		if _, ok := submissionIds[rayJob.Status.JobId]; !ok || rayJob.Status.JobId == "" {
+			numRunningJobs++
+		}
+	}
+	return numRunningJobs, nil
+}
+
+// claimRayClusterFromPool returns the name of the RayCluster that the RayJob claims from its RayClusterPool. If the RayJob
+// has already claimed a RayCluster, e.g., in a reconciliation whose status update wasn't persisted, that RayCluster is
+// returned. Otherwise, an idle RayCluster created from the current template of the pool is claimed, and a `Ready` one is
+// preferred. If the pool has no idle RayCluster, an empty name is returned.
+func (r *RayJobReconciler) claimRayClusterFromPool(ctx context.Context, rayJob *rayv1.RayJob) (string, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	rayClusterPool := &rayv1.RayClusterPool{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJob.Spec.RayClusterPoolName}, rayClusterPool); err != nil {
+		return "", err
+	}
+	templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+	if err != nil {
+		return "", err
+	}
+	rayClusters, err := listRayClusterPoolRayClusters(ctx, r.Client, rayClusterPool)
+	if err != nil {
+		return "", err
+	}
+
+	var idleRayCluster *rayv1.RayCluster
+	for _, rayCluster := range rayClusters {
+		if !rayCluster.DeletionTimestamp.IsZero() {
+			continue
+		}
+		claimedBy, isClaimed := rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey]
+		if isClaimed {
+			if claimedBy == rayJob.Name {
+				return rayCluster.Name, nil
+			}
+			continue
+		}
+		// The idle RayClusters created from an outdated template are replaced by the RayClusterPool controller.
+		if rayCluster.Annotations[utils.RayClusterPoolTemplateHashAnnotationKey] != templateHash {
+			continue
+		}
+		if idleRayCluster == nil || (idleRayCluster.Status.State != rayv1.Ready && rayCluster.Status.State == rayv1.Ready) { //nolint:staticcheck // https://github.com/ray-project/kuberay/pull/2288
+			idleRayCluster = rayCluster
+		}
+	}
+	if idleRayCluster == nil {
+		return "", nil
+	}
+
+	// The update is rejected with a conflict if another RayJob claims the same RayCluster at the same time.
+	if idleRayCluster.Annotations == nil {
+		idleRayCluster.Annotations = map[string]string{}
+	}
+	idleRayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = rayJob.Name
+	if err := r.Update(ctx, idleRayCluster); err != nil {
+		return "", err
+	}
+	logger.Info("Claimed a RayCluster from the pool", "RayCluster", idleRayCluster.Name, "RayClusterPool", rayClusterPool.Name)
+	r.Recorder.Eventf(rayJob, corev1.EventTypeNormal, string(utils.ClaimedRayCluster), "Claimed RayCluster %s/%s from RayClusterPool %s", idleRayCluster.Namespace, idleRayCluster.Name, rayClusterPool.Name)
+	return idleRayCluster.Name, nil
+}
+
+// releaseRayClusterToPool releases the RayCluster claimed by the RayJob according to the release policy of its RayClusterPool.
+// The RayCluster is returned to the pool only if the policy is `Reuse` and the RayCluster was created from the current template
+// of the pool. Otherwise, it is deleted. It returns true once the RayJob no longer holds a RayCluster of the pool.
+func (r *RayJobReconciler) releaseRayClusterToPool(ctx context.Context, rayJob *rayv1.RayJob) (bool, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	rayClusterPool := &rayv1.RayClusterPool{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJob.Spec.RayClusterPoolName}, rayClusterPool); err != nil {
+		if errors.IsNotFound(err) {
+			// The RayClusters of a deleted pool are garbage collected by Kubernetes through their owner references.
+			logger.Info("The RayClusterPool has been deleted", "RayClusterPool", rayJob.Spec.RayClusterPoolName)
+			return true, nil
+		}
+		return false, err
+	}
+	rayClusters, err := listRayClusterPoolRayClusters(ctx, r.Client, rayClusterPool)
+	if err != nil {
+		return false, err
+	}
+
+	isReleased := true
+	for _, rayCluster := range rayClusters {
+		if rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] != rayJob.Name {
+			continue
+		}
+		if !rayCluster.DeletionTimestamp.IsZero() {
+			logger.Info("The deletion of the RayCluster claimed from the pool is ongoing.", "RayCluster", rayCluster.Name)
+			isReleased = false
+			continue
+		}
+		shouldReuse, err := shouldReuseRayClusterPoolRayCluster(rayClusterPool, rayCluster)
+		if err != nil {
+			return false, err
+		}
+		if shouldReuse {
+			r.stopRayJobBeforeRelease(ctx, rayJob, rayCluster)
+			delete(rayCluster.Annotations, utils.RayClusterPoolClaimedByAnnotationKey)
+			if err := r.Update(ctx, rayCluster); err != nil {
+				return false, err
+			}
+			logger.Info("Returned the RayCluster to the pool", "RayCluster", rayCluster.Name, "RayClusterPool", rayClusterPool.Name)
+			r.Recorder.Eventf(rayJob, corev1.EventTypeNormal, string(utils.ReleasedRayCluster), "Returned RayCluster %s/%s to RayClusterPool %s", rayCluster.Namespace, rayCluster.Name, rayClusterPool.Name)
+			continue
+		}
+		if err := r.Delete(ctx, rayCluster); err != nil {
+			r.Recorder.Eventf(rayJob, corev1.EventTypeWarning, string(utils.FailedToDeleteRayCluster), "Failed to delete cluster %s/%s: %v", rayCluster.Namespace, rayCluster.Name, err)
+			return false, err
+		}
+		logger.Info("The RayCluster claimed from the pool is deleted", "RayCluster", rayCluster.Name, "RayClusterPool", rayClusterPool.Name)
+		r.Recorder.Eventf(rayJob, corev1.EventTypeNormal, string(utils.DeletedRayCluster), "Deleted cluster %s/%s", rayCluster.Namespace, rayCluster.Name)
+		isReleased = false
+	}
+	return isReleased, nil
+}
+
+// stopRayJobBeforeRelease stops the Ray job if it may still be running, so that it doesn't occupy the RayCluster after
+// the RayCluster is returned to the pool. Errors are only logged because the RayCluster is released anyway.
+func (r *RayJobReconciler) stopRayJobBeforeRelease(ctx context.Context, rayJob *rayv1.RayJob, rayCluster *rayv1.RayCluster) {
+	logger := ctrl.LoggerFrom(ctx)
+	if rayJob.Status.JobId == "" || rayJob.Status.DashboardURL == "" || rayv1.IsJobTerminal(rayJob.Status.JobStatus) {
+		return
+	}
+	rayDashboardClient := r.dashboardClientFunc()
+	if err := rayDashboardClient.InitClient(ctx, rayJob.Status.DashboardURL, rayCluster); err != nil {
+		logger.Error(err, "Failed to initialize dashboard client")
+		return
+	}
+	if err := rayDashboardClient.StopJob(ctx, rayJob.Status.JobId); err != nil {
+		logger.Error(err, "Failed to stop the Ray job before returning the RayCluster to the pool", "JobId", rayJob.Status.JobId)
+	}
+}
+
 func validateRayJobSpec(rayJob *rayv1.RayJob) error {
 	// KubeRay has some limitations for the suspend operation. The limitations are a subset of the limitations of
 	// Kueue (https://kueue.sigs.k8s.io/docs/tasks/run_rayjobs/#c-limitations). For example, KubeRay allows users
 	// to suspend a RayJob with autoscaling enabled, but Kueue doesn't.
-	if rayJob.Spec.Suspend && !rayJob.Spec.ShutdownAfterJobFinishes {
-		return fmt.Errorf("a RayJob with shutdownAfterJobFinishes set to false is not allowed to be suspended")
+	if rayJob.Spec.Suspend {
+		// A deletionPolicy replaces shutdownAfterJobFinishes, so the RayJob can be suspended as long as both of
+		// its rules delete the RayCluster.
+		if deletionPolicy := rayJob.Spec.DeletionPolicy; deletionPolicy != nil {
+			if deletionPolicy.OnSuccess == rayv1.DeleteNoneDeletionPolicy || deletionPolicy.OnFailure == rayv1.DeleteNoneDeletionPolicy {
+				return fmt.Errorf("a RayJob with the deletion policy %s is not allowed to be suspended", rayv1.DeleteNoneDeletionPolicy)
+			}
+		} else if !rayJob.Spec.ShutdownAfterJobFinishes {
+			return fmt.Errorf("a RayJob with shutdownAfterJobFinishes set to false is not allowed to be suspended")
+		}
 	}
 	if rayJob.Spec.Suspend && len(rayJob.Spec.ClusterSelector) != 0 {
 		return fmt.Errorf("the ClusterSelector mode doesn't support the suspend operation")
 	}
-	if rayJob.Spec.RayClusterSpec == nil && len(rayJob.Spec.ClusterSelector) == 0 {
-		return fmt.Errorf("one of RayClusterSpec or ClusterSelector must be set")
+	if rayJob.Spec.ClusterPoolSelector != nil {
+		if rayJob.Spec.Suspend {
+			return fmt.Errorf("the ClusterPoolSelector mode doesn't support the suspend operation")
+		}
+		if len(rayJob.Spec.ClusterSelector) != 0 || rayJob.Spec.RayClusterSpec != nil {
+			return fmt.Errorf("clusterPoolSelector can't be set together with clusterSelector or rayClusterSpec")
+		}
+		if _, err := metav1.LabelSelectorAsSelector(rayJob.Spec.ClusterPoolSelector); err != nil {
+			return fmt.Errorf("invalid clusterPoolSelector: %w", err)
+		}
+	}
+	if rayJob.Spec.MaxConcurrentJobsPerCluster != nil && rayJob.Spec.ClusterPoolSelector == nil {
+		return fmt.Errorf("maxConcurrentJobsPerCluster can only be set together with clusterPoolSelector")
+	}
+	if rayJob.Spec.RayClusterPoolName != "" {
+		if rayJob.Spec.RayClusterSpec != nil || usesExistingRayCluster(rayJob) {
+			return fmt.Errorf("rayClusterPoolName can't be set together with rayClusterSpec, clusterSelector or clusterPoolSelector")
+		}
+		if deletionPolicy := rayJob.Spec.DeletionPolicy; deletionPolicy != nil &&
+			(deletionPolicy.OnSuccess == rayv1.DeleteWorkersDeletionPolicy || deletionPolicy.OnFailure == rayv1.DeleteWorkersDeletionPolicy) {
+			return fmt.Errorf("the RayClusterPool mode doesn't support the deletion policy %s", rayv1.DeleteWorkersDeletionPolicy)
+		}
+	}
+	if rayJob.Spec.RayClusterSpec == nil && !usesExistingRayCluster(rayJob) && rayJob.Spec.RayClusterPoolName == "" {
+		return fmt.Errorf("one of RayClusterSpec, ClusterSelector, ClusterPoolSelector or RayClusterPoolName must be set")
+	}
+	if rayJob.Spec.RuntimeEnvFrom != nil && rayJob.Spec.RuntimeEnvYAML != "" {
+		return fmt.Errorf("runtimeEnvYAML and runtimeEnvFrom can't be set at the same time")
 	}
 	// Validate whether RuntimeEnvYAML is a valid YAML string. Note that this only checks its validity
 	// as a YAML string, not its adherence to the runtime environment schema.
@@ -836,9 +1840,35 @@ func validateRayJobSpec(rayJob *rayv1.RayJob) error {
 	if rayJob.Spec.ActiveDeadlineSeconds != nil && *rayJob.Spec.ActiveDeadlineSeconds <= 0 {
 		return fmt.Errorf("activeDeadlineSeconds must be a positive integer")
 	}
+	if rayJob.Spec.InitializationDeadlineSeconds != nil && *rayJob.Spec.InitializationDeadlineSeconds <= 0 {
+		return fmt.Errorf("initializationDeadlineSeconds must be a positive integer")
+	}
 	if rayJob.Spec.BackoffLimit != nil && *rayJob.Spec.BackoffLimit < 0 {
 		return fmt.Errorf("backoffLimit must be a positive integer")
 	}
+	if logRetention := rayJob.Spec.LogRetention; logRetention != nil && (logRetention.ConfigMap == nil) == (logRetention.PersistentVolumeClaim == nil) {
+		return fmt.Errorf("exactly one of configMap and persistentVolumeClaim must be set in logRetention")
+	}
+	if deletionPolicy := rayJob.Spec.DeletionPolicy; deletionPolicy != nil {
+		if rayJob.Spec.ShutdownAfterJobFinishes {
+			return fmt.Errorf("deletionPolicy and shutdownAfterJobFinishes can't be set at the same time")
+		}
+		if usesExistingRayCluster(rayJob) {
+			for _, policy := range []rayv1.DeletionPolicyType{deletionPolicy.OnSuccess, deletionPolicy.OnFailure} {
+				if policy == rayv1.DeleteClusterDeletionPolicy || policy == rayv1.DeleteWorkersDeletionPolicy {
+					return fmt.Errorf("the ClusterSelector and ClusterPoolSelector modes don't support the deletion policy %s", policy)
+				}
+			}
+		}
+	}
+	for _, dependency := range rayJob.Spec.DependsOn {
+		if dependency.Name == rayJob.Name {
+			return fmt.Errorf("a RayJob can't depend on itself")
+		}
+	}
+	if isSameClusterRetry(rayJob) && rayJob.Spec.SubmissionMode == rayv1.InteractiveMode {
+		return fmt.Errorf("retryPolicy %s is not supported in InteractiveMode", rayv1.SameClusterRetry)
+	}
 	return nil
 }
 
@@ -3,24 +3,32 @@ package ray
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	utils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
 	"github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
+	"github.com/ray-project/kuberay/ray-operator/pkg/features"
 )
 
 func TestCreateRayJobSubmitterIfNeed(t *testing.T) {
@@ -196,6 +204,94 @@ func TestGetSubmitterTemplate(t *testing.T) {
 	assert.Equal(t, "test-job-id", envVar.Value)
 }
 
+func TestGetSubmitterTemplate_RuntimeEnvFrom(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	rayJobInstance := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayJobSpec{
+			Entrypoint: "echo hello world",
+			RuntimeEnvFrom: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "runtime-env"},
+				Key:                  "runtime_env.yaml",
+			},
+			SubmitterPodTemplate: &corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{}},
+				},
+			},
+		},
+		Status: rayv1.RayJobStatus{
+			DashboardURL: "test-url",
+			JobId:        "test-job-id",
+		},
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime-env",
+			Namespace: namespace,
+		},
+		Data: map[string]string{"runtime_env.yaml": "pip: [\"requests\"]"},
+	}
+	ctx := context.Background()
+
+	// The runtime environment is read from the ConfigMap when the submitter template is built.
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(configMap).Build()
+	r := &RayJobReconciler{Client: fakeClient}
+	submitterTemplate, err := r.getSubmitterTemplate(ctx, rayJobInstance, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ray", "job", "submit", "--address", "http://test-url", "--runtime-env-json", `{"pip":["requests"]}`, "--submission-id", "test-job-id", "--", "echo", "hello", "world"}, submitterTemplate.Spec.Containers[utils.RayContainerIndex].Command)
+	assert.Empty(t, rayJobInstance.Spec.RuntimeEnvYAML)
+
+	// The ConfigMap doesn't exist.
+	r = &RayJobReconciler{Client: clientFake.NewClientBuilder().WithScheme(newScheme).Build()}
+	_, err = r.getSubmitterTemplate(ctx, rayJobInstance, nil)
+	assert.Error(t, err)
+}
+
+func TestRayJobsForConfigMap(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "default"
+	newRayJob := func(name string, configMapName string) *rayv1.RayJob {
+		rayJob := &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+		if configMapName != "" {
+			rayJob.Spec.RuntimeEnvFrom = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+				Key:                  "runtime_env.yaml",
+			}
+		}
+		return rayJob
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(
+		newRayJob("rayjob-1", "runtime-env"),
+		newRayJob("rayjob-2", "other-runtime-env"),
+		newRayJob("rayjob-3", ""),
+	).WithIndex(&rayv1.RayJob{}, rayJobRuntimeEnvConfigMapIndexField, rayJobRuntimeEnvConfigMapName).Build()
+	r := &RayJobReconciler{Client: fakeClient}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime-env",
+			Namespace: namespace,
+		},
+	}
+	requests := r.rayJobsForConfigMap(context.Background(), configMap)
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "rayjob-1"}}}, requests)
+}
+
 func TestUpdateStatusToSuspendingIfNeeded(t *testing.T) {
 	newScheme := runtime.NewScheme()
 	_ = rayv1.AddToScheme(newScheme)
@@ -220,6 +316,11 @@ func TestUpdateStatusToSuspendingIfNeeded(t *testing.T) {
 			status:               rayv1.JobDeploymentStatusInitializing,
 			expectedShouldUpdate: true,
 		},
+		"Suspend is true, and the RayJob is blocked by its dependencies": {
+			suspend:              true,
+			status:               rayv1.JobDeploymentStatusBlocked,
+			expectedShouldUpdate: true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -232,7 +333,8 @@ func TestUpdateStatusToSuspendingIfNeeded(t *testing.T) {
 					Namespace: namespace,
 				},
 				Spec: rayv1.RayJobSpec{
-					Suspend: tc.suspend,
+					Suspend:                   tc.suspend,
+					SuspendGracePeriodSeconds: ptr.To[int32](30),
 				},
 				Status: rayv1.RayJobStatus{
 					JobDeploymentStatus: tc.status,
@@ -257,8 +359,10 @@ func TestUpdateStatusToSuspendingIfNeeded(t *testing.T) {
 
 			if tc.expectedShouldUpdate {
 				assert.Equal(t, rayv1.JobDeploymentStatusSuspending, rayJob.Status.JobDeploymentStatus)
+				assert.NotNil(t, rayJob.Status.SuspendStartTime)
 			} else {
 				assert.Equal(t, tc.status, rayJob.Status.JobDeploymentStatus)
+				assert.Nil(t, rayJob.Status.SuspendStartTime)
 			}
 		})
 	}
@@ -330,6 +434,86 @@ func TestUpdateRayJobStatus(t *testing.T) {
 	}
 }
 
+func TestUpdateRayJobStatus_Conditions(t *testing.T) {
+	defer features.SetFeatureGateDuringTest(t, features.RayJobAndRayServiceStatusConditions, true)()
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	// The RayJob was created before the feature gate was enabled, so it has no conditions.
+	oldRayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+		Status: rayv1.RayJobStatus{
+			JobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+			JobStatus:           rayv1.JobStatusRunning,
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(oldRayJob).
+		WithStatusSubresource(oldRayJob).Build()
+	ctx := context.Background()
+	testRayJobReconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+
+	newRayJob := &rayv1.RayJob{}
+	err := fakeClient.Get(ctx, types.NamespacedName{Namespace: oldRayJob.Namespace, Name: oldRayJob.Name}, newRayJob)
+	assert.NoError(t, err)
+	err = testRayJobReconciler.updateRayJobStatus(ctx, oldRayJob, newRayJob)
+	assert.NoError(t, err)
+
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: oldRayJob.Namespace, Name: oldRayJob.Name}, newRayJob)
+	assert.NoError(t, err)
+	assert.True(t, meta.IsStatusConditionTrue(newRayJob.Status.Conditions, string(rayv1.RayJobClusterReady)))
+	assert.True(t, meta.IsStatusConditionTrue(newRayJob.Status.Conditions, string(rayv1.RayJobSubmitted)))
+	assert.True(t, meta.IsStatusConditionFalse(newRayJob.Status.Conditions, string(rayv1.RayJobFinished)))
+	assert.True(t, meta.IsStatusConditionFalse(newRayJob.Status.Conditions, string(rayv1.RayJobSuspended)))
+}
+
+func TestSetRayJobConditions(t *testing.T) {
+	rayJob := &rayv1.RayJob{}
+	assertConditions := func(clusterReady, submitted, finished, suspended metav1.ConditionStatus) {
+		assert.True(t, meta.IsStatusConditionPresentAndEqual(rayJob.Status.Conditions, string(rayv1.RayJobClusterReady), clusterReady))
+		assert.True(t, meta.IsStatusConditionPresentAndEqual(rayJob.Status.Conditions, string(rayv1.RayJobSubmitted), submitted))
+		assert.True(t, meta.IsStatusConditionPresentAndEqual(rayJob.Status.Conditions, string(rayv1.RayJobFinished), finished))
+		assert.True(t, meta.IsStatusConditionPresentAndEqual(rayJob.Status.Conditions, string(rayv1.RayJobSuspended), suspended))
+	}
+
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusNew
+	setRayJobConditions(rayJob)
+	assertConditions(metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionFalse)
+	assert.Equal(t, "New", meta.FindStatusCondition(rayJob.Status.Conditions, string(rayv1.RayJobClusterReady)).Reason)
+
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusInitializing
+	setRayJobConditions(rayJob)
+	assertConditions(metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionFalse)
+	assert.Equal(t, string(rayv1.JobDeploymentStatusInitializing), meta.FindStatusCondition(rayJob.Status.Conditions, string(rayv1.RayJobClusterReady)).Reason)
+
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusWaiting
+	setRayJobConditions(rayJob)
+	assertConditions(metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionFalse)
+
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusRunning
+	setRayJobConditions(rayJob)
+	assertConditions(metav1.ConditionTrue, metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionFalse)
+
+	// ClusterReady and JobSubmitted keep their values after the RayJob has finished.
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+	rayJob.Status.Reason = rayv1.AppFailed
+	setRayJobConditions(rayJob)
+	assertConditions(metav1.ConditionTrue, metav1.ConditionTrue, metav1.ConditionTrue, metav1.ConditionFalse)
+	assert.Equal(t, string(rayv1.AppFailed), meta.FindStatusCondition(rayJob.Status.Conditions, string(rayv1.RayJobFinished)).Reason)
+
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusSuspended
+	setRayJobConditions(rayJob)
+	assertConditions(metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionFalse, metav1.ConditionTrue)
+}
+
 func TestValidateRayJobSpec(t *testing.T) {
 	err := validateRayJobSpec(&rayv1.RayJob{})
 	assert.Error(t, err, "The RayJob is invalid because both `RayClusterSpec` and `ClusterSelector` are empty")
@@ -368,140 +552,1028 @@ func TestValidateRayJobSpec(t *testing.T) {
 	})
 	assert.Error(t, err, "The RayJob is invalid because the runtimeEnvYAML is invalid.")
 
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RuntimeEnvYAML: "pip: [\"requests\"]",
+			RuntimeEnvFrom: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "runtime-env"},
+				Key:                  "runtime_env.yaml",
+			},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because runtimeEnvYAML and runtimeEnvFrom are both set.")
+
 	err = validateRayJobSpec(&rayv1.RayJob{
 		Spec: rayv1.RayJobSpec{
 			BackoffLimit: ptr.To[int32](-1),
 		},
 	})
 	assert.Error(t, err, "The RayJob is invalid because the backoffLimit must be a positive integer.")
-}
 
-func TestFailedToCreateRayJobSubmitterEvent(t *testing.T) {
-	rayJob := &rayv1.RayJob{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-rayjob",
-			Namespace: "default",
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			InitializationDeadlineSeconds: ptr.To[int32](0),
+			RayClusterSpec:                &rayv1.RayClusterSpec{},
 		},
-	}
+	})
+	assert.Error(t, err, "The RayJob is invalid because the initializationDeadlineSeconds must be a positive integer.")
 
-	submitterTemplate := corev1.PodTemplateSpec{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-submit-pod",
-			Namespace: "default",
-		},
-		Spec: corev1.PodSpec{
-			Containers: []corev1.Container{
-				{
-					Name:  "ray-submit",
-					Image: "rayproject/ray:latest",
-				},
-			},
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RetryPolicy:    ptr.To(rayv1.SameClusterRetry),
+			SubmissionMode: rayv1.InteractiveMode,
+			RayClusterSpec: &rayv1.RayClusterSpec{},
 		},
-	}
+	})
+	assert.Error(t, err, "The RayJob is invalid because InteractiveMode doesn't support the SameCluster retry policy.")
 
-	fakeClient := clientFake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
-		Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
-			return errors.New("random")
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			LogRetention:   &rayv1.LogRetention{},
+			RayClusterSpec: &rayv1.RayClusterSpec{},
 		},
-	}).WithScheme(scheme.Scheme).Build()
-
-	recorder := record.NewFakeRecorder(100)
+	})
+	assert.Error(t, err, "The RayJob is invalid because neither configMap nor persistentVolumeClaim is set in logRetention.")
 
-	reconciler := &RayJobReconciler{
-		Client:   fakeClient,
-		Recorder: recorder,
-		Scheme:   scheme.Scheme,
-	}
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			DeletionPolicy:           &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteClusterDeletionPolicy, OnFailure: rayv1.DeleteWorkersDeletionPolicy},
+			ShutdownAfterJobFinishes: true,
+			RayClusterSpec:           &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because deletionPolicy and shutdownAfterJobFinishes are both set.")
 
-	err := reconciler.createNewK8sJob(context.Background(), rayJob, submitterTemplate)
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			DeletionPolicy:  &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteSelfDeletionPolicy, OnFailure: rayv1.DeleteWorkersDeletionPolicy},
+			ClusterSelector: map[string]string{"key": "value"},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because the ClusterSelector mode doesn't support DeleteWorkers.")
 
-	assert.NotNil(t, err, "Expected error due to simulated job creation failure")
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			DeletionPolicy:  &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteSelfDeletionPolicy, OnFailure: rayv1.DeleteNoneDeletionPolicy},
+			ClusterSelector: map[string]string{"key": "value"},
+		},
+	})
+	assert.NoError(t, err, "The RayJob is valid because the ClusterSelector mode supports DeleteSelf and DeleteNone.")
 
-	var foundFailureEvent bool
-	events := []string{}
-	for len(recorder.Events) > 0 {
-		event := <-recorder.Events
-		if strings.Contains(event, "Failed to create new Kubernetes Job") {
-			foundFailureEvent = true
-			break
-		}
-		events = append(events, event)
-	}
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			Suspend:        true,
+			DeletionPolicy: &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteClusterDeletionPolicy, OnFailure: rayv1.DeleteSelfDeletionPolicy},
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.NoError(t, err, "The RayJob is valid because a deletionPolicy that deletes the RayCluster replaces shutdownAfterJobFinishes for suspend.")
 
-	assert.Truef(t, foundFailureEvent, "Expected event to be generated for job creation failure, got events: %s", strings.Join(events, "\n"))
-}
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			Suspend:        true,
+			DeletionPolicy: &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteClusterDeletionPolicy, OnFailure: rayv1.DeleteNoneDeletionPolicy},
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because a RayJob with the deletion policy DeleteNone is not allowed to be suspended.")
 
-func TestFailedCreateRayClusterEvent(t *testing.T) {
-	rayJob := &rayv1.RayJob{
+	err = validateRayJobSpec(&rayv1.RayJob{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-rayjob",
-			Namespace: "default",
+			Name: "test-rayjob",
 		},
 		Spec: rayv1.RayJobSpec{
+			DependsOn:      []rayv1.RayJobDependency{{Name: "test-rayjob"}},
 			RayClusterSpec: &rayv1.RayClusterSpec{},
 		},
-	}
+	})
+	assert.Error(t, err, "The RayJob is invalid because it depends on itself.")
 
-	fakeClient := clientFake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
-		Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
-			return errors.New("random")
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			ClusterPoolSelector:         &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "shared"}},
+			MaxConcurrentJobsPerCluster: ptr.To[int32](2),
 		},
-	}).WithScheme(scheme.Scheme).Build()
+	})
+	assert.NoError(t, err, "The RayJob is valid.")
 
-	recorder := record.NewFakeRecorder(100)
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			ClusterPoolSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "shared"}},
+			RayClusterSpec:      &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because clusterPoolSelector and rayClusterSpec are both set.")
 
-	reconciler := &RayJobReconciler{
-		Client:   fakeClient,
-		Recorder: recorder,
-		Scheme:   scheme.Scheme,
-	}
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			ClusterPoolSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "shared"}},
+			Suspend:             true,
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because the ClusterPoolSelector mode doesn't support the suspend operation.")
 
-	_, err := reconciler.getOrCreateRayClusterInstance(context.Background(), rayJob)
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			MaxConcurrentJobsPerCluster: ptr.To[int32](2),
+			RayClusterSpec:              &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because maxConcurrentJobsPerCluster is set without clusterPoolSelector.")
 
-	assert.NotNil(t, err, "Expected error due to cluster creation failure")
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayClusterPoolName: "test-rayclusterpool",
+			DeletionPolicy:     &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteClusterDeletionPolicy, OnFailure: rayv1.DeleteNoneDeletionPolicy},
+		},
+	})
+	assert.NoError(t, err, "The RayJob is valid because the RayClusterPool mode supports DeleteCluster.")
 
-	var foundFailureEvent bool
-	events := []string{}
-	for len(recorder.Events) > 0 {
-		event := <-recorder.Events
-		if strings.Contains(event, "Failed to create RayCluster") {
-			foundFailureEvent = true
-			break
-		}
-		events = append(events, event)
-	}
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayClusterPoolName: "test-rayclusterpool",
+			RayClusterSpec:     &rayv1.RayClusterSpec{},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because rayClusterPoolName and rayClusterSpec are both set.")
 
-	assert.Truef(t, foundFailureEvent, "Expected event to be generated for cluster creation failure, got events: %s", strings.Join(events, "\n"))
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayClusterPoolName: "test-rayclusterpool",
+			ClusterSelector:    map[string]string{"key": "value"},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because rayClusterPoolName and clusterSelector are both set.")
+
+	err = validateRayJobSpec(&rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			RayClusterPoolName: "test-rayclusterpool",
+			DeletionPolicy:     &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteWorkersDeletionPolicy, OnFailure: rayv1.DeleteNoneDeletionPolicy},
+		},
+	})
+	assert.Error(t, err, "The RayJob is invalid because the RayClusterPool mode doesn't support DeleteWorkers.")
 }
 
-func TestFailedDeleteRayJobSubmitterEvent(t *testing.T) {
+func TestCheckDependenciesAndUpdateStatusIfNeeded(t *testing.T) {
 	newScheme := runtime.NewScheme()
-	_ = batchv1.AddToScheme(newScheme)
+	_ = rayv1.AddToScheme(newScheme)
 
-	rayJob := &rayv1.RayJob{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-rayjob",
-			Namespace: "default",
-		},
-	}
-	submitter := &batchv1.Job{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      "test-rayjob",
-			Namespace: "default",
-		},
+	newDependencyRayJob := func(name string, deploymentStatus rayv1.JobDeploymentStatus, jobStatus rayv1.JobStatus) *rayv1.RayJob {
+		return &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Status: rayv1.RayJobStatus{
+				JobDeploymentStatus: deploymentStatus,
+				JobStatus:           jobStatus,
+			},
+		}
 	}
+	succeeded := newDependencyRayJob("succeeded", rayv1.JobDeploymentStatusComplete, rayv1.JobStatusSucceeded)
+	failed := newDependencyRayJob("failed", rayv1.JobDeploymentStatusComplete, rayv1.JobStatusFailed)
+	running := newDependencyRayJob("running", rayv1.JobDeploymentStatusRunning, rayv1.JobStatusRunning)
 
-	fakeClient := clientFake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
-		Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
-			return errors.New("random")
+	tests := map[string]struct {
+		expectedStatus           rayv1.JobDeploymentStatus
+		expectedFailedDependency string
+		dependsOn                []rayv1.RayJobDependency
+		expectedIsBlocked        bool
+		expectedNotFoundEvent    bool
+	}{
+		"All dependencies satisfy their conditions": {
+			dependsOn: []rayv1.RayJobDependency{
+				{Name: succeeded.Name},
+				{Name: failed.Name, Condition: rayv1.RayJobDependencyFailed},
+				{Name: failed.Name, Condition: rayv1.RayJobDependencyAny},
+			},
+			expectedStatus:    rayv1.JobDeploymentStatusBlocked,
+			expectedIsBlocked: false,
 		},
-	}).WithScheme(newScheme).WithRuntimeObjects(submitter).Build()
-
-	recorder := record.NewFakeRecorder(100)
+		"A dependency is still running": {
+			dependsOn:         []rayv1.RayJobDependency{{Name: succeeded.Name}, {Name: running.Name}},
+			expectedStatus:    rayv1.JobDeploymentStatusBlocked,
+			expectedIsBlocked: true,
+		},
+		"A dependency is not found": {
+			dependsOn:             []rayv1.RayJobDependency{{Name: "not-found"}},
+			expectedStatus:        rayv1.JobDeploymentStatusBlocked,
+			expectedIsBlocked:     true,
+			expectedNotFoundEvent: true,
+		},
+		"A dependency failed": {
+			dependsOn:                []rayv1.RayJobDependency{{Name: running.Name}, {Name: failed.Name, Condition: rayv1.RayJobDependencyComplete}},
+			expectedStatus:           rayv1.JobDeploymentStatusFailed,
+			expectedIsBlocked:        false,
+			expectedFailedDependency: failed.Name,
+		},
+		"A dependency succeeded, but the condition requires it to fail": {
+			dependsOn:                []rayv1.RayJobDependency{{Name: succeeded.Name, Condition: rayv1.RayJobDependencyFailed}},
+			expectedStatus:           rayv1.JobDeploymentStatusFailed,
+			expectedIsBlocked:        false,
+			expectedFailedDependency: succeeded.Name,
+		},
+	}
 
-	reconciler := &RayJobReconciler{
-		Client:   fakeClient,
-		Recorder: recorder,
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayJob := &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-rayjob",
+					Namespace: "default",
+				},
+				Spec: rayv1.RayJobSpec{
+					DependsOn: tc.dependsOn,
+				},
+				Status: rayv1.RayJobStatus{
+					JobDeploymentStatus: rayv1.JobDeploymentStatusBlocked,
+				},
+			}
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(succeeded, failed, running).Build()
+			recorder := record.NewFakeRecorder(100)
+			reconciler := &RayJobReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+
+			isBlocked, err := reconciler.checkDependenciesAndUpdateStatusIfNeeded(context.Background(), rayJob)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedIsBlocked, isBlocked)
+			assert.Equal(t, tc.expectedStatus, rayJob.Status.JobDeploymentStatus)
+			if tc.expectedNotFoundEvent {
+				assert.Len(t, recorder.Events, 1)
+				assert.Contains(t, <-recorder.Events, string(utils.RayJobDependencyNotFound))
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+			if tc.expectedFailedDependency != "" {
+				assert.Equal(t, rayv1.DependencyFailed, rayJob.Status.Reason)
+				assert.Contains(t, rayJob.Status.Message, "The RayJob "+tc.expectedFailedDependency+" ")
+			} else {
+				assert.Empty(t, rayJob.Status.Reason)
+			}
+		})
+	}
+}
+
+func TestReconcile_DependsOn(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	preprocessing := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "preprocessing",
+			Namespace: "default",
+		},
+		Status: rayv1.RayJobStatus{
+			JobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+			JobStatus:           rayv1.JobStatusRunning,
+		},
+	}
+	training := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "training",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayJobSpec{
+			DependsOn:      []rayv1.RayJobDependency{{Name: preprocessing.Name}},
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(preprocessing, training).WithStatusSubresource(preprocessing, training).Build()
+	ctx := context.Background()
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: record.NewFakeRecorder(100),
+		Scheme:   newScheme,
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: training.Namespace, Name: training.Name}}
+
+	// The RayJob is blocked until the RayJob in dependsOn finishes.
+	for i := 0; i < 2; i++ {
+		_, err := reconciler.Reconcile(ctx, request)
+		assert.NoError(t, err)
+		err = fakeClient.Get(ctx, request.NamespacedName, training)
+		assert.NoError(t, err)
+		assert.Equal(t, rayv1.JobDeploymentStatusBlocked, training.Status.JobDeploymentStatus)
+		assert.Empty(t, training.Status.RayClusterName)
+	}
+
+	// The RayJob in dependsOn succeeds, so the RayJob starts.
+	preprocessing.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+	preprocessing.Status.JobStatus = rayv1.JobStatusSucceeded
+	err := fakeClient.Status().Update(ctx, preprocessing)
+	assert.NoError(t, err)
+	_, err = reconciler.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	err = fakeClient.Get(ctx, request.NamespacedName, training)
+	assert.NoError(t, err)
+	assert.Equal(t, rayv1.JobDeploymentStatusInitializing, training.Status.JobDeploymentStatus)
+	assert.NotEmpty(t, training.Status.JobId)
+	assert.NotEmpty(t, training.Status.RayClusterName)
+	assert.NotNil(t, training.Status.StartTime)
+}
+
+func TestReconcile_DependsOnNotFound(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	// The RayJob in dependsOn is never created, so the RayJob fails once activeDeadlineSeconds has passed since its creation.
+	training := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "training",
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+		Spec: rayv1.RayJobSpec{
+			DependsOn:             []rayv1.RayJobDependency{{Name: "preprocessing"}},
+			RayClusterSpec:        &rayv1.RayClusterSpec{},
+			ActiveDeadlineSeconds: ptr.To[int32](60),
+		},
+		Status: rayv1.RayJobStatus{
+			JobDeploymentStatus: rayv1.JobDeploymentStatusBlocked,
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(training).WithStatusSubresource(training).Build()
+	ctx := context.Background()
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: record.NewFakeRecorder(100),
+		Scheme:   newScheme,
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: training.Namespace, Name: training.Name}}
+
+	_, err := reconciler.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	err = fakeClient.Get(ctx, request.NamespacedName, training)
+	assert.NoError(t, err)
+	assert.Equal(t, rayv1.JobDeploymentStatusFailed, training.Status.JobDeploymentStatus)
+	assert.Equal(t, rayv1.DeadlineExceeded, training.Status.Reason)
+	assert.Empty(t, training.Status.RayClusterName)
+}
+
+func TestGetDeletionPolicy(t *testing.T) {
+	deletionPolicy := &rayv1.DeletionPolicy{OnSuccess: rayv1.DeleteWorkersDeletionPolicy, OnFailure: rayv1.DeleteNoneDeletionPolicy}
+	tests := map[string]struct {
+		deletionPolicy           *rayv1.DeletionPolicy
+		clusterSelector          map[string]string
+		deploymentStatus         rayv1.JobDeploymentStatus
+		jobStatus                rayv1.JobStatus
+		expected                 rayv1.DeletionPolicyType
+		rayClusterPoolName       string
+		shutdownAfterJobFinishes bool
+		deleteRayJobCR           bool
+	}{
+		"The Ray job succeeded": {
+			deletionPolicy:   deletionPolicy,
+			deploymentStatus: rayv1.JobDeploymentStatusComplete,
+			jobStatus:        rayv1.JobStatusSucceeded,
+			expected:         rayv1.DeleteWorkersDeletionPolicy,
+		},
+		"The Ray job failed": {
+			deletionPolicy:   deletionPolicy,
+			deploymentStatus: rayv1.JobDeploymentStatusComplete,
+			jobStatus:        rayv1.JobStatusFailed,
+			expected:         rayv1.DeleteNoneDeletionPolicy,
+		},
+		"The RayJob failed": {
+			deletionPolicy:   deletionPolicy,
+			deploymentStatus: rayv1.JobDeploymentStatusFailed,
+			jobStatus:        rayv1.JobStatusRunning,
+			expected:         rayv1.DeleteNoneDeletionPolicy,
+		},
+		"ShutdownAfterJobFinishes is false": {
+			deploymentStatus: rayv1.JobDeploymentStatusComplete,
+			jobStatus:        rayv1.JobStatusSucceeded,
+			expected:         rayv1.DeleteNoneDeletionPolicy,
+		},
+		"ShutdownAfterJobFinishes is true": {
+			shutdownAfterJobFinishes: true,
+			deploymentStatus:         rayv1.JobDeploymentStatusComplete,
+			jobStatus:                rayv1.JobStatusSucceeded,
+			expected:                 rayv1.DeleteClusterDeletionPolicy,
+		},
+		"ShutdownAfterJobFinishes is true and DELETE_RAYJOB_CR_AFTER_JOB_FINISHES is true": {
+			shutdownAfterJobFinishes: true,
+			deleteRayJobCR:           true,
+			deploymentStatus:         rayv1.JobDeploymentStatusFailed,
+			jobStatus:                rayv1.JobStatusFailed,
+			expected:                 rayv1.DeleteSelfDeletionPolicy,
+		},
+		"ShutdownAfterJobFinishes is true in the ClusterSelector mode": {
+			shutdownAfterJobFinishes: true,
+			clusterSelector:          map[string]string{"key": "value"},
+			deploymentStatus:         rayv1.JobDeploymentStatusComplete,
+			jobStatus:                rayv1.JobStatusSucceeded,
+			expected:                 rayv1.DeleteNoneDeletionPolicy,
+		},
+		"ShutdownAfterJobFinishes is false in the RayClusterPool mode": {
+			rayClusterPoolName: "test-rayclusterpool",
+			deploymentStatus:   rayv1.JobDeploymentStatusComplete,
+			jobStatus:          rayv1.JobStatusSucceeded,
+			expected:           rayv1.DeleteClusterDeletionPolicy,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.deleteRayJobCR {
+				t.Setenv(utils.DELETE_RAYJOB_CR_AFTER_JOB_FINISHES, "true")
+			}
+			rayJob := &rayv1.RayJob{
+				Spec: rayv1.RayJobSpec{
+					DeletionPolicy:           tc.deletionPolicy,
+					ClusterSelector:          tc.clusterSelector,
+					RayClusterPoolName:       tc.rayClusterPoolName,
+					ShutdownAfterJobFinishes: tc.shutdownAfterJobFinishes,
+				},
+				Status: rayv1.RayJobStatus{
+					JobDeploymentStatus: tc.deploymentStatus,
+					JobStatus:           tc.jobStatus,
+				},
+			}
+			assert.Equal(t, tc.expected, getDeletionPolicy(rayJob))
+		})
+	}
+}
+
+func TestDeleteWorkerGroups(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-raycluster",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayClusterSpec{
+			EnableInTreeAutoscaling: ptr.To(true),
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "small-group",
+					Replicas:    ptr.To[int32](2),
+					MinReplicas: ptr.To[int32](1),
+					MaxReplicas: ptr.To[int32](5),
+				},
+			},
+		},
+	}
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+		Status: rayv1.RayJobStatus{
+			RayClusterName: rayCluster.Name,
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayCluster).Build()
+	recorder := record.NewFakeRecorder(100)
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
+		Scheme:   newScheme,
+	}
+	ctx := context.Background()
+
+	err := reconciler.deleteWorkerGroups(ctx, rayJob)
+	assert.NoError(t, err)
+	cluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: rayCluster.Namespace, Name: rayCluster.Name}, cluster)
+	assert.NoError(t, err)
+	workerGroupSpec := cluster.Spec.WorkerGroupSpecs[0]
+	assert.Equal(t, int32(0), *workerGroupSpec.Replicas)
+	assert.Equal(t, int32(0), *workerGroupSpec.MinReplicas)
+	assert.Equal(t, int32(0), *workerGroupSpec.MaxReplicas)
+	assert.Len(t, recorder.Events, 1)
+
+	// The worker groups have already been scaled to zero, so the RayCluster isn't updated again.
+	err = reconciler.deleteWorkerGroups(ctx, rayJob)
+	assert.NoError(t, err)
+	assert.Len(t, recorder.Events, 1)
+
+	// The RayCluster has been deleted.
+	err = fakeClient.Delete(ctx, cluster)
+	assert.NoError(t, err)
+	err = reconciler.deleteWorkerGroups(ctx, rayJob)
+	assert.NoError(t, err)
+}
+
+func TestRetainRayJobLogs(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = batchv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-raycluster",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayClusterSpec{
+			HeadGroupSpec: rayv1.HeadGroupSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Image: "rayproject/ray"}},
+					},
+				},
+			},
+		},
+	}
+	newRayJob := func(logRetention *rayv1.LogRetention) *rayv1.RayJob {
+		return &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rayjob",
+				Namespace: "default",
+			},
+			Spec: rayv1.RayJobSpec{
+				LogRetention: logRetention,
+			},
+			Status: rayv1.RayJobStatus{
+				JobId:          "test-job-id",
+				RayClusterName: rayCluster.Name,
+				DashboardURL:   "test-raycluster-head-svc.default.svc.cluster.local:8265",
+			},
+		}
+	}
+	ctx := context.Background()
+
+	// The tail of the logs is stored in a ConfigMap.
+	rayJob := newRayJob(&rayv1.LogRetention{ConfigMap: &rayv1.ConfigMapLogRetention{TailBytes: ptr.To[int32](2)}})
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayCluster, rayJob).Build()
+	reconciler := &RayJobReconciler{Client: fakeClient, Recorder: record.NewFakeRecorder(100), Scheme: newScheme}
+	err := reconciler.retainRayJobLogs(ctx, rayJob, rayCluster, &utils.FakeRayDashboardClient{})
+	assert.NoError(t, err)
+	configMap := &corev1.ConfigMap{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJobLogsName(rayJob)}, configMap)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{RayJobLogsFileName: "og"}, configMap.Data)
+	assert.True(t, metav1.IsControlledBy(configMap, rayJob))
+	assert.Equal(t, &rayv1.RayJobLogLocation{ConfigMapName: configMap.Name, Path: RayJobLogsFileName}, rayJob.Status.LogLocation)
+	isFinished, err := reconciler.isRayJobLogRetentionFinished(ctx, rayJob)
+	assert.NoError(t, err)
+	assert.True(t, isFinished)
+
+	// The full logs are written to a PersistentVolumeClaim by a Kubernetes Job.
+	rayJob = newRayJob(&rayv1.LogRetention{PersistentVolumeClaim: &rayv1.PersistentVolumeClaimLogRetention{ClaimName: "test-pvc", SubPath: "logs"}})
+	fakeClient = clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayCluster, rayJob).Build()
+	reconciler = &RayJobReconciler{Client: fakeClient, Recorder: record.NewFakeRecorder(100), Scheme: newScheme}
+	err = reconciler.retainRayJobLogs(ctx, rayJob, rayCluster, &utils.FakeRayDashboardClient{})
+	assert.NoError(t, err)
+	job := &batchv1.Job{}
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJobLogsName(rayJob)}, job)
+	assert.NoError(t, err)
+	podSpec := job.Spec.Template.Spec
+	assert.Equal(t, "test-pvc", podSpec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, "logs", podSpec.Containers[0].VolumeMounts[0].SubPath)
+	assert.Contains(t, podSpec.Containers[0].Args[0], "ray job logs --address http://test-raycluster-head-svc.default.svc.cluster.local:8265 'test-job-id'")
+	assert.Equal(t, &rayv1.RayJobLogLocation{PersistentVolumeClaimName: "test-pvc", Path: "logs/" + job.Name + ".log"}, rayJob.Status.LogLocation)
+
+	// The RayCluster can't be deleted until the Kubernetes Job has finished.
+	isFinished, err = reconciler.isRayJobLogRetentionFinished(ctx, rayJob)
+	assert.NoError(t, err)
+	assert.False(t, isFinished)
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	err = fakeClient.Status().Update(ctx, job)
+	assert.NoError(t, err)
+	isFinished, err = reconciler.isRayJobLogRetentionFinished(ctx, rayJob)
+	assert.NoError(t, err)
+	assert.True(t, isFinished)
+}
+
+func TestReconcile_LogRetentionIsBestEffort(t *testing.T) {
+	// ConfigMaps are not registered in the scheme, so retaining the driver logs in a ConfigMap fails.
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-raycluster",
+			Namespace: "default",
+		},
+	}
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayJobSpec{
+			SubmissionMode: rayv1.HTTPMode,
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+			LogRetention:   &rayv1.LogRetention{ConfigMap: &rayv1.ConfigMapLogRetention{}},
+		},
+		Status: rayv1.RayJobStatus{
+			JobId:               "test-job-id",
+			RayClusterName:      rayCluster.Name,
+			DashboardURL:        "test-raycluster-head-svc.default.svc.cluster.local:8265",
+			JobStatus:           rayv1.JobStatusRunning,
+			JobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayCluster, rayJob).WithStatusSubresource(rayJob).Build()
+	fakeDashboardClient := &utils.FakeRayDashboardClient{}
+	getJobInfo := func(context.Context, string) (*utils.RayJobInfo, error) {
+		return &utils.RayJobInfo{JobStatus: rayv1.JobStatusSucceeded}, nil
+	}
+	fakeDashboardClient.GetJobInfoMock.Store(&getJobInfo)
+	recorder := record.NewFakeRecorder(100)
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
+		Scheme:   newScheme,
+		dashboardClientFunc: func() utils.RayDashboardClientInterface {
+			return fakeDashboardClient
+		},
+	}
+	ctx := context.Background()
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJob.Name}}
+
+	_, err := reconciler.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	updatedRayJob := &rayv1.RayJob{}
+	err = fakeClient.Get(ctx, request.NamespacedName, updatedRayJob)
+	assert.NoError(t, err)
+	assert.Equal(t, rayv1.JobDeploymentStatusComplete, updatedRayJob.Status.JobDeploymentStatus)
+	assert.Equal(t, rayv1.JobStatusSucceeded, updatedRayJob.Status.JobStatus)
+	assert.Nil(t, updatedRayJob.Status.LogLocation)
+
+	foundEvent := false
+	for len(recorder.Events) > 0 {
+		if strings.Contains(<-recorder.Events, string(utils.FailedToRetainRayJobLogs)) {
+			foundEvent = true
+		}
+	}
+	assert.True(t, foundEvent, "The failure to retain the driver logs should be reported")
+}
+
+func TestTailOfLogs(t *testing.T) {
+	assert.Equal(t, "hello", tailOfLogs("hello", 10))
+	assert.Equal(t, "llo", tailOfLogs("hello", 3))
+	// A multi-byte character is never split.
+	assert.Equal(t, "b", tailOfLogs("a\u00e9b", 2))
+}
+
+func TestRecordAttemptIfNeeded(t *testing.T) {
+	startTime := metav1.NewTime(time.Now().Add(-time.Minute))
+	oldRayJob := &rayv1.RayJob{
+		Spec: rayv1.RayJobSpec{
+			SubmissionMode: rayv1.HTTPMode,
+		},
+		Status: rayv1.RayJobStatus{
+			JobId:               "test-job-id",
+			RayClusterName:      "test-raycluster",
+			JobStatus:           rayv1.JobStatusRunning,
+			JobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+			StartTime:           &startTime,
+		},
+	}
+
+	// The attempt is still running.
+	rayJob := oldRayJob.DeepCopy()
+	recordAttemptIfNeeded(oldRayJob, rayJob)
+	assert.Empty(t, rayJob.Status.Attempts)
+
+	// The attempt has failed.
+	rayJob.Status.JobStatus = rayv1.JobStatusFailed
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+	rayJob.Status.Reason = rayv1.AppFailed
+	rayJob.Status.Message = "the Ray job failed"
+	recordAttemptIfNeeded(oldRayJob, rayJob)
+	assert.Len(t, rayJob.Status.Attempts, 1)
+	attempt := rayJob.Status.Attempts[0]
+	assert.Equal(t, "test-job-id", attempt.JobId)
+	assert.Equal(t, "test-raycluster", attempt.RayClusterName)
+	assert.Equal(t, rayv1.HTTPMode, attempt.SubmissionMode)
+	assert.Equal(t, rayv1.JobStatusFailed, attempt.JobStatus)
+	assert.Equal(t, rayv1.AppFailed, attempt.Reason)
+	assert.Equal(t, "the Ray job failed", attempt.Message)
+	assert.Equal(t, &startTime, attempt.StartTime)
+	assert.NotNil(t, attempt.EndTime)
+
+	// The attempt is only recorded when the status transitions.
+	recordAttemptIfNeeded(rayJob.DeepCopy(), rayJob)
+	assert.Len(t, rayJob.Status.Attempts, 1)
+
+	// A suspended attempt is recorded as well.
+	rayJob = oldRayJob.DeepCopy()
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusSuspending
+	recordAttemptIfNeeded(oldRayJob, rayJob)
+	assert.Len(t, rayJob.Status.Attempts, 1)
+	assert.Equal(t, rayv1.JobStatusRunning, rayJob.Status.Attempts[0].JobStatus)
+
+	// Only the most recent attempts are kept.
+	for i := 0; i < RayJobMaxAttempts; i++ {
+		oldRayJob.Status.Attempts = append(oldRayJob.Status.Attempts, rayv1.RayJobAttempt{JobId: fmt.Sprintf("old-job-id-%d", i)})
+	}
+	rayJob = oldRayJob.DeepCopy()
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+	recordAttemptIfNeeded(oldRayJob, rayJob)
+	assert.Len(t, rayJob.Status.Attempts, RayJobMaxAttempts)
+	assert.Equal(t, "old-job-id-1", rayJob.Status.Attempts[0].JobId)
+	assert.Equal(t, "test-job-id", rayJob.Status.Attempts[RayJobMaxAttempts-1].JobId)
+}
+
+func TestReconcile_SameClusterRetry(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = batchv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-raycluster",
+			Namespace: "default",
+		},
+	}
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayJobSpec{
+			BackoffLimit:   ptr.To[int32](2),
+			RetryPolicy:    ptr.To(rayv1.SameClusterRetry),
+			SubmissionMode: rayv1.K8sJobMode,
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+		Status: rayv1.RayJobStatus{
+			Attempts:            []rayv1.RayJobAttempt{{JobId: "test-job-id", Message: "the Ray job failed"}},
+			JobId:               "test-job-id",
+			RayClusterName:      rayCluster.Name,
+			DashboardURL:        "test-raycluster-head-svc.default.svc.cluster.local:8265",
+			JobStatus:           rayv1.JobStatusFailed,
+			JobDeploymentStatus: rayv1.JobDeploymentStatusRetrying,
+			Reason:              rayv1.AppFailed,
+			Message:             "the Ray job failed",
+			Failed:              ptr.To[int32](1),
+		},
+	}
+	submitter := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rayJob.Name,
+			Namespace: rayJob.Namespace,
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayCluster, rayJob, submitter).WithStatusSubresource(rayJob).Build()
+	ctx := context.Background()
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: record.NewFakeRecorder(100),
+		Scheme:   newScheme,
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJob.Name}}
+
+	// The first reconciliation deletes the submitter Kubernetes Job and waits for the deletion to finish.
+	_, err := reconciler.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	err = fakeClient.Get(ctx, common.RayJobK8sJobNamespacedName(rayJob), &batchv1.Job{})
+	assert.True(t, k8serrors.IsNotFound(err), "The submitter Kubernetes Job should be deleted")
+	updatedRayJob := &rayv1.RayJob{}
+	err = fakeClient.Get(ctx, request.NamespacedName, updatedRayJob)
+	assert.NoError(t, err)
+	assert.Equal(t, rayv1.JobDeploymentStatusRetrying, updatedRayJob.Status.JobDeploymentStatus)
+
+	// The second reconciliation resubmits the Ray job to the same RayCluster with a new submission ID.
+	_, err = reconciler.Reconcile(ctx, request)
+	assert.NoError(t, err)
+	err = fakeClient.Get(ctx, request.NamespacedName, updatedRayJob)
+	assert.NoError(t, err)
+	assert.Equal(t, rayv1.JobDeploymentStatusNew, updatedRayJob.Status.JobDeploymentStatus)
+	assert.Equal(t, rayv1.JobStatusNew, updatedRayJob.Status.JobStatus)
+	assert.NotEmpty(t, updatedRayJob.Status.JobId)
+	assert.NotEqual(t, "test-job-id", updatedRayJob.Status.JobId)
+	assert.Equal(t, rayCluster.Name, updatedRayJob.Status.RayClusterName)
+	assert.Equal(t, rayJob.Status.DashboardURL, updatedRayJob.Status.DashboardURL)
+	assert.Empty(t, updatedRayJob.Status.Message)
+	assert.Len(t, updatedRayJob.Status.Attempts, 1)
+
+	err = fakeClient.Get(ctx, common.RayJobRayClusterNamespacedName(rayJob), &rayv1.RayCluster{})
+	assert.NoError(t, err, "The RayCluster should not be deleted")
+}
+
+func TestReconcile_GracefulStopBeforeSuspension(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = batchv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	newRayJob := func(suspendStartTime *metav1.Time) *rayv1.RayJob {
+		return &rayv1.RayJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rayjob",
+				Namespace: "default",
+			},
+			Spec: rayv1.RayJobSpec{
+				Suspend:                   true,
+				ShutdownAfterJobFinishes:  true,
+				SuspendGracePeriodSeconds: ptr.To[int32](60),
+				SubmissionMode:            rayv1.K8sJobMode,
+				RayClusterSpec:            &rayv1.RayClusterSpec{},
+			},
+			Status: rayv1.RayJobStatus{
+				JobId:               "test-job-id",
+				RayClusterName:      "test-raycluster",
+				DashboardURL:        "test-raycluster-head-svc.default.svc.cluster.local:8265",
+				JobStatus:           rayv1.JobStatusRunning,
+				JobDeploymentStatus: rayv1.JobDeploymentStatusSuspending,
+				SuspendStartTime:    suspendStartTime,
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		suspendStartTime     *metav1.Time
+		jobStatus            rayv1.JobStatus
+		expectClusterDeleted bool
+	}{
+		"Wait for the Ray job to stop": {
+			suspendStartTime:     &metav1.Time{Time: time.Now().Add(-10 * time.Second)},
+			jobStatus:            rayv1.JobStatusRunning,
+			expectClusterDeleted: false,
+		},
+		"Delete the RayCluster once the Ray job has stopped": {
+			suspendStartTime:     &metav1.Time{Time: time.Now().Add(-10 * time.Second)},
+			jobStatus:            rayv1.JobStatusStopped,
+			expectClusterDeleted: true,
+		},
+		"Delete the RayCluster once the grace period has expired": {
+			suspendStartTime:     &metav1.Time{Time: time.Now().Add(-2 * time.Minute)},
+			jobStatus:            rayv1.JobStatusRunning,
+			expectClusterDeleted: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayCluster := &rayv1.RayCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-raycluster",
+					Namespace: "default",
+				},
+			}
+			rayJob := newRayJob(tc.suspendStartTime)
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayCluster, rayJob).WithStatusSubresource(rayJob).Build()
+			fakeDashboardClient := &utils.FakeRayDashboardClient{}
+			getJobInfo := func(context.Context, string) (*utils.RayJobInfo, error) {
+				return &utils.RayJobInfo{JobStatus: tc.jobStatus}, nil
+			}
+			fakeDashboardClient.GetJobInfoMock.Store(&getJobInfo)
+			reconciler := &RayJobReconciler{
+				Client:   fakeClient,
+				Recorder: record.NewFakeRecorder(100),
+				Scheme:   newScheme,
+				dashboardClientFunc: func() utils.RayDashboardClientInterface {
+					return fakeDashboardClient
+				},
+			}
+			ctx := context.Background()
+			request := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJob.Name}}
+
+			_, err := reconciler.Reconcile(ctx, request)
+			assert.NoError(t, err)
+
+			err = fakeClient.Get(ctx, common.RayJobRayClusterNamespacedName(rayJob), &rayv1.RayCluster{})
+			assert.Equal(t, tc.expectClusterDeleted, k8serrors.IsNotFound(err))
+			updatedRayJob := &rayv1.RayJob{}
+			err = fakeClient.Get(ctx, request.NamespacedName, updatedRayJob)
+			assert.NoError(t, err)
+			assert.Equal(t, rayv1.JobDeploymentStatusSuspending, updatedRayJob.Status.JobDeploymentStatus)
+		})
+	}
+}
+
+func TestFailedToCreateRayJobSubmitterEvent(t *testing.T) {
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+	}
+
+	submitterTemplate := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-submit-pod",
+			Namespace: "default",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  "ray-submit",
+					Image: "rayproject/ray:latest",
+				},
+			},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+			return errors.New("random")
+		},
+	}).WithScheme(scheme.Scheme).Build()
+
+	recorder := record.NewFakeRecorder(100)
+
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
+		Scheme:   scheme.Scheme,
+	}
+
+	err := reconciler.createNewK8sJob(context.Background(), rayJob, submitterTemplate)
+
+	assert.NotNil(t, err, "Expected error due to simulated job creation failure")
+
+	var foundFailureEvent bool
+	events := []string{}
+	for len(recorder.Events) > 0 {
+		event := <-recorder.Events
+		if strings.Contains(event, "Failed to create new Kubernetes Job") {
+			foundFailureEvent = true
+			break
+		}
+		events = append(events, event)
+	}
+
+	assert.Truef(t, foundFailureEvent, "Expected event to be generated for job creation failure, got events: %s", strings.Join(events, "\n"))
+}
+
+func TestFailedCreateRayClusterEvent(t *testing.T) {
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayJobSpec{
+			RayClusterSpec: &rayv1.RayClusterSpec{},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.CreateOption) error {
+			return errors.New("random")
+		},
+	}).WithScheme(scheme.Scheme).Build()
+
+	recorder := record.NewFakeRecorder(100)
+
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
+		Scheme:   scheme.Scheme,
+	}
+
+	_, err := reconciler.getOrCreateRayClusterInstance(context.Background(), rayJob)
+
+	assert.NotNil(t, err, "Expected error due to cluster creation failure")
+
+	var foundFailureEvent bool
+	events := []string{}
+	for len(recorder.Events) > 0 {
+		event := <-recorder.Events
+		if strings.Contains(event, "Failed to create RayCluster") {
+			foundFailureEvent = true
+			break
+		}
+		events = append(events, event)
+	}
+
+	assert.Truef(t, foundFailureEvent, "Expected event to be generated for cluster creation failure, got events: %s", strings.Join(events, "\n"))
+}
+
+func TestFailedDeleteRayJobSubmitterEvent(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = batchv1.AddToScheme(newScheme)
+
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+	}
+	submitter := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob",
+			Namespace: "default",
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Delete: func(_ context.Context, _ client.WithWatch, _ client.Object, _ ...client.DeleteOption) error {
+			return errors.New("random")
+		},
+	}).WithScheme(newScheme).WithRuntimeObjects(submitter).Build()
+
+	recorder := record.NewFakeRecorder(100)
+
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
 		Scheme:   scheme.Scheme,
 	}
 
@@ -575,3 +1647,640 @@ func TestFailedDeleteRayClusterEvent(t *testing.T) {
 
 	assert.Truef(t, foundFailureEvent, "Expected event to be generated for cluster deletion failure, got events: %s", strings.Join(events, "\n"))
 }
+
+// poolFakeDashboardClient lists the Ray jobs of the RayCluster that it is initialized with.
+type poolFakeDashboardClient struct {
+	jobs           map[string][]utils.RayJobInfo
+	rayClusterName string
+	utils.FakeRayDashboardClient
+}
+
+func (r *poolFakeDashboardClient) InitClient(_ context.Context, _ string, rayCluster *rayv1.RayCluster) error {
+	r.rayClusterName = rayCluster.Name
+	return nil
+}
+
+func (r *poolFakeDashboardClient) ListJobs(_ context.Context) (*[]utils.RayJobInfo, error) {
+	jobs := r.jobs[r.rayClusterName]
+	return &jobs, nil
+}
+
+func TestSelectRayClusterFromPool(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	running := utils.RayJobInfo{JobStatus: rayv1.JobStatusRunning}
+	succeeded := utils.RayJobInfo{JobStatus: rayv1.JobStatusSucceeded}
+	submitted := utils.RayJobInfo{JobStatus: rayv1.JobStatusRunning, SubmissionId: "submitted-job-id"}
+	jobs := map[string][]utils.RayJobInfo{
+		"busy-raycluster":      {running, running, succeeded},
+		"idle-raycluster":      {submitted, succeeded, succeeded},
+		"not-ready-raycluster": {},
+		"other-raycluster":     {},
+	}
+
+	tests := map[string]struct {
+		maxConcurrentJobsPerCluster *int32
+		expectedRayClusterName      string
+		notReady                    []string
+		assignedRayJobs             []*rayv1.RayJob
+	}{
+		"The least-loaded RayCluster is selected": {
+			expectedRayClusterName: "idle-raycluster",
+		},
+		"RayJobs that haven't submitted their Ray jobs are counted": {
+			assignedRayJobs: []*rayv1.RayJob{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "initializing-rayjob-1", Namespace: "default", UID: "initializing-rayjob-1"},
+					Status: rayv1.RayJobStatus{
+						RayClusterName:      "idle-raycluster",
+						JobId:               "initializing-job-id-1",
+						JobDeploymentStatus: rayv1.JobDeploymentStatusInitializing,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "initializing-rayjob-2", Namespace: "default", UID: "initializing-rayjob-2"},
+					Status: rayv1.RayJobStatus{
+						RayClusterName:      "idle-raycluster",
+						JobId:               "initializing-job-id-2",
+						JobDeploymentStatus: rayv1.JobDeploymentStatusInitializing,
+					},
+				},
+			},
+			expectedRayClusterName: "busy-raycluster",
+		},
+		"RayJobs whose Ray jobs are known to the Ray dashboard or have finished aren't counted twice": {
+			assignedRayJobs: []*rayv1.RayJob{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "running-rayjob", Namespace: "default", UID: "running-rayjob"},
+					Status: rayv1.RayJobStatus{
+						RayClusterName:      "idle-raycluster",
+						JobId:               "submitted-job-id",
+						JobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "complete-rayjob", Namespace: "default", UID: "complete-rayjob"},
+					Status: rayv1.RayJobStatus{
+						RayClusterName:      "idle-raycluster",
+						JobId:               "complete-job-id",
+						JobDeploymentStatus: rayv1.JobDeploymentStatusComplete,
+					},
+				},
+			},
+			expectedRayClusterName: "idle-raycluster",
+		},
+		"RayClusters that aren't ready are skipped": {
+			notReady:               []string{"idle-raycluster"},
+			expectedRayClusterName: "busy-raycluster",
+		},
+		"RayClusters that have reached the limit are skipped": {
+			maxConcurrentJobsPerCluster: ptr.To[int32](2),
+			notReady:                    []string{"idle-raycluster"},
+			expectedRayClusterName:      "",
+		},
+		"A RayCluster under the limit is selected": {
+			maxConcurrentJobsPerCluster: ptr.To[int32](2),
+			expectedRayClusterName:      "idle-raycluster",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var objects []runtime.Object
+			for rayClusterName := range jobs {
+				labels := map[string]string{"pool": "shared"}
+				if rayClusterName == "other-raycluster" {
+					labels["pool"] = "other"
+				}
+				rayCluster := &rayv1.RayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: rayClusterName, Namespace: "default", Labels: labels},
+					Status:     rayv1.RayClusterStatus{State: rayv1.Ready},
+				}
+				if rayClusterName == "not-ready-raycluster" {
+					rayCluster.Status.State = ""
+				}
+				for _, notReady := range tc.notReady {
+					if rayClusterName == notReady {
+						rayCluster.Status.State = ""
+					}
+				}
+				headSvcName, err := utils.GenerateHeadServiceName(utils.RayClusterCRD, rayCluster.Spec, rayCluster.Name)
+				assert.NoError(t, err)
+				headSvc := &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: headSvcName, Namespace: "default"},
+					Spec: corev1.ServiceSpec{
+						Ports: []corev1.ServicePort{{Name: utils.DashboardPortName, Port: utils.DefaultDashboardPort}},
+					},
+				}
+				objects = append(objects, rayCluster, headSvc)
+			}
+			for _, assignedRayJob := range tc.assignedRayJobs {
+				objects = append(objects, assignedRayJob)
+			}
+			reconciler := &RayJobReconciler{
+				Client:   clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(objects...).Build(),
+				Recorder: record.NewFakeRecorder(100),
+				Scheme:   newScheme,
+				dashboardClientFunc: func() utils.RayDashboardClientInterface {
+					return &poolFakeDashboardClient{jobs: jobs}
+				},
+			}
+			rayJob := &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+				Spec: rayv1.RayJobSpec{
+					ClusterPoolSelector:         &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "shared"}},
+					MaxConcurrentJobsPerCluster: tc.maxConcurrentJobsPerCluster,
+				},
+			}
+
+			rayClusterName, err := reconciler.selectRayClusterFromPool(context.Background(), rayJob)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRayClusterName, rayClusterName)
+
+			// The selected RayCluster is recorded in the status when the RayJob is initialized. If no RayCluster in
+			// the pool can accept the Ray job, the RayJob stays in its current status.
+			err = reconciler.initRayJobStatusIfNeed(context.Background(), rayJob)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRayClusterName, rayJob.Status.RayClusterName)
+			if tc.expectedRayClusterName == "" {
+				assert.Equal(t, rayv1.JobDeploymentStatusNew, rayJob.Status.JobDeploymentStatus)
+			} else {
+				assert.Equal(t, rayv1.JobDeploymentStatusInitializing, rayJob.Status.JobDeploymentStatus)
+			}
+		})
+	}
+}
+
+func TestClaimRayClusterFromPool(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size: 2,
+			Template: rayv1.RayClusterSpec{
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	// newRayCluster returns a RayCluster of the pool created from the current template of the pool. If `claimedBy`
+	// isn't empty, the RayCluster is claimed by the RayJob with that name.
+	newRayCluster := func(name string, state rayv1.ClusterState, claimedBy string) *rayv1.RayCluster {
+		templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+		assert.NoError(t, err)
+		rayCluster := &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: rayClusterPool.Namespace,
+				Labels: map[string]string{
+					utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+					utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+				},
+				Annotations: map[string]string{
+					utils.RayClusterPoolTemplateHashAnnotationKey: templateHash,
+				},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rayClusterPool, rayv1.GroupVersion.WithKind("RayClusterPool"))},
+			},
+			Spec:   *rayClusterPool.Spec.Template.DeepCopy(),
+			Status: rayv1.RayClusterStatus{State: state},
+		}
+		if claimedBy != "" {
+			rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = claimedBy
+		}
+		return rayCluster
+	}
+	tests := map[string]struct {
+		expectedRayClusterName string
+		rayClusters            []*rayv1.RayCluster
+	}{
+		"A ready idle RayCluster is preferred": {
+			rayClusters: []*rayv1.RayCluster{
+				newRayCluster("not-ready", "", ""),
+				newRayCluster("ready", rayv1.Ready, ""),
+			},
+			expectedRayClusterName: "ready",
+		},
+		"The RayCluster already claimed by the RayJob is returned": {
+			rayClusters: []*rayv1.RayCluster{
+				newRayCluster("idle", rayv1.Ready, ""),
+				newRayCluster("claimed", "", "test-rayjob"),
+			},
+			expectedRayClusterName: "claimed",
+		},
+		"RayClusters claimed by other RayJobs are skipped": {
+			rayClusters: []*rayv1.RayCluster{
+				newRayCluster("claimed", rayv1.Ready, "other-rayjob"),
+			},
+			expectedRayClusterName: "",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			objects := []runtime.Object{rayClusterPool.DeepCopy()}
+			for _, rayCluster := range tc.rayClusters {
+				objects = append(objects, rayCluster.DeepCopy())
+			}
+			reconciler := &RayJobReconciler{
+				Client:   clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(objects...).Build(),
+				Recorder: record.NewFakeRecorder(100),
+				Scheme:   newScheme,
+			}
+			rayJob := &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+				Spec:       rayv1.RayJobSpec{RayClusterPoolName: rayClusterPool.Name},
+			}
+
+			rayClusterName, err := reconciler.claimRayClusterFromPool(context.Background(), rayJob)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRayClusterName, rayClusterName)
+			if rayClusterName == "" {
+				return
+			}
+			rayCluster := &rayv1.RayCluster{}
+			err = reconciler.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: rayClusterName}, rayCluster)
+			assert.NoError(t, err)
+			assert.Equal(t, rayJob.Name, rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey])
+		})
+	}
+}
+
+func TestReleaseRayClusterToPool(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	tests := map[string]struct {
+		releasePolicy       rayv1.RayClusterPoolReleasePolicy
+		isTemplateOutdated  bool
+		expectClusterExists bool
+	}{
+		"The RayCluster is deleted with the Delete policy": {
+			releasePolicy:       rayv1.DeleteRayClusterPoolReleasePolicy,
+			expectClusterExists: false,
+		},
+		"The RayCluster is returned to the pool with the Reuse policy": {
+			releasePolicy:       rayv1.ReuseRayClusterPoolReleasePolicy,
+			expectClusterExists: true,
+		},
+		"An outdated RayCluster is deleted with the Reuse policy": {
+			releasePolicy:       rayv1.ReuseRayClusterPoolReleasePolicy,
+			isTemplateOutdated:  true,
+			expectClusterExists: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayClusterPool := &rayv1.RayClusterPool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-rayclusterpool",
+					Namespace: "default",
+					UID:       "test-rayclusterpool-uid",
+				},
+				Spec: rayv1.RayClusterPoolSpec{
+					Size: 2,
+					Template: rayv1.RayClusterSpec{
+						HeadGroupSpec: rayv1.HeadGroupSpec{
+							Template: corev1.PodTemplateSpec{
+								Spec: corev1.PodSpec{
+									Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+								},
+							},
+						},
+					},
+				},
+			}
+			rayClusterPool.Spec.ReleasePolicy = tc.releasePolicy
+			// newRayCluster returns a RayCluster of the pool created from the current template of the pool. If `claimedBy`
+			// isn't empty, the RayCluster is claimed by the RayJob with that name.
+			newRayCluster := func(name string, state rayv1.ClusterState, claimedBy string) *rayv1.RayCluster {
+				templateHash, err := utils.GenerateJsonHash(rayClusterPool.Spec.Template)
+				assert.NoError(t, err)
+				rayCluster := &rayv1.RayCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: rayClusterPool.Namespace,
+						Labels: map[string]string{
+							utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+							utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+						},
+						Annotations: map[string]string{
+							utils.RayClusterPoolTemplateHashAnnotationKey: templateHash,
+						},
+						OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rayClusterPool, rayv1.GroupVersion.WithKind("RayClusterPool"))},
+					},
+					Spec:   *rayClusterPool.Spec.Template.DeepCopy(),
+					Status: rayv1.RayClusterStatus{State: state},
+				}
+				if claimedBy != "" {
+					rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey] = claimedBy
+				}
+				return rayCluster
+			}
+			claimedRayCluster := newRayCluster("claimed", rayv1.Ready, "test-rayjob")
+			otherRayCluster := newRayCluster("other", rayv1.Ready, "other-rayjob")
+			if tc.isTemplateOutdated {
+				rayClusterPool.Spec.Template.HeadGroupSpec.Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+			}
+			reconciler := &RayJobReconciler{
+				Client:   clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayClusterPool, claimedRayCluster, otherRayCluster).Build(),
+				Recorder: record.NewFakeRecorder(100),
+				Scheme:   newScheme,
+			}
+			rayJob := &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+				Spec:       rayv1.RayJobSpec{RayClusterPoolName: rayClusterPool.Name},
+				Status:     rayv1.RayJobStatus{RayClusterName: claimedRayCluster.Name, JobStatus: rayv1.JobStatusSucceeded},
+			}
+
+			isReleased, err := reconciler.deleteClusterResources(context.Background(), rayJob)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectClusterExists, isReleased)
+
+			rayCluster := &rayv1.RayCluster{}
+			err = reconciler.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: claimedRayCluster.Name}, rayCluster)
+			if tc.expectClusterExists {
+				assert.NoError(t, err)
+				assert.NotContains(t, rayCluster.Annotations, utils.RayClusterPoolClaimedByAnnotationKey)
+			} else {
+				assert.True(t, k8serrors.IsNotFound(err))
+				// The RayCluster can't be found in the next reconciliation, so the release is complete.
+				isReleased, err = reconciler.deleteClusterResources(context.Background(), rayJob)
+				assert.NoError(t, err)
+				assert.True(t, isReleased)
+			}
+
+			// The RayClusters claimed by other RayJobs aren't touched.
+			err = reconciler.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: otherRayCluster.Name}, rayCluster)
+			assert.NoError(t, err)
+			assert.Equal(t, "other-rayjob", rayCluster.Annotations[utils.RayClusterPoolClaimedByAnnotationKey])
+		})
+	}
+}
+
+func TestReconcile_ReleaseRayClusterToPoolAfterRayJobFinishes(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayClusterPool := &rayv1.RayClusterPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayclusterpool",
+			Namespace: "default",
+			UID:       "test-rayclusterpool-uid",
+		},
+		Spec: rayv1.RayClusterPoolSpec{
+			Size:          1,
+			ReleasePolicy: rayv1.DeleteRayClusterPoolReleasePolicy,
+		},
+	}
+	rayCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "claimed",
+			Namespace: rayClusterPool.Namespace,
+			Labels: map[string]string{
+				utils.RayOriginatedFromCRNameLabelKey: rayClusterPool.Name,
+				utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayClusterPoolCRD),
+			},
+			Annotations: map[string]string{
+				utils.RayClusterPoolClaimedByAnnotationKey: "test-rayjob",
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rayClusterPool, rayv1.GroupVersion.WithKind("RayClusterPool"))},
+		},
+	}
+	// The RayJob keeps the default values of shutdownAfterJobFinishes and deletionPolicy.
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+		Spec:       rayv1.RayJobSpec{RayClusterPoolName: rayClusterPool.Name},
+		Status: rayv1.RayJobStatus{
+			JobDeploymentStatus: rayv1.JobDeploymentStatusComplete,
+			JobStatus:           rayv1.JobStatusSucceeded,
+			RayClusterName:      rayCluster.Name,
+			EndTime:             &metav1.Time{Time: time.Now().Add(-time.Minute)},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayClusterPool, rayCluster, rayJob).WithStatusSubresource(rayJob).Build()
+	ctx := context.Background()
+	reconciler := &RayJobReconciler{
+		Client:   fakeClient,
+		Recorder: record.NewFakeRecorder(100),
+		Scheme:   newScheme,
+	}
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: rayJob.Namespace, Name: rayJob.Name}})
+	assert.NoError(t, err)
+
+	// The RayCluster is released to the pool, whose Delete policy deletes it.
+	err = fakeClient.Get(ctx, types.NamespacedName{Namespace: rayCluster.Namespace, Name: rayCluster.Name}, &rayv1.RayCluster{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestCheckRayClusterPodsAndUpdateStatusIfNeeded(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	newPod := func(nodeType rayv1.RayNodeType, status corev1.PodStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-raycluster-" + string(nodeType),
+				Namespace: "default",
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:  "test-raycluster",
+					utils.RayNodeTypeLabelKey: string(nodeType),
+				},
+			},
+			Status: status,
+		}
+	}
+	crashLoopHeadPod := newPod(rayv1.HeadNode, corev1.PodStatus{
+		ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "ray-head", RestartCount: RayJobHeadPodRestartThreshold, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+		},
+	})
+	restartedHeadPod := newPod(rayv1.HeadNode, corev1.PodStatus{
+		ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "ray-head", RestartCount: 1, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+		},
+	})
+	oomKilledHeadPod := newPod(rayv1.HeadNode, corev1.PodStatus{
+		ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "ray-head", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}}},
+		},
+	})
+	pendingHeadPod := newPod(rayv1.HeadNode, corev1.PodStatus{Phase: corev1.PodPending})
+	unschedulableWorkerPod := newPod(rayv1.WorkerNode, corev1.PodStatus{
+		Conditions: []corev1.PodCondition{
+			{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+		},
+	})
+
+	tests := map[string]struct {
+		initializationDeadlineSeconds *int32
+		expectedReason                rayv1.JobFailedReason
+		pods                          []runtime.Object
+		isDeadlineExceeded            bool
+		expectedShouldUpdate          bool
+	}{
+		"A crash-looping head Pod fails the RayJob after the restart threshold": {
+			pods:                 []runtime.Object{crashLoopHeadPod},
+			expectedShouldUpdate: true,
+			expectedReason:       rayv1.HeadPodCrashLoop,
+		},
+		"A crash-looping head Pod under the restart threshold doesn't fail the RayJob before the deadline": {
+			pods:                          []runtime.Object{restartedHeadPod},
+			initializationDeadlineSeconds: ptr.To[int32](60),
+			expectedShouldUpdate:          false,
+		},
+		"A crash-looping head Pod under the restart threshold fails the RayJob after the deadline": {
+			pods:                          []runtime.Object{restartedHeadPod},
+			initializationDeadlineSeconds: ptr.To[int32](60),
+			isDeadlineExceeded:            true,
+			expectedShouldUpdate:          true,
+			expectedReason:                rayv1.HeadPodCrashLoop,
+		},
+		"An OOMKilled head Pod that hasn't restarted doesn't fail the RayJob without a deadline": {
+			pods:                 []runtime.Object{oomKilledHeadPod},
+			expectedShouldUpdate: false,
+		},
+		"An unschedulable worker Pod doesn't fail the RayJob without a deadline": {
+			pods:                 []runtime.Object{pendingHeadPod, unschedulableWorkerPod},
+			expectedShouldUpdate: false,
+		},
+		"An unschedulable worker Pod doesn't fail the RayJob before the deadline": {
+			pods:                          []runtime.Object{pendingHeadPod, unschedulableWorkerPod},
+			initializationDeadlineSeconds: ptr.To[int32](60),
+			expectedShouldUpdate:          false,
+		},
+		"An unschedulable worker Pod fails the RayJob after the deadline": {
+			pods:                          []runtime.Object{pendingHeadPod, unschedulableWorkerPod},
+			initializationDeadlineSeconds: ptr.To[int32](60),
+			isDeadlineExceeded:            true,
+			expectedShouldUpdate:          true,
+			expectedReason:                rayv1.Unschedulable,
+		},
+		"The RayJob fails with ClusterProvisioningTimeout after the deadline if no Pod failure is found": {
+			pods:                          []runtime.Object{pendingHeadPod},
+			initializationDeadlineSeconds: ptr.To[int32](60),
+			isDeadlineExceeded:            true,
+			expectedShouldUpdate:          true,
+			expectedReason:                rayv1.ClusterProvisioningTimeout,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(100)
+			reconciler := &RayJobReconciler{
+				Client:   clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(tc.pods...).Build(),
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+			startTime := time.Now()
+			if tc.isDeadlineExceeded {
+				startTime = startTime.Add(-2 * time.Minute)
+			}
+			rayJob := &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+				Spec:       rayv1.RayJobSpec{InitializationDeadlineSeconds: tc.initializationDeadlineSeconds},
+				Status: rayv1.RayJobStatus{
+					JobDeploymentStatus: rayv1.JobDeploymentStatusInitializing,
+					StartTime:           &metav1.Time{Time: startTime},
+				},
+			}
+			rayCluster := &rayv1.RayCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-raycluster", Namespace: "default"}}
+
+			shouldUpdate, err := reconciler.checkRayClusterPodsAndUpdateStatusIfNeeded(context.Background(), rayJob, rayCluster)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedShouldUpdate, shouldUpdate)
+			if !tc.expectedShouldUpdate {
+				assert.Equal(t, rayv1.JobDeploymentStatusInitializing, rayJob.Status.JobDeploymentStatus)
+				assert.Empty(t, recorder.Events)
+				return
+			}
+			assert.Equal(t, rayv1.JobDeploymentStatusFailed, rayJob.Status.JobDeploymentStatus)
+			assert.Equal(t, tc.expectedReason, rayJob.Status.Reason)
+			assert.NotEmpty(t, rayJob.Status.Message)
+			assert.Contains(t, <-recorder.Events, string(rayClusterPodsFailureEventTypes[tc.expectedReason]))
+		})
+	}
+}
+
+func TestCheckK8sJobAndUpdateStatusIfNeeded(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	evictedPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjob-submitter",
+			Namespace: "default",
+			Labels:    map[string]string{batchv1.JobNameLabel: "test-rayjob"},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted", Message: "The node was low on resource: memory."},
+	}
+
+	tests := map[string]struct {
+		jobStatus      rayv1.JobStatus
+		expectedReason rayv1.JobFailedReason
+		pods           []runtime.Object
+	}{
+		"AppFailed if the Ray job has failed": {
+			jobStatus:      rayv1.JobStatusFailed,
+			pods:           []runtime.Object{evictedPod},
+			expectedReason: rayv1.AppFailed,
+		},
+		"SubmitterPodFailed if the submitter Pod was evicted": {
+			jobStatus:      rayv1.JobStatusPending,
+			pods:           []runtime.Object{evictedPod},
+			expectedReason: rayv1.SubmitterPodFailed,
+		},
+		"SubmissionFailed if the submitter Pods have exited with errors": {
+			jobStatus:      rayv1.JobStatusPending,
+			expectedReason: rayv1.SubmissionFailed,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reconciler := &RayJobReconciler{
+				Client:   clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(tc.pods...).Build(),
+				Recorder: record.NewFakeRecorder(100),
+				Scheme:   newScheme,
+			}
+			rayJob := &rayv1.RayJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+				Status: rayv1.RayJobStatus{
+					JobDeploymentStatus: rayv1.JobDeploymentStatusRunning,
+					JobStatus:           tc.jobStatus,
+				},
+			}
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-rayjob", Namespace: "default"},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{
+						{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+					},
+				},
+			}
+
+			shouldUpdate, err := reconciler.checkK8sJobAndUpdateStatusIfNeeded(context.Background(), rayJob, job)
+			assert.NoError(t, err)
+			assert.True(t, shouldUpdate)
+			assert.Equal(t, rayv1.JobDeploymentStatusFailed, rayJob.Status.JobDeploymentStatus)
+			assert.Equal(t, tc.expectedReason, rayJob.Status.Reason)
+		})
+	}
+}
@@ -0,0 +1,446 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
+	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+const (
+	RayJobSetDefaultRequeueDuration = 3 * time.Second
+)
+
+// The parameters of a RayJobSet are injected as environment variables, so their names must be valid environment variable names.
+var rayJobSetParameterNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// RayJobSetReconciler reconciles a RayJobSet object
+type RayJobSetReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// NewRayJobSetReconciler returns a new reconcile.Reconciler
+func NewRayJobSetReconciler(_ context.Context, mgr manager.Manager) *RayJobSetReconciler {
+	return &RayJobSetReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("rayjobset-controller"),
+	}
+}
+
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobsets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=ray.io,resources=rayjobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile creates a child RayJob for each parameter set of a RayJobSet within the parallelism limit, aggregates the
+// statuses of the child RayJobs, and decides whether the RayJobSet has succeeded or failed according to its policies.
+func (r *RayJobSetReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	rayJobSet := &rayv1.RayJobSet{}
+	if err := r.Get(ctx, request.NamespacedName, rayJobSet); err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request. Stop reconciliation.
+			logger.Info("RayJobSet resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		logger.Error(err, "Failed to get RayJobSet")
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+
+	if !rayJobSet.DeletionTimestamp.IsZero() {
+		// The child RayJobs are garbage collected by Kubernetes through their owner references.
+		logger.Info("RayJobSet is being deleted", "DeletionTimestamp", rayJobSet.DeletionTimestamp)
+		return ctrl.Result{}, nil
+	}
+
+	if err := validateRayJobSetSpec(rayJobSet); err != nil {
+		logger.Error(err, "The RayJobSet spec is invalid")
+		r.Recorder.Eventf(rayJobSet, corev1.EventTypeWarning, string(utils.InvalidRayJobSetSpec),
+			"The RayJobSet spec is invalid %s/%s: %v", rayJobSet.Namespace, rayJobSet.Name, err)
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+
+	// Please do NOT modify `originalRayJobSet` in the following code.
+	originalRayJobSet := rayJobSet.DeepCopy()
+
+	rayJobList := rayv1.RayJobList{}
+	if err := r.List(ctx, &rayJobList, common.RayJobSetRayJobsAssociationOptions(rayJobSet).ToListOptions()...); err != nil {
+		logger.Error(err, "Failed to list the child RayJobs of the RayJobSet")
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+	rayJobs := make(map[string]*rayv1.RayJob, len(rayJobList.Items))
+	for i := range rayJobList.Items {
+		if rayJob := &rayJobList.Items[i]; metav1.IsControlledBy(rayJob, rayJobSet) {
+			rayJobs[rayJob.Name] = rayJob
+		}
+	}
+
+	// The status is no longer updated once the RayJobSet has finished, and the child RayJobs that are still
+	// running are deleted.
+	if !isRayJobSetFinished(rayJobSet) {
+		updateRayJobSetStatusFromRayJobs(rayJobSet, getRayJobSetParameterSets(rayJobSet), rayJobs)
+	}
+	if isRayJobSetFinished(rayJobSet) {
+		if err := r.deleteUnfinishedRayJobs(ctx, rayJobSet, rayJobs); err != nil {
+			return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+		}
+	} else if err := r.createRayJobs(ctx, rayJobSet, rayJobs); err != nil {
+		return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+	}
+
+	rayJobSet.Status.ObservedGeneration = rayJobSet.Generation
+	if !equality.Semantic.DeepEqual(originalRayJobSet.Status, rayJobSet.Status) {
+		if err := r.Status().Update(ctx, rayJobSet); err != nil {
+			logger.Info("Failed to update RayJobSet status", "error", err)
+			return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, err
+		}
+	}
+
+	if isRayJobSetFinished(rayJobSet) {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: RayJobSetDefaultRequeueDuration}, nil
+}
+
+// createRayJobs creates the child RayJobs that haven't been created yet, in the order of their parameter sets,
+// so that at most `Parallelism` of them are active at the same time.
+func (r *RayJobSetReconciler) createRayJobs(ctx context.Context, rayJobSet *rayv1.RayJobSet, rayJobs map[string]*rayv1.RayJob) error {
+	logger := ctrl.LoggerFrom(ctx)
+	status := &rayJobSet.Status
+
+	numToCreate := status.Pending
+	if parallelism := rayJobSet.Spec.Parallelism; parallelism != nil {
+		numToCreate = min(numToCreate, *parallelism-status.Active)
+	}
+	for i := range status.Jobs {
+		if numToCreate <= 0 {
+			break
+		}
+		jobStatus := &status.Jobs[i]
+		if _, ok := rayJobs[jobStatus.Name]; ok || jobStatus.JobDeploymentStatus != "" {
+			continue
+		}
+		rayJob, err := r.constructRayJobForRayJobSet(rayJobSet, jobStatus.Name, jobStatus.Parameters)
+		if err != nil {
+			return err
+		}
+		if err := r.Create(ctx, rayJob); err != nil {
+			if !errors.IsAlreadyExists(err) {
+				r.Recorder.Eventf(rayJobSet, corev1.EventTypeWarning, string(utils.FailedToCreateRayJob), "Failed to create RayJob %s/%s: %v", rayJob.Namespace, rayJob.Name, err)
+				return err
+			}
+			// The name of a child RayJob can collide with a RayJob that the RayJobSet doesn't own, e.g., a child
+			// of another RayJobSet whose name ends with `-<index>`. The RayJobSet can never create that child.
+			existingRayJob := &rayv1.RayJob{}
+			if err := r.Get(ctx, client.ObjectKeyFromObject(rayJob), existingRayJob); err != nil {
+				return err
+			}
+			if !metav1.IsControlledBy(existingRayJob, rayJobSet) {
+				logger.Info("The child RayJob already exists and isn't owned by the RayJobSet. Transition the status to `Failed`.", "RayJob", rayJob.Name)
+				r.Recorder.Eventf(rayJobSet, corev1.EventTypeWarning, string(utils.FailedToCreateRayJob),
+					"Failed to create RayJob %s/%s: it already exists and isn't owned by the RayJobSet", rayJob.Namespace, rayJob.Name)
+				status.State = rayv1.RayJobSetFailed
+				status.Message = fmt.Sprintf("The child RayJob %s already exists and isn't owned by the RayJobSet", rayJob.Name)
+				status.EndTime = &metav1.Time{Time: time.Now()}
+				return nil
+			}
+			logger.Info("The child RayJob already exists", "RayJob", rayJob.Name)
+		} else {
+			logger.Info("Created a child RayJob", "RayJob", rayJob.Name, "parameters", jobStatus.Parameters)
+			r.Recorder.Eventf(rayJobSet, corev1.EventTypeNormal, string(utils.CreatedRayJob), "Created RayJob %s/%s", rayJob.Namespace, rayJob.Name)
+		}
+		status.Pending--
+		status.Active++
+		numToCreate--
+	}
+	return nil
+}
+
+func (r *RayJobSetReconciler) constructRayJobForRayJobSet(rayJobSet *rayv1.RayJobSet, name string, parameters map[string]string) (*rayv1.RayJob, error) {
+	labels := make(map[string]string, len(rayJobSet.Labels)+2)
+	for key, value := range rayJobSet.Labels {
+		labels[key] = value
+	}
+	labels[utils.RayOriginatedFromCRNameLabelKey] = rayJobSet.Name
+	labels[utils.RayOriginatedFromCRDLabelKey] = utils.RayOriginatedFromCRDLabelValue(utils.RayJobSetCRD)
+
+	spec := rayJobSet.Spec.Template.DeepCopy()
+	runtimeEnvYAML, err := injectParametersIntoRuntimeEnvYAML(spec.RuntimeEnvYAML, parameters)
+	if err != nil {
+		return nil, err
+	}
+	spec.RuntimeEnvYAML = runtimeEnvYAML
+	spec.Entrypoint = replaceParametersInEntrypoint(spec.Entrypoint, parameters)
+
+	rayJob := &rayv1.RayJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:    labels,
+			Name:      name,
+			Namespace: rayJobSet.Namespace,
+		},
+		Spec: *spec,
+	}
+
+	// Set the ownership in order to do the garbage collection by k8s.
+	if err := ctrl.SetControllerReference(rayJobSet, rayJob, r.Scheme); err != nil {
+		return nil, err
+	}
+	return rayJob, nil
+}
+
+// deleteUnfinishedRayJobs deletes the child RayJobs that haven't finished after the RayJobSet has finished.
+// The RayJob controller stops their Ray jobs before they are removed.
+func (r *RayJobSetReconciler) deleteUnfinishedRayJobs(ctx context.Context, rayJobSet *rayv1.RayJobSet, rayJobs map[string]*rayv1.RayJob) error {
+	logger := ctrl.LoggerFrom(ctx)
+	for _, rayJob := range rayJobs {
+		if isRayJobFinished(rayJob) || !rayJob.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, rayJob, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			r.Recorder.Eventf(rayJobSet, corev1.EventTypeWarning, string(utils.FailedToDeleteRayJob), "Failed to delete RayJob %s/%s: %v", rayJob.Namespace, rayJob.Name, err)
+			return err
+		}
+		logger.Info("Deleted an unfinished child RayJob of the finished RayJobSet", "RayJob", rayJob.Name)
+		r.Recorder.Eventf(rayJobSet, corev1.EventTypeNormal, string(utils.DeletedRayJob), "Deleted RayJob %s/%s", rayJob.Namespace, rayJob.Name)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RayJobSetReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcurrency int) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rayv1.RayJobSet{}).
+		Owns(&rayv1.RayJob{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: reconcileConcurrency,
+			LogConstructor: func(request *reconcile.Request) logr.Logger {
+				logger := ctrl.Log.WithName("controllers").WithName("RayJobSet")
+				if request != nil {
+					logger = logger.WithValues("RayJobSet", request.NamespacedName)
+				}
+				return logger
+			},
+		}).
+		Complete(r)
+}
+
+// updateRayJobSetStatusFromRayJobs records the status of the child RayJob of each parameter set, counts the child RayJobs
+// in each state, and transitions the RayJobSet to `Complete` or `Failed` once its success or failure policy is met.
+// A child RayJob that has been deleted after it finished, e.g., because of its deletion policy, keeps its last status,
+// and one that has been deleted before it finished is counted as failed.
+func updateRayJobSetStatusFromRayJobs(rayJobSet *rayv1.RayJobSet, parameterSets []map[string]string, rayJobs map[string]*rayv1.RayJob) {
+	status := &rayJobSet.Status
+	if status.StartTime == nil {
+		status.StartTime = &metav1.Time{Time: time.Now()}
+	}
+	previousJobStatuses := make(map[string]rayv1.RayJobSetJobStatus, len(status.Jobs))
+	for _, jobStatus := range status.Jobs {
+		previousJobStatuses[jobStatus.Name] = jobStatus
+	}
+
+	status.Jobs = make([]rayv1.RayJobSetJobStatus, 0, len(parameterSets))
+	status.Pending, status.Active, status.Succeeded, status.Failed = 0, 0, 0, 0
+	for i, parameters := range parameterSets {
+		jobStatus := rayv1.RayJobSetJobStatus{
+			Name:       getRayJobSetChildName(rayJobSet, i),
+			Parameters: parameters,
+		}
+		if rayJob, ok := rayJobs[jobStatus.Name]; ok {
+			jobStatus.JobStatus = rayJob.Status.JobStatus
+			jobStatus.JobDeploymentStatus = rayJob.Status.JobDeploymentStatus
+		} else if previous := previousJobStatuses[jobStatus.Name]; previous.JobDeploymentStatus != rayv1.JobDeploymentStatusNew {
+			// The child RayJob was created before, so it is never recreated. If it was deleted before it finished,
+			// it can't finish anymore and is counted as failed.
+			jobStatus.JobStatus = previous.JobStatus
+			jobStatus.JobDeploymentStatus = previous.JobDeploymentStatus
+			if !isRayJobDeploymentStatusFinished(previous.JobDeploymentStatus) {
+				jobStatus.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+			}
+		}
+
+		switch {
+		case jobStatus.JobDeploymentStatus == rayv1.JobDeploymentStatusComplete && jobStatus.JobStatus == rayv1.JobStatusSucceeded:
+			status.Succeeded++
+		case isRayJobDeploymentStatusFinished(jobStatus.JobDeploymentStatus):
+			status.Failed++
+		case rayJobs[jobStatus.Name] != nil:
+			status.Active++
+		default:
+			status.Pending++
+		}
+		status.Jobs = append(status.Jobs, jobStatus)
+	}
+
+	numSucceededRequired, numFailedAllowed := getRayJobSetPolicyThresholds(rayJobSet, int32(len(parameterSets)))
+	switch {
+	case status.Succeeded >= numSucceededRequired:
+		status.State = rayv1.RayJobSetComplete
+		status.Message = fmt.Sprintf("%d of %d child RayJobs succeeded", status.Succeeded, len(parameterSets))
+	case status.Failed >= numFailedAllowed:
+		status.State = rayv1.RayJobSetFailed
+		status.Message = fmt.Sprintf("%d of %d child RayJobs failed, which reaches the failure policy", status.Failed, len(parameterSets))
+	case status.Pending+status.Active == 0:
+		status.State = rayv1.RayJobSetFailed
+		status.Message = fmt.Sprintf("All child RayJobs finished, but only %d of them succeeded while %d are required", status.Succeeded, numSucceededRequired)
+	default:
+		status.State = rayv1.RayJobSetRunning
+		status.Message = ""
+	}
+	if isRayJobSetFinished(rayJobSet) {
+		status.EndTime = &metav1.Time{Time: time.Now()}
+	}
+}
+
+// getRayJobSetPolicyThresholds returns the number of succeeded child RayJobs required by the success policy and the
+// number of failed child RayJobs at which the failure policy is met.
+func getRayJobSetPolicyThresholds(rayJobSet *rayv1.RayJobSet, numJobs int32) (int32, int32) {
+	numSucceededRequired := numJobs
+	if successPolicy := rayJobSet.Spec.SuccessPolicy; successPolicy != nil && successPolicy.SucceededJobs != nil {
+		numSucceededRequired = *successPolicy.SucceededJobs
+	}
+	numFailedAllowed := numJobs - numSucceededRequired + 1
+	if failurePolicy := rayJobSet.Spec.FailurePolicy; failurePolicy != nil && failurePolicy.FailedJobs != nil {
+		numFailedAllowed = *failurePolicy.FailedJobs
+	}
+	return numSucceededRequired, numFailedAllowed
+}
+
+// getRayJobSetParameterSets returns the parameter sets of the RayJobSet. The parameter sets of a matrix are enumerated
+// in the lexicographical order of the parameter names, with the values of the last parameter varying the fastest.
+func getRayJobSetParameterSets(rayJobSet *rayv1.RayJobSet) []map[string]string {
+	parameters := rayJobSet.Spec.Parameters
+	if len(parameters.List) != 0 {
+		return parameters.List
+	}
+
+	names := make([]string, 0, len(parameters.Matrix))
+	for name := range parameters.Matrix {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	parameterSets := []map[string]string{{}}
+	for _, name := range names {
+		nextParameterSets := make([]map[string]string, 0, len(parameterSets)*len(parameters.Matrix[name]))
+		for _, parameterSet := range parameterSets {
+			for _, value := range parameters.Matrix[name] {
+				nextParameterSet := maps.Clone(parameterSet)
+				nextParameterSet[name] = value
+				nextParameterSets = append(nextParameterSets, nextParameterSet)
+			}
+		}
+		parameterSets = nextParameterSets
+	}
+	return parameterSets
+}
+
+// injectParametersIntoRuntimeEnvYAML adds the parameters to the `env_vars` of the runtime environment. A parameter
+// overrides an environment variable with the same name in the template.
+func injectParametersIntoRuntimeEnvYAML(runtimeEnvYAML string, parameters map[string]string) (string, error) {
+	runtimeEnv, err := utils.UnmarshalRuntimeEnvYAML(runtimeEnvYAML)
+	if err != nil {
+		return "", err
+	}
+	if runtimeEnv == nil {
+		runtimeEnv = utils.RuntimeEnvType{}
+	}
+	envVars := map[string]interface{}{}
+	if value, ok := runtimeEnv["env_vars"]; ok && value != nil {
+		if envVars, ok = value.(map[string]interface{}); !ok {
+			return "", fmt.Errorf("env_vars in runtimeEnvYAML must be a map, got %T", value)
+		}
+	}
+	for name, value := range parameters {
+		envVars[name] = value
+	}
+	runtimeEnv["env_vars"] = envVars
+
+	data, err := yaml.Marshal(runtimeEnv)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// replaceParametersInEntrypoint replaces every `$(NAME)` in the entrypoint with the value of the parameter NAME.
+func replaceParametersInEntrypoint(entrypoint string, parameters map[string]string) string {
+	oldNew := make([]string, 0, 2*len(parameters))
+	for name, value := range parameters {
+		oldNew = append(oldNew, "$("+name+")", value)
+	}
+	return strings.NewReplacer(oldNew...).Replace(entrypoint)
+}
+
+// getRayJobSetChildName returns the name of the child RayJob of the parameter set at `index`.
+func getRayJobSetChildName(rayJobSet *rayv1.RayJobSet, index int) string {
+	return fmt.Sprintf("%s-%d", rayJobSet.Name, index)
+}
+
+func isRayJobSetFinished(rayJobSet *rayv1.RayJobSet) bool {
+	return rayJobSet.Status.State == rayv1.RayJobSetComplete || rayJobSet.Status.State == rayv1.RayJobSetFailed
+}
+
+func isRayJobDeploymentStatusFinished(status rayv1.JobDeploymentStatus) bool {
+	return status == rayv1.JobDeploymentStatusComplete || status == rayv1.JobDeploymentStatusFailed
+}
+
+func validateRayJobSetSpec(rayJobSet *rayv1.RayJobSet) error {
+	parameters := rayJobSet.Spec.Parameters
+	if (len(parameters.Matrix) == 0) == (len(parameters.List) == 0) {
+		return fmt.Errorf("exactly one of matrix and list must be set in parameters")
+	}
+	for name, values := range parameters.Matrix {
+		if !rayJobSetParameterNameRegexp.MatchString(name) {
+			return fmt.Errorf("invalid parameter name %q: must be a valid environment variable name", name)
+		}
+		if len(values) == 0 {
+			return fmt.Errorf("parameter %q in matrix must have at least one value", name)
+		}
+	}
+	for _, parameterSet := range parameters.List {
+		for name := range parameterSet {
+			if !rayJobSetParameterNameRegexp.MatchString(name) {
+				return fmt.Errorf("invalid parameter name %q: must be a valid environment variable name", name)
+			}
+		}
+	}
+	numJobs := int32(len(getRayJobSetParameterSets(rayJobSet)))
+	if successPolicy := rayJobSet.Spec.SuccessPolicy; successPolicy != nil && successPolicy.SucceededJobs != nil && *successPolicy.SucceededJobs > numJobs {
+		return fmt.Errorf("succeededJobs in successPolicy must not be greater than the number of parameter sets %d", numJobs)
+	}
+	if rayJobSet.Spec.Template.RuntimeEnvFrom != nil {
+		return fmt.Errorf("runtimeEnvFrom can't be set in the template because the parameters are injected into runtimeEnvYAML")
+	}
+	if err := validateRayJobSpec(&rayv1.RayJob{Spec: rayJobSet.Spec.Template}); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,514 @@
+package ray
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	utils "github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
+)
+
+func TestGetRayJobSetParameterSets(t *testing.T) {
+	rayJobSet := &rayv1.RayJobSet{
+		Spec: rayv1.RayJobSetSpec{
+			Parameters: rayv1.RayJobSetParameters{
+				List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}, {"LR": "0.001"}},
+			},
+		},
+	}
+	assert.Equal(t, rayJobSet.Spec.Parameters.List, getRayJobSetParameterSets(rayJobSet))
+
+	rayJobSet.Spec.Parameters = rayv1.RayJobSetParameters{
+		Matrix: map[string][]string{
+			"LR":         {"0.1", "0.01"},
+			"BATCH_SIZE": {"32", "64"},
+		},
+	}
+	assert.Equal(t, []map[string]string{
+		{"BATCH_SIZE": "32", "LR": "0.1"},
+		{"BATCH_SIZE": "32", "LR": "0.01"},
+		{"BATCH_SIZE": "64", "LR": "0.1"},
+		{"BATCH_SIZE": "64", "LR": "0.01"},
+	}, getRayJobSetParameterSets(rayJobSet))
+}
+
+func TestConstructRayJobForRayJobSet(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(scheme)
+
+	rayJobSet := &rayv1.RayJobSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjobset",
+			Namespace: "default",
+			UID:       "test-rayjobset-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayJobSetSpec{
+			Parameters: rayv1.RayJobSetParameters{
+				List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}, {"LR": "0.001"}},
+			},
+			Template: rayv1.RayJobSpec{
+				Entrypoint:      "python train.py --lr $(LR)",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+	}
+	rayJobSet.Spec.Template.RuntimeEnvYAML = "pip: [\"requests\"]\nenv_vars:\n  LR: \"1.0\"\n  SEED: \"42\"\n"
+	r := &RayJobSetReconciler{Scheme: scheme}
+	rayJob, err := r.constructRayJobForRayJobSet(rayJobSet, "test-rayjobset-1", map[string]string{"LR": "0.01"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "test-rayjobset-1", rayJob.Name)
+	assert.Equal(t, "test", rayJob.Labels["app"])
+	assert.Equal(t, rayJobSet.Name, rayJob.Labels[utils.RayOriginatedFromCRNameLabelKey])
+	assert.Equal(t, utils.RayOriginatedFromCRDLabelValue(utils.RayJobSetCRD), rayJob.Labels[utils.RayOriginatedFromCRDLabelKey])
+	assert.True(t, metav1.IsControlledBy(rayJob, rayJobSet))
+	assert.Equal(t, "python train.py --lr 0.01", rayJob.Spec.Entrypoint)
+
+	runtimeEnv, err := utils.UnmarshalRuntimeEnvYAML(rayJob.Spec.RuntimeEnvYAML)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"requests"}, runtimeEnv["pip"])
+	assert.Equal(t, map[string]interface{}{"LR": "0.01", "SEED": "42"}, runtimeEnv["env_vars"])
+
+	// The template of the RayJobSet must not be modified.
+	assert.Equal(t, "python train.py --lr $(LR)", rayJobSet.Spec.Template.Entrypoint)
+
+	rayJobSet.Spec.Template.RuntimeEnvYAML = "env_vars: [\"LR\"]"
+	_, err = r.constructRayJobForRayJobSet(rayJobSet, "test-rayjobset-0", map[string]string{"LR": "0.1"})
+	assert.ErrorContains(t, err, "env_vars in runtimeEnvYAML must be a map")
+}
+
+func TestValidateRayJobSetSpec(t *testing.T) {
+	tests := map[string]struct {
+		mutate        func(rayJobSet *rayv1.RayJobSet)
+		expectedError string
+	}{
+		"Valid list": {
+			mutate: func(_ *rayv1.RayJobSet) {},
+		},
+		"Valid matrix": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.Parameters = rayv1.RayJobSetParameters{Matrix: map[string][]string{"LR": {"0.1", "0.01"}}}
+			},
+		},
+		"Neither matrix nor list": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.Parameters = rayv1.RayJobSetParameters{}
+			},
+			expectedError: "exactly one of matrix and list",
+		},
+		"Both matrix and list": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.Parameters.Matrix = map[string][]string{"LR": {"0.1"}}
+			},
+			expectedError: "exactly one of matrix and list",
+		},
+		"Invalid parameter name": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.Parameters.List[0] = map[string]string{"LEARNING-RATE": "0.1"}
+			},
+			expectedError: "invalid parameter name",
+		},
+		"Matrix parameter without values": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.Parameters = rayv1.RayJobSetParameters{Matrix: map[string][]string{"LR": {}}}
+			},
+			expectedError: "must have at least one value",
+		},
+		"SucceededJobs greater than the number of parameter sets": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.SuccessPolicy = &rayv1.RayJobSetSuccessPolicy{SucceededJobs: ptr.To[int32](4)}
+			},
+			expectedError: "succeededJobs in successPolicy",
+		},
+		"Invalid template": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.Template.Suspend = true
+			},
+			expectedError: "invalid template",
+		},
+		"Runtime environment from a ConfigMap": {
+			mutate: func(rayJobSet *rayv1.RayJobSet) {
+				rayJobSet.Spec.Template.RuntimeEnvFrom = &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "runtime-env"},
+					Key:                  "runtime_env.yaml",
+				}
+			},
+			expectedError: "runtimeEnvFrom can't be set in the template",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayJobSet := &rayv1.RayJobSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-rayjobset",
+					Namespace: "default",
+					UID:       "test-rayjobset-uid",
+					Labels:    map[string]string{"app": "test"},
+				},
+				Spec: rayv1.RayJobSetSpec{
+					Parameters: rayv1.RayJobSetParameters{
+						List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}, {"LR": "0.001"}},
+					},
+					Template: rayv1.RayJobSpec{
+						Entrypoint:      "python train.py --lr $(LR)",
+						ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+					},
+				},
+			}
+			tc.mutate(rayJobSet)
+			err := validateRayJobSetSpec(rayJobSet)
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestUpdateRayJobSetStatusFromRayJobs(t *testing.T) {
+	// An empty JobStatus means that the child RayJob hasn't been created.
+	const (
+		succeeded = rayv1.JobStatusSucceeded
+		failed    = rayv1.JobStatusFailed
+		running   = rayv1.JobStatusRunning
+		pending   = rayv1.JobStatusNew
+	)
+	deploymentStatuses := map[rayv1.JobStatus]rayv1.JobDeploymentStatus{
+		succeeded: rayv1.JobDeploymentStatusComplete,
+		failed:    rayv1.JobDeploymentStatusFailed,
+		running:   rayv1.JobDeploymentStatusRunning,
+	}
+
+	tests := map[string]struct {
+		successPolicy     *rayv1.RayJobSetSuccessPolicy
+		failurePolicy     *rayv1.RayJobSetFailurePolicy
+		expectedState     rayv1.RayJobSetState
+		rayJobs           []rayv1.JobStatus
+		expectedPending   int32
+		expectedActive    int32
+		expectedSucceeded int32
+		expectedFailed    int32
+	}{
+		"No child RayJob has been created": {
+			rayJobs:         []rayv1.JobStatus{pending, pending, pending},
+			expectedState:   rayv1.RayJobSetRunning,
+			expectedPending: 3,
+		},
+		"Some child RayJobs are running": {
+			rayJobs:           []rayv1.JobStatus{succeeded, running, pending},
+			expectedState:     rayv1.RayJobSetRunning,
+			expectedPending:   1,
+			expectedActive:    1,
+			expectedSucceeded: 1,
+		},
+		"All child RayJobs succeeded": {
+			rayJobs:           []rayv1.JobStatus{succeeded, succeeded, succeeded},
+			expectedState:     rayv1.RayJobSetComplete,
+			expectedSucceeded: 3,
+		},
+		"A failure fails the RayJobSet by default": {
+			rayJobs:           []rayv1.JobStatus{succeeded, failed, running},
+			expectedState:     rayv1.RayJobSetFailed,
+			expectedActive:    1,
+			expectedSucceeded: 1,
+			expectedFailed:    1,
+		},
+		"The success policy is met": {
+			successPolicy:     &rayv1.RayJobSetSuccessPolicy{SucceededJobs: ptr.To[int32](2)},
+			rayJobs:           []rayv1.JobStatus{succeeded, failed, succeeded},
+			expectedState:     rayv1.RayJobSetComplete,
+			expectedSucceeded: 2,
+			expectedFailed:    1,
+		},
+		"A failure tolerated by the success policy": {
+			successPolicy:     &rayv1.RayJobSetSuccessPolicy{SucceededJobs: ptr.To[int32](2)},
+			rayJobs:           []rayv1.JobStatus{succeeded, failed, running},
+			expectedState:     rayv1.RayJobSetRunning,
+			expectedActive:    1,
+			expectedSucceeded: 1,
+			expectedFailed:    1,
+		},
+		"The failure policy is met": {
+			successPolicy:  &rayv1.RayJobSetSuccessPolicy{SucceededJobs: ptr.To[int32](1)},
+			failurePolicy:  &rayv1.RayJobSetFailurePolicy{FailedJobs: ptr.To[int32](2)},
+			rayJobs:        []rayv1.JobStatus{failed, failed, running},
+			expectedState:  rayv1.RayJobSetFailed,
+			expectedActive: 1,
+			expectedFailed: 2,
+		},
+		"All child RayJobs finished without meeting either policy": {
+			successPolicy:     &rayv1.RayJobSetSuccessPolicy{SucceededJobs: ptr.To[int32](3)},
+			failurePolicy:     &rayv1.RayJobSetFailurePolicy{FailedJobs: ptr.To[int32](3)},
+			rayJobs:           []rayv1.JobStatus{succeeded, succeeded, failed},
+			expectedState:     rayv1.RayJobSetFailed,
+			expectedSucceeded: 2,
+			expectedFailed:    1,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayJobSet := &rayv1.RayJobSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-rayjobset",
+					Namespace: "default",
+				},
+				Spec: rayv1.RayJobSetSpec{
+					Parameters: rayv1.RayJobSetParameters{
+						List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}, {"LR": "0.001"}},
+					},
+					SuccessPolicy: tc.successPolicy,
+					FailurePolicy: tc.failurePolicy,
+				},
+			}
+			rayJobs := map[string]*rayv1.RayJob{}
+			for i, jobStatus := range tc.rayJobs {
+				if jobStatus != pending {
+					name := fmt.Sprintf("test-rayjobset-%d", i)
+					rayJobs[name] = &rayv1.RayJob{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      name,
+							Namespace: "default",
+						},
+						Status: rayv1.RayJobStatus{
+							JobStatus:           jobStatus,
+							JobDeploymentStatus: deploymentStatuses[jobStatus],
+						},
+					}
+				}
+			}
+
+			updateRayJobSetStatusFromRayJobs(rayJobSet, getRayJobSetParameterSets(rayJobSet), rayJobs)
+			status := rayJobSet.Status
+			assert.Equal(t, tc.expectedState, status.State)
+			assert.Equal(t, tc.expectedPending, status.Pending)
+			assert.Equal(t, tc.expectedActive, status.Active)
+			assert.Equal(t, tc.expectedSucceeded, status.Succeeded)
+			assert.Equal(t, tc.expectedFailed, status.Failed)
+			assert.Len(t, status.Jobs, 3)
+			assert.NotNil(t, status.StartTime)
+			assert.Equal(t, isRayJobSetFinished(rayJobSet), status.EndTime != nil)
+		})
+	}
+}
+
+func TestUpdateRayJobSetStatusFromRayJobs_DeletedRayJob(t *testing.T) {
+	rayJobSet := &rayv1.RayJobSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjobset",
+			Namespace: "default",
+		},
+		Spec: rayv1.RayJobSetSpec{
+			Parameters: rayv1.RayJobSetParameters{
+				List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}, {"LR": "0.001"}},
+			},
+		},
+		Status: rayv1.RayJobSetStatus{
+			Jobs: []rayv1.RayJobSetJobStatus{
+				{Name: "test-rayjobset-0", JobStatus: rayv1.JobStatusSucceeded, JobDeploymentStatus: rayv1.JobDeploymentStatusComplete},
+				{Name: "test-rayjobset-1", JobStatus: rayv1.JobStatusRunning, JobDeploymentStatus: rayv1.JobDeploymentStatusRunning},
+			},
+		},
+	}
+
+	// A finished child RayJob that has been deleted keeps its last status, while an unfinished one is counted as
+	// failed instead of being recreated.
+	updateRayJobSetStatusFromRayJobs(rayJobSet, getRayJobSetParameterSets(rayJobSet), map[string]*rayv1.RayJob{})
+	assert.Equal(t, int32(1), rayJobSet.Status.Succeeded)
+	assert.Equal(t, int32(1), rayJobSet.Status.Failed)
+	assert.Equal(t, int32(1), rayJobSet.Status.Pending)
+	assert.Equal(t, rayv1.JobStatusSucceeded, rayJobSet.Status.Jobs[0].JobStatus)
+	assert.Equal(t, rayv1.JobDeploymentStatusFailed, rayJobSet.Status.Jobs[1].JobDeploymentStatus)
+}
+
+func TestReconcileRayJobSet_Parallelism(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayJobSet := &rayv1.RayJobSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjobset",
+			Namespace: "default",
+			UID:       "test-rayjobset-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayJobSetSpec{
+			Parameters: rayv1.RayJobSetParameters{
+				List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}, {"LR": "0.001"}},
+			},
+			Parallelism: ptr.To[int32](2),
+			Template: rayv1.RayJobSpec{
+				Entrypoint:      "python train.py --lr $(LR)",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+	}
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(rayJobSet).
+		WithStatusSubresource(rayJobSet).
+		Build()
+	r := &RayJobSetReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+	namespacedName := types.NamespacedName{Namespace: rayJobSet.Namespace, Name: rayJobSet.Name}
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.Equal(t, RayJobSetDefaultRequeueDuration, result.RequeueAfter)
+	rayJobList := rayv1.RayJobList{}
+	assert.NoError(t, fakeClient.List(ctx, &rayJobList, client.InNamespace(rayJobSet.Namespace)))
+	names := []string{}
+	for _, rayJob := range rayJobList.Items {
+		names = append(names, rayJob.Name)
+	}
+	assert.ElementsMatch(t, []string{"test-rayjobset-0", "test-rayjobset-1"}, names)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayJobSet))
+	assert.Equal(t, rayv1.RayJobSetRunning, rayJobSet.Status.State)
+	assert.Equal(t, int32(1), rayJobSet.Status.Pending)
+	assert.Equal(t, int32(2), rayJobSet.Status.Active)
+
+	// Once a child RayJob finishes, the next one is created.
+	rayJob := &rayv1.RayJob{}
+	assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: rayJobSet.Namespace, Name: "test-rayjobset-0"}, rayJob))
+	rayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusComplete
+	rayJob.Status.JobStatus = rayv1.JobStatusSucceeded
+	assert.NoError(t, fakeClient.Update(ctx, rayJob))
+
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.List(ctx, &rayJobList, client.InNamespace(rayJobSet.Namespace)))
+	assert.Len(t, rayJobList.Items, 3)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayJobSet))
+	assert.Equal(t, int32(0), rayJobSet.Status.Pending)
+	assert.Equal(t, int32(2), rayJobSet.Status.Active)
+	assert.Equal(t, int32(1), rayJobSet.Status.Succeeded)
+}
+
+func TestReconcileRayJobSet_DeleteUnfinishedRayJobs(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayJobSet := &rayv1.RayJobSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjobset",
+			Namespace: "default",
+			UID:       "test-rayjobset-uid",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: rayv1.RayJobSetSpec{
+			Parameters: rayv1.RayJobSetParameters{
+				List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}, {"LR": "0.001"}},
+			},
+			Template: rayv1.RayJobSpec{
+				Entrypoint:      "python train.py --lr $(LR)",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+	}
+	failedRayJob, err := (&RayJobSetReconciler{Scheme: newScheme}).constructRayJobForRayJobSet(rayJobSet, "test-rayjobset-0", map[string]string{"LR": "0.1"})
+	assert.NoError(t, err)
+	failedRayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusFailed
+	failedRayJob.Status.JobStatus = rayv1.JobStatusFailed
+	runningRayJob, err := (&RayJobSetReconciler{Scheme: newScheme}).constructRayJobForRayJobSet(rayJobSet, "test-rayjobset-1", map[string]string{"LR": "0.01"})
+	assert.NoError(t, err)
+	runningRayJob.Status.JobDeploymentStatus = rayv1.JobDeploymentStatusRunning
+	runningRayJob.Status.JobStatus = rayv1.JobStatusRunning
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(rayJobSet, failedRayJob, runningRayJob).
+		WithStatusSubresource(rayJobSet).
+		Build()
+	r := &RayJobSetReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+	namespacedName := types.NamespacedName{Namespace: rayJobSet.Namespace, Name: rayJobSet.Name}
+
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.Equal(t, ctrl.Result{}, result)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayJobSet))
+	assert.Equal(t, rayv1.RayJobSetFailed, rayJobSet.Status.State)
+	assert.NotNil(t, rayJobSet.Status.EndTime)
+	// The running RayJob is deleted, and no RayJob is created for the pending parameter set.
+	rayJobList := rayv1.RayJobList{}
+	assert.NoError(t, fakeClient.List(ctx, &rayJobList, client.InNamespace(rayJobSet.Namespace)))
+	assert.Len(t, rayJobList.Items, 1)
+	assert.Equal(t, failedRayJob.Name, rayJobList.Items[0].Name)
+}
+
+func TestReconcileRayJobSet_RayJobNameCollision(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayJobSet := &rayv1.RayJobSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-rayjobset",
+			Namespace: "default",
+			UID:       "test-rayjobset-uid",
+		},
+		Spec: rayv1.RayJobSetSpec{
+			Parameters: rayv1.RayJobSetParameters{
+				List: []map[string]string{{"LR": "0.1"}, {"LR": "0.01"}},
+			},
+			Template: rayv1.RayJobSpec{
+				Entrypoint:      "python train.py --lr $(LR)",
+				ClusterSelector: map[string]string{utils.RayClusterLabelKey: "test-raycluster"},
+			},
+		},
+	}
+	// The RayJob has the name of the second child RayJob, but it is owned by another RayJobSet named `test-rayjobset-1`.
+	otherRayJobSet := rayJobSet.DeepCopy()
+	otherRayJobSet.Name = "test-rayjobset-1"
+	otherRayJobSet.UID = "other-rayjobset-uid"
+	otherRayJob, err := (&RayJobSetReconciler{Scheme: newScheme}).constructRayJobForRayJobSet(otherRayJobSet, "test-rayjobset-1", map[string]string{"LR": "0.1"})
+	assert.NoError(t, err)
+
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithObjects(rayJobSet, otherRayJob).
+		WithStatusSubresource(rayJobSet).
+		Build()
+	r := &RayJobSetReconciler{
+		Client:   fakeClient,
+		Scheme:   newScheme,
+		Recorder: record.NewFakeRecorder(100),
+	}
+	ctx := context.Background()
+	namespacedName := types.NamespacedName{Namespace: rayJobSet.Namespace, Name: rayJobSet.Name}
+
+	_, err = r.Reconcile(ctx, ctrl.Request{NamespacedName: namespacedName})
+	assert.NoError(t, err)
+	assert.NoError(t, fakeClient.Get(ctx, namespacedName, rayJobSet))
+	assert.Equal(t, rayv1.RayJobSetFailed, rayJobSet.Status.State)
+	assert.Contains(t, rayJobSet.Status.Message, "test-rayjobset-1")
+	assert.NotNil(t, rayJobSet.Status.EndTime)
+
+	// The RayJob owned by the other RayJobSet isn't touched.
+	rayJob := &rayv1.RayJob{}
+	assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: rayJobSet.Namespace, Name: "test-rayjobset-1"}, rayJob))
+	assert.True(t, metav1.IsControlledBy(rayJob, otherRayJobSet))
+}
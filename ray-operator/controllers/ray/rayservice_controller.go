@@ -7,6 +7,7 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -19,8 +20,6 @@ import (
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/common"
 	"github.com/ray-project/kuberay/ray-operator/pkg/features"
 
-	cmap "github.com/orcaman/concurrent-map/v2"
-
 	"github.com/go-logr/logr"
 	fmtErrors "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -33,11 +32,13 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -48,32 +49,36 @@ const (
 	ServiceDefaultRequeueDuration   = 2 * time.Second
 	RayClusterDeletionDelayDuration = 60 * time.Second
 	ENABLE_ZERO_DOWNTIME            = "ENABLE_ZERO_DOWNTIME"
+
+	DefaultIncrementalUpgradeIntervalSeconds = int32(60)
+
+	// Definition of an index field for the name of the ConfigMap that a RayService reads its Serve config from
+	rayServiceServeConfigMapIndexField = "spec.serveConfigV2From.name"
 )
 
+var DefaultIncrementalUpgradeTrafficSteps = []int32{10, 50, 100}
+
 // RayServiceReconciler reconciles a RayService object
 type RayServiceReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
-	// Currently, the Ray dashboard doesn't cache the Serve deployment config.
-	// To avoid reapplying the same config repeatedly, cache the config in this map.
-	ServeConfigs                 cmap.ConcurrentMap[string, string]
-	RayClusterDeletionTimestamps cmap.ConcurrentMap[string, time.Time]
 
 	dashboardClientFunc func() utils.RayDashboardClientInterface
 	httpProxyClientFunc func() utils.RayHttpProxyClientInterface
 }
 
 // NewRayServiceReconciler returns a new reconcile.Reconciler
-func NewRayServiceReconciler(_ context.Context, mgr manager.Manager, provider utils.ClientProvider) *RayServiceReconciler {
+func NewRayServiceReconciler(ctx context.Context, mgr manager.Manager, provider utils.ClientProvider) *RayServiceReconciler {
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &rayv1.RayService{}, rayServiceServeConfigMapIndexField, rayServiceServeConfigMapName); err != nil {
+		panic(err)
+	}
 	dashboardClientFunc := provider.GetDashboardClient(mgr)
 	httpProxyClientFunc := provider.GetHttpProxyClient(mgr)
 	return &RayServiceReconciler{
-		Client:                       mgr.GetClient(),
-		Scheme:                       mgr.GetScheme(),
-		Recorder:                     mgr.GetEventRecorderFor("rayservice-controller"),
-		ServeConfigs:                 cmap.New[string](),
-		RayClusterDeletionTimestamps: cmap.New[time.Time](),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("rayservice-controller"),
 
 		dashboardClientFunc: dashboardClientFunc,
 		httpProxyClientFunc: httpProxyClientFunc,
@@ -91,6 +96,7 @@ func NewRayServiceReconciler(_ context.Context, mgr manager.Manager, provider ut
 // +kubebuilder:rbac:groups=core,resources=pods/status,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=pods/proxy,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core,resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=services/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=core,resources=services/proxy,verbs=get;update;patch
@@ -131,7 +137,9 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
 	}
 
-	r.cleanUpServeConfigCache(ctx, rayServiceInstance)
+	if rayServiceInstance.Annotations[utils.RayServiceRollbackAnnotationKey] == "true" {
+		return r.rollBackToPreviousRayCluster(ctx, rayServiceInstance)
+	}
 
 	// TODO (kevin85421): ObservedGeneration should be used to determine whether to update this CR or not.
 	rayServiceInstance.Status.ObservedGeneration = rayServiceInstance.ObjectMeta.Generation
@@ -166,7 +174,7 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
 		if isReady, err = r.reconcileServe(ctx, rayServiceInstance, activeRayClusterInstance, true); err != nil {
 			logger.Error(err, "Fail to reconcileServe.")
-			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, r.updateRayClusterDeletionTimestamps(ctx, originalRayServiceInstance, rayServiceInstance)
 		}
 	} else if activeRayClusterInstance != nil && pendingRayClusterInstance != nil {
 		logger.Info("Reconciling the Serve component. Active and pending Ray clusters exist.")
@@ -175,15 +183,19 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 			logger.Error(err, "Failed to update active Ray cluster's status.")
 		}
 
-		if isReady, err = r.reconcileServe(ctx, rayServiceInstance, pendingRayClusterInstance, false); err != nil {
+		isReady, err = r.reconcileServe(ctx, rayServiceInstance, pendingRayClusterInstance, false)
+		if !isReady && isUpgradeTimedOut(rayServiceInstance, pendingRayClusterInstance, time.Now()) {
+			return r.rollBackUpgrade(ctx, rayServiceInstance, pendingRayClusterInstance)
+		}
+		if err != nil {
 			logger.Error(err, "Fail to reconcileServe.")
-			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, r.updateRayClusterDeletionTimestamps(ctx, originalRayServiceInstance, rayServiceInstance)
 		}
 	} else if activeRayClusterInstance == nil && pendingRayClusterInstance != nil {
 		rayServiceInstance.Status.ActiveServiceStatus = rayv1.RayServiceStatus{}
 		if isReady, err = r.reconcileServe(ctx, rayServiceInstance, pendingRayClusterInstance, false); err != nil {
 			logger.Error(err, "Fail to reconcileServe.")
-			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, r.updateRayClusterDeletionTimestamps(ctx, originalRayServiceInstance, rayServiceInstance)
 		}
 	} else {
 		logger.Info("Reconciling the Serve component. No Ray cluster exists.")
@@ -191,8 +203,20 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
 	}
 
+	if features.Enabled(features.RayJobAndRayServiceStatusConditions) {
+		setRayServiceConditions(rayServiceInstance)
+	}
+
 	if !isReady {
 		logger.Info("Ray Serve applications are not ready to serve requests")
+		// The other status fields have already been updated in `reconcileServe` if needed.
+		if !reflect.DeepEqual(originalRayServiceInstance.Status.Conditions, rayServiceInstance.Status.Conditions) ||
+			!reflect.DeepEqual(originalRayServiceInstance.Status.RayClusterDeletionTimestamps, rayServiceInstance.Status.RayClusterDeletionTimestamps) {
+			if errStatus := r.Status().Update(ctx, rayServiceInstance); errStatus != nil {
+				logger.Error(errStatus, "Failed to update the conditions and RayCluster deletion schedule of RayService", "rayServiceInstance", rayServiceInstance)
+				return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, errStatus
+			}
+		}
 		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
 	}
 
@@ -211,7 +235,11 @@ func (r *RayServiceReconciler) Reconcile(ctx context.Context, request ctrl.Reque
 		logger.Info("No Ray cluster found. Skipping ingress and service reconciliation.")
 	}
 
-	if rayClusterInstance != nil {
+	if utils.IsIncrementalUpgradeEnabled(&rayServiceInstance.Spec) {
+		if err := r.reconcileIncrementalUpgrade(ctx, rayServiceInstance, activeRayClusterInstance, pendingRayClusterInstance); err != nil {
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+	} else if rayClusterInstance != nil {
 		if err := r.reconcileServices(ctx, rayServiceInstance, rayClusterInstance, utils.HeadService); err != nil {
 			err = r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
 			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
@@ -248,8 +276,47 @@ func validateRayServiceSpec(rayService *rayv1.RayService) error {
 	}
 
 	if upgradeStrategy := rayService.Spec.UpgradeStrategy; upgradeStrategy != nil {
-		if *upgradeStrategy != rayv1.NewCluster && *upgradeStrategy != rayv1.None {
-			return fmt.Errorf("spec.UpgradeStrategy value %s is invalid, valid options are %s or %s", *upgradeStrategy, rayv1.NewCluster, rayv1.None)
+		if *upgradeStrategy != rayv1.NewCluster && *upgradeStrategy != rayv1.IncrementalUpgrade && *upgradeStrategy != rayv1.None {
+			return fmt.Errorf("spec.UpgradeStrategy value %s is invalid, valid options are %s, %s or %s", *upgradeStrategy, rayv1.NewCluster, rayv1.IncrementalUpgrade, rayv1.None)
+		}
+	}
+
+	if rayService.Spec.ServeConfigV2From != nil && rayService.Spec.ServeConfigV2 != "" {
+		return fmt.Errorf("spec.serveConfigV2 and spec.serveConfigV2From can't be set at the same time")
+	}
+
+	if selector := rayService.Spec.ServeConfigV2From; selector != nil && ptr.Deref(selector.Optional, false) {
+		return fmt.Errorf("spec.serveConfigV2From.optional must not be true because a missing Serve config would delete all Serve applications")
+	}
+
+	if isInPlaceWorkerGroupUpgradeEnabled(&rayService.Spec) {
+		if upgradeStrategy := rayService.Spec.RayClusterSpec.UpgradeStrategy; upgradeStrategy == nil || upgradeStrategy.Type == nil {
+			return fmt.Errorf("spec.rayClusterConfig.upgradeStrategy.type must be set when spec.workerGroupUpgradeStrategy is %s", rayv1.InPlaceWorkerGroupUpgrade)
+		}
+	}
+
+	if timeoutSeconds := rayService.Spec.UpgradeTimeoutSeconds; timeoutSeconds != nil && *timeoutSeconds <= 0 {
+		return fmt.Errorf("spec.upgradeTimeoutSeconds must be a positive integer")
+	}
+
+	if options := rayService.Spec.IncrementalUpgradeOptions; options != nil {
+		if !utils.IsIncrementalUpgradeEnabled(&rayService.Spec) {
+			return fmt.Errorf("spec.incrementalUpgradeOptions can only be set when spec.UpgradeStrategy is %s", rayv1.IncrementalUpgrade)
+		}
+		if options.IntervalSeconds != nil && *options.IntervalSeconds < 0 {
+			return fmt.Errorf("spec.incrementalUpgradeOptions.intervalSeconds must be a non-negative integer")
+		}
+		if len(options.TrafficSteps) > 0 {
+			prev := int32(0)
+			for _, step := range options.TrafficSteps {
+				if step <= prev || step > 100 {
+					return fmt.Errorf("spec.incrementalUpgradeOptions.trafficSteps must be strictly increasing percentages between 1 and 100")
+				}
+				prev = step
+			}
+			if prev != 100 {
+				return fmt.Errorf("spec.incrementalUpgradeOptions.trafficSteps must end with 100")
+			}
 		}
 	}
 	return nil
@@ -274,6 +341,53 @@ func (r *RayServiceReconciler) calculateStatus(ctx context.Context, rayServiceIn
 	return nil
 }
 
+// setRayServiceConditions sets the ServeApplicationsReady condition according to the Serve applications on the active
+// RayCluster, and the UpgradeInProgress condition according to whether a pending RayCluster is replacing the active one.
+func setRayServiceConditions(rayService *rayv1.RayService) {
+	activeServiceStatus := rayService.Status.ActiveServiceStatus
+	serveApplicationsReady := metav1.Condition{
+		Type:               string(rayv1.ServeApplicationsReady),
+		Status:             metav1.ConditionFalse,
+		Reason:             rayv1.NoActiveRayCluster,
+		Message:            "No active RayCluster is serving the Serve applications",
+		ObservedGeneration: rayService.Generation,
+	}
+	if activeServiceStatus.RayClusterName != "" {
+		notRunningApps := []string{}
+		for appName, appStatus := range activeServiceStatus.Applications {
+			if appStatus.Status != rayv1.ApplicationStatusEnum.RUNNING {
+				notRunningApps = append(notRunningApps, appName)
+			}
+		}
+		sort.Strings(notRunningApps)
+		if len(activeServiceStatus.Applications) > 0 && len(notRunningApps) == 0 {
+			serveApplicationsReady.Status = metav1.ConditionTrue
+			serveApplicationsReady.Reason = rayv1.AllServeApplicationsRunning
+			serveApplicationsReady.Message = fmt.Sprintf("All Serve applications on RayCluster %s are running", activeServiceStatus.RayClusterName)
+		} else {
+			serveApplicationsReady.Reason = rayv1.ServeApplicationsNotRunning
+			serveApplicationsReady.Message = fmt.Sprintf("Serve applications on RayCluster %s are not running: %v", activeServiceStatus.RayClusterName, notRunningApps)
+		}
+	}
+	meta.SetStatusCondition(&rayService.Status.Conditions, serveApplicationsReady)
+
+	// The first RayCluster of a RayService is also pending until its Serve applications are ready, but
+	// only replacing an active RayCluster is an upgrade.
+	pendingRayClusterName := rayService.Status.PendingServiceStatus.RayClusterName
+	upgradeInProgress := metav1.Condition{
+		Type:               string(rayv1.UpgradeInProgress),
+		Status:             metav1.ConditionFalse,
+		Reason:             rayv1.NoPendingRayCluster,
+		ObservedGeneration: rayService.Generation,
+	}
+	if pendingRayClusterName != "" && activeServiceStatus.RayClusterName != "" {
+		upgradeInProgress.Status = metav1.ConditionTrue
+		upgradeInProgress.Reason = rayv1.PendingRayClusterPreparing
+		upgradeInProgress.Message = fmt.Sprintf("Preparing RayCluster %s to replace RayCluster %s", pendingRayClusterName, activeServiceStatus.RayClusterName)
+	}
+	meta.SetStatusCondition(&rayService.Status.Conditions, upgradeInProgress)
+}
+
 // Checks whether the old and new RayServiceStatus are inconsistent by comparing different fields.
 // If the only difference between the old and new status is the HealthLastUpdateTime field,
 // the status update will not be triggered.
@@ -285,6 +399,20 @@ func (r *RayServiceReconciler) inconsistentRayServiceStatus(ctx context.Context,
 		return true
 	}
 
+	if !reflect.DeepEqual(oldStatus.TrafficRoutedPercent, newStatus.TrafficRoutedPercent) {
+		logger.Info("inconsistentRayServiceStatus RayService TrafficRoutedPercent changed", "oldTrafficRoutedPercent", oldStatus.TrafficRoutedPercent, "newTrafficRoutedPercent", newStatus.TrafficRoutedPercent)
+		return true
+	}
+
+	if !reflect.DeepEqual(oldStatus.TargetTrafficPercent, newStatus.TargetTrafficPercent) {
+		logger.Info("inconsistentRayServiceStatus RayService TargetTrafficPercent changed", "oldTargetTrafficPercent", oldStatus.TargetTrafficPercent, "newTargetTrafficPercent", newStatus.TargetTrafficPercent)
+		return true
+	}
+	if !reflect.DeepEqual(oldStatus.LastTrafficMigratedTime, newStatus.LastTrafficMigratedTime) {
+		logger.Info("inconsistentRayServiceStatus RayService LastTrafficMigratedTime changed", "oldLastTrafficMigratedTime", oldStatus.LastTrafficMigratedTime, "newLastTrafficMigratedTime", newStatus.LastTrafficMigratedTime)
+		return true
+	}
+
 	if len(oldStatus.Applications) != len(newStatus.Applications) {
 		return true
 	}
@@ -305,6 +433,15 @@ func (r *RayServiceReconciler) inconsistentRayServiceStatus(ctx context.Context,
 			return true
 		}
 
+		if oldAppStatus.RoutePrefix != newAppStatus.RoutePrefix {
+			logger.Info("inconsistentRayServiceStatus RayService application route prefix changed", "appName", appName, "oldRoutePrefix", oldAppStatus.RoutePrefix, "newRoutePrefix", newAppStatus.RoutePrefix)
+			return true
+		}
+		if !oldAppStatus.LastDeployedTime.Equal(newAppStatus.LastDeployedTime) {
+			logger.Info("inconsistentRayServiceStatus RayService application last deployed time changed", "appName", appName, "oldLastDeployedTime", oldAppStatus.LastDeployedTime, "newLastDeployedTime", newAppStatus.LastDeployedTime)
+			return true
+		}
+
 		if len(oldAppStatus.Deployments) != len(newAppStatus.Deployments) {
 			return true
 		}
@@ -323,6 +460,16 @@ func (r *RayServiceReconciler) inconsistentRayServiceStatus(ctx context.Context,
 				logger.Info("inconsistentRayServiceStatus RayService deployment status message changed", "oldDeploymentStatus", oldDeploymentStatus.Message, "newDeploymentStatus", newDeploymentStatus.Message)
 				return true
 			}
+
+			if !reflect.DeepEqual(oldDeploymentStatus.TargetReplicas, newDeploymentStatus.TargetReplicas) ||
+				!reflect.DeepEqual(oldDeploymentStatus.RunningReplicas, newDeploymentStatus.RunningReplicas) ||
+				!reflect.DeepEqual(oldDeploymentStatus.ReplicaStates, newDeploymentStatus.ReplicaStates) ||
+				!reflect.DeepEqual(oldDeploymentStatus.Autoscaling, newDeploymentStatus.Autoscaling) {
+				logger.Info("inconsistentRayServiceStatus RayService deployment replicas changed", "deploymentName", deploymentName, "appName", appName,
+					"oldTargetReplicas", oldDeploymentStatus.TargetReplicas, "newTargetReplicas", newDeploymentStatus.TargetReplicas,
+					"oldReplicaStates", oldDeploymentStatus.ReplicaStates, "newReplicaStates", newDeploymentStatus.ReplicaStates)
+				return true
+			}
 		}
 	}
 
@@ -342,6 +489,21 @@ func (r *RayServiceReconciler) inconsistentRayServiceStatuses(ctx context.Contex
 		return true
 	}
 
+	if oldStatus.LastFailedSpec != newStatus.LastFailedSpec {
+		logger.Info("inconsistentRayServiceStatus RayService LastFailedSpec changed", "oldLastFailedSpec", oldStatus.LastFailedSpec, "newLastFailedSpec", newStatus.LastFailedSpec)
+		return true
+	}
+
+	if !reflect.DeepEqual(oldStatus.RayClusterDeletionTimestamps, newStatus.RayClusterDeletionTimestamps) {
+		logger.Info("inconsistentRayServiceStatus RayService RayClusterDeletionTimestamps changed", "oldRayClusterDeletionTimestamps", oldStatus.RayClusterDeletionTimestamps, "newRayClusterDeletionTimestamps", newStatus.RayClusterDeletionTimestamps)
+		return true
+	}
+
+	if oldStatus.PreviousActiveRayClusterName != newStatus.PreviousActiveRayClusterName {
+		logger.Info("inconsistentRayServiceStatus RayService PreviousActiveRayClusterName changed", "oldPreviousActiveRayClusterName", oldStatus.PreviousActiveRayClusterName, "newPreviousActiveRayClusterName", newStatus.PreviousActiveRayClusterName)
+		return true
+	}
+
 	if r.inconsistentRayServiceStatus(ctx, oldStatus.ActiveServiceStatus, newStatus.ActiveServiceStatus) {
 		logger.Info("inconsistentRayServiceStatus RayService ActiveServiceStatus changed")
 		return true
@@ -352,6 +514,11 @@ func (r *RayServiceReconciler) inconsistentRayServiceStatuses(ctx context.Contex
 		return true
 	}
 
+	if !reflect.DeepEqual(oldStatus.Conditions, newStatus.Conditions) {
+		logger.Info("inconsistentRayServiceStatus RayService Conditions changed")
+		return true
+	}
+
 	return false
 }
 
@@ -366,6 +533,8 @@ func (r *RayServiceReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcu
 		Owns(&rayv1.RayCluster{}).
 		Owns(&corev1.Service{}).
 		Owns(&networkingv1.Ingress{}).
+		// Only the metadata of the ConfigMaps is cached. The Serve config is read from the API server.
+		WatchesMetadata(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.rayServicesForConfigMap)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: reconcileConcurrency,
 			LogConstructor: func(request *reconcile.Request) logr.Logger {
@@ -379,6 +548,29 @@ func (r *RayServiceReconciler) SetupWithManager(mgr ctrl.Manager, reconcileConcu
 		Complete(r)
 }
 
+// rayServicesForConfigMap maps a ConfigMap to the RayServices that read their Serve config from it.
+func (r *RayServiceReconciler) rayServicesForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	rayServiceList := rayv1.RayServiceList{}
+	if err := r.List(ctx, &rayServiceList, client.InNamespace(obj.GetNamespace()), client.MatchingFields{rayServiceServeConfigMapIndexField: obj.GetName()}); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to list RayServices for ConfigMap", "ConfigMap", client.ObjectKeyFromObject(obj))
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, rayService := range rayServiceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKey{Namespace: rayService.Namespace, Name: rayService.Name}})
+	}
+	return requests
+}
+
+// rayServiceServeConfigMapName indexes a RayService by the name of the ConfigMap that it reads its Serve config from.
+func rayServiceServeConfigMapName(obj client.Object) []string {
+	rayService := obj.(*rayv1.RayService)
+	if selector := rayService.Spec.ServeConfigV2From; selector != nil {
+		return []string{selector.Name}
+	}
+	return nil
+}
+
 func (r *RayServiceReconciler) getRayServiceInstance(ctx context.Context, request ctrl.Request) (*rayv1.RayService, error) {
 	logger := ctrl.LoggerFrom(ctx)
 	rayServiceInstance := &rayv1.RayService{}
@@ -438,18 +630,23 @@ func (r *RayServiceReconciler) reconcileRayCluster(ctx context.Context, rayServi
 			enableZeroDowntime = strings.ToLower(zeroDowntimeEnvVar) != "false"
 		}
 		if rayServiceSpecUpgradeStrategy != nil {
-			enableZeroDowntime = *rayServiceSpecUpgradeStrategy == rayv1.NewCluster
+			enableZeroDowntime = *rayServiceSpecUpgradeStrategy == rayv1.NewCluster || *rayServiceSpecUpgradeStrategy == rayv1.IncrementalUpgrade
 		}
 
 		if enableZeroDowntime || !enableZeroDowntime && activeRayCluster == nil {
 			// Add a pending cluster name. In the next reconcile loop, shouldPrepareNewRayCluster will return DoNothing and we will
 			// actually create the pending RayCluster instance.
 			r.markRestartAndAddPendingClusterName(ctx, rayServiceInstance)
+			if activeRayCluster != nil {
+				r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.PreparingRayCluster),
+					"Preparing RayCluster %s/%s to replace the active RayCluster %s/%s", rayServiceInstance.Namespace,
+					rayServiceInstance.Status.PendingServiceStatus.RayClusterName, activeRayCluster.Namespace, activeRayCluster.Name)
+			}
 		} else {
 			logger.Info("Zero-downtime upgrade is disabled (ENABLE_ZERO_DOWNTIME: false). Skip preparing a new RayCluster.")
 		}
 		return activeRayCluster, nil, nil
-	} else if clusterAction == Update {
+	} else if clusterAction == Update || clusterAction == UpdateWorkerGroups {
 		// Update the active cluster.
 		logger.Info("Updating the active RayCluster instance.")
 		if activeRayCluster, err = r.constructRayClusterForRayService(ctx, rayServiceInstance, activeRayCluster.Name); err != nil {
@@ -458,6 +655,10 @@ func (r *RayServiceReconciler) reconcileRayCluster(ctx context.Context, rayServi
 		if err := r.updateRayClusterInstance(ctx, activeRayCluster); err != nil {
 			return nil, nil, err
 		}
+		if clusterAction == UpdateWorkerGroups {
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.UpdatedWorkerGroups),
+				"Updated the worker groups of the active RayCluster %s/%s in place", activeRayCluster.Namespace, activeRayCluster.Name)
+		}
 		return activeRayCluster, nil, nil
 	}
 
@@ -480,12 +681,19 @@ func (r *RayServiceReconciler) cleanUpRayClusterInstance(ctx context.Context, ra
 
 	// Clean up RayCluster instances. Each instance is deleted 60 seconds
 	// after becoming inactive to give the ingress time to update.
+	// The deletion timestamps are recorded in the RayService status, which `Reconcile` persists, so that they survive
+	// operator restarts.
+	danglingRayClusterNames := make(map[string]bool)
 	for _, rayClusterInstance := range rayClusterList.Items {
 		if rayClusterInstance.Name != rayServiceInstance.Status.ActiveServiceStatus.RayClusterName && rayClusterInstance.Name != rayServiceInstance.Status.PendingServiceStatus.RayClusterName {
-			cachedTimestamp, exists := r.RayClusterDeletionTimestamps.Get(rayClusterInstance.Name)
+			danglingRayClusterNames[rayClusterInstance.Name] = true
+			scheduledTimestamp, exists := rayServiceInstance.Status.RayClusterDeletionTimestamps[rayClusterInstance.Name]
 			if !exists {
-				deletionTimestamp := metav1.Now().Add(RayClusterDeletionDelayDuration)
-				r.RayClusterDeletionTimestamps.Set(rayClusterInstance.Name, deletionTimestamp)
+				deletionTimestamp := metav1.NewTime(time.Now().Add(RayClusterDeletionDelayDuration))
+				if rayServiceInstance.Status.RayClusterDeletionTimestamps == nil {
+					rayServiceInstance.Status.RayClusterDeletionTimestamps = make(map[string]metav1.Time)
+				}
+				rayServiceInstance.Status.RayClusterDeletionTimestamps[rayClusterInstance.Name] = deletionTimestamp
 				logger.Info(
 					"Scheduled dangling RayCluster for deletion",
 					"rayClusterName", rayClusterInstance.Name,
@@ -493,10 +701,10 @@ func (r *RayServiceReconciler) cleanUpRayClusterInstance(ctx context.Context, ra
 				)
 			} else {
 				reasonForDeletion := ""
-				if time.Since(cachedTimestamp) > 0*time.Second {
+				if time.Since(scheduledTimestamp.Time) > 0*time.Second {
 					reasonForDeletion = fmt.Sprintf("Deletion timestamp %s "+
 						"for RayCluster %s has passed. Deleting cluster "+
-						"immediately.", cachedTimestamp, rayClusterInstance.Name)
+						"immediately.", scheduledTimestamp, rayClusterInstance.Name)
 				}
 
 				if reasonForDeletion != "" {
@@ -509,6 +717,28 @@ func (r *RayServiceReconciler) cleanUpRayClusterInstance(ctx context.Context, ra
 		}
 	}
 
+	// Forget the RayClusters that have been deleted or have become active or pending again.
+	for name := range rayServiceInstance.Status.RayClusterDeletionTimestamps {
+		if !danglingRayClusterNames[name] {
+			delete(rayServiceInstance.Status.RayClusterDeletionTimestamps, name)
+		}
+	}
+	return nil
+}
+
+// updateRayClusterDeletionTimestamps persists the RayCluster deletion schedule recorded by `cleanUpRayClusterInstance` when
+// `Reconcile` returns before it updates the RayService status. Only `RayClusterDeletionTimestamps` is patched, so the other
+// status fields that were computed before the failure aren't written.
+func (r *RayServiceReconciler) updateRayClusterDeletionTimestamps(ctx context.Context, originalRayServiceInstance *rayv1.RayService, rayServiceInstance *rayv1.RayService) error {
+	if reflect.DeepEqual(originalRayServiceInstance.Status.RayClusterDeletionTimestamps, rayServiceInstance.Status.RayClusterDeletionTimestamps) {
+		return nil
+	}
+	updatedRayServiceInstance := originalRayServiceInstance.DeepCopy()
+	updatedRayServiceInstance.Status.RayClusterDeletionTimestamps = rayServiceInstance.Status.RayClusterDeletionTimestamps
+	if err := r.Status().Patch(ctx, updatedRayServiceInstance, client.MergeFrom(originalRayServiceInstance)); err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "Failed to update the RayCluster deletion schedule of RayService", "rayServiceInstance", rayServiceInstance)
+		return err
+	}
 	return nil
 }
 
@@ -526,33 +756,13 @@ func (r *RayServiceReconciler) getRayClusterByNamespacedName(ctx context.Context
 	return rayCluster, nil
 }
 
-// cleanUpServeConfigCache cleans up the unused serve deployments config in the cached map.
-func (r *RayServiceReconciler) cleanUpServeConfigCache(ctx context.Context, rayServiceInstance *rayv1.RayService) {
-	logger := ctrl.LoggerFrom(ctx)
-	activeConfigKey := r.generateConfigKey(rayServiceInstance, rayServiceInstance.Status.ActiveServiceStatus.RayClusterName)
-	pendingConfigKey := r.generateConfigKey(rayServiceInstance, rayServiceInstance.Status.PendingServiceStatus.RayClusterName)
-	configPrefix := r.generateConfigKeyPrefix(rayServiceInstance)
-
-	// Clean up RayCluster serve deployment configs.
-	for key := range r.ServeConfigs.Items() {
-		if key == activeConfigKey || key == pendingConfigKey {
-			continue
-		}
-		if !strings.HasPrefix(key, configPrefix) {
-			// Skip configs owned by other RayService Instance.
-			continue
-		}
-		logger.Info("cleanUpServeConfigCache", "activeConfigKey", activeConfigKey, "pendingConfigKey", pendingConfigKey, "remove key", key)
-		r.ServeConfigs.Remove(key)
-	}
-}
-
 type ClusterAction int
 
 const (
-	DoNothing  ClusterAction = iota // value 0
-	Update                          // value 1
-	RolloutNew                      // value 2
+	DoNothing          ClusterAction = iota // value 0
+	Update                                  // value 1
+	RolloutNew                              // value 2
+	UpdateWorkerGroups                      // value 3
 )
 
 // shouldPrepareNewRayCluster checks if we need to generate a new pending cluster.
@@ -591,6 +801,12 @@ func (r *RayServiceReconciler) shouldPrepareNewRayCluster(ctx context.Context, r
 			return DoNothing
 		}
 
+		// Don't retry an upgrade that was rolled back until the RayCluster config changes again.
+		if goalClusterHash == rayServiceInstance.Status.LastFailedSpec {
+			logger.Info("The upgrade to the goal config was rolled back. Skip preparing a new RayCluster.", "lastFailedSpec", rayServiceInstance.Status.LastFailedSpec)
+			return DoNothing
+		}
+
 		// Case 3: Otherwise, if everything is identical except for the Replicas and WorkersToDelete of
 		// the existing workergroups, and one or more new workergroups are added at the end, then update the cluster.
 		activeClusterNumWorkerGroups, err := strconv.Atoi(activeRayCluster.ObjectMeta.Annotations[utils.NumWorkerGroupsKey])
@@ -619,7 +835,22 @@ func (r *RayServiceReconciler) shouldPrepareNewRayCluster(ctx context.Context, r
 			}
 		}
 
-		// Case 4: Otherwise, rollout a new cluster.
+		// Case 4: If the InPlace worker group upgrade strategy is used and only the worker groups have changed,
+		// update the cluster. The RayCluster replaces its outdated worker Pods based on its upgrade strategy.
+		if isInPlaceWorkerGroupUpgradeEnabled(&rayServiceInstance.Spec) {
+			activeClusterHashWithoutWorkerGroups := activeRayCluster.ObjectMeta.Annotations[utils.HashWithoutWorkerGroupsKey]
+			goalClusterHashWithoutWorkerGroups, err := generateHashWithoutWorkerGroups(rayServiceInstance.Spec.RayClusterSpec)
+			if err != nil {
+				logger.Error(err, errContextFailedToSerialize)
+				return DoNothing
+			}
+			if activeClusterHashWithoutWorkerGroups == goalClusterHashWithoutWorkerGroups {
+				logger.Info("Active RayCluster config matches goal config, except for WorkerGroupSpecs. Updating the worker groups of the RayCluster in place.")
+				return UpdateWorkerGroups
+			}
+		}
+
+		// Case 5: Otherwise, rollout a new cluster.
 		logger.Info(
 			"Active RayCluster config doesn't match goal config. "+
 				"RayService operator should prepare a new Ray cluster.",
@@ -697,9 +928,14 @@ func (r *RayServiceReconciler) updateRayClusterInstance(ctx context.Context, ray
 	// Update the fetched RayCluster with new changes
 	currentRayCluster.Spec = rayClusterInstance.Spec
 
-	// Update the labels and annotations
+	// Update the labels and annotations. Keep the hash of the applied Serve config since the Serve applications
+	// are not affected by the update.
+	serveConfigHash, hasServeConfigHash := currentRayCluster.Annotations[utils.ServeConfigHashAnnotationKey]
 	currentRayCluster.Labels = rayClusterInstance.Labels
 	currentRayCluster.Annotations = rayClusterInstance.Annotations
+	if hasServeConfigHash {
+		currentRayCluster.Annotations[utils.ServeConfigHashAnnotationKey] = serveConfigHash
+	}
 
 	// Update the RayCluster
 	if err = r.Update(ctx, currentRayCluster); err != nil {
@@ -776,6 +1012,11 @@ func (r *RayServiceReconciler) constructRayClusterForRayService(ctx context.Cont
 		logger.Error(err, errContext)
 		return nil, err
 	}
+	rayClusterAnnotations[utils.HashWithoutWorkerGroupsKey], err = generateHashWithoutWorkerGroups(rayService.Spec.RayClusterSpec)
+	if err != nil {
+		logger.Error(err, errContext)
+		return nil, err
+	}
 	rayClusterAnnotations[utils.NumWorkerGroupsKey] = strconv.Itoa(len(rayService.Spec.RayClusterSpec.WorkerGroupSpecs))
 
 	// set the KubeRay version used to create the RayCluster
@@ -799,20 +1040,18 @@ func (r *RayServiceReconciler) constructRayClusterForRayService(ctx context.Cont
 	return rayCluster, nil
 }
 
-func (r *RayServiceReconciler) checkIfNeedSubmitServeDeployment(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serveStatus *rayv1.RayServiceStatus) bool {
+func (r *RayServiceReconciler) checkIfNeedSubmitServeDeployment(ctx context.Context, serveConfigV2 string, rayClusterInstance *rayv1.RayCluster, serveStatus *rayv1.RayServiceStatus) bool {
 	logger := ctrl.LoggerFrom(ctx)
 
-	// If the Serve config has not been cached, update the Serve config.
-	cacheKey := r.generateConfigKey(rayServiceInstance, rayClusterInstance.Name)
-	cachedServeConfigV2, exist := r.ServeConfigs.Get(cacheKey)
+	// If no Serve config has been applied to the RayCluster, update the Serve config.
+	appliedServeConfigHash, exist := rayClusterInstance.Annotations[utils.ServeConfigHashAnnotationKey]
 
 	if !exist {
 		logger.Info(
 			"shouldUpdate",
 			"shouldUpdateServe", true,
-			"reason", "Nothing has been cached for the cluster with the key",
+			"reason", "No Serve config has been applied to the cluster",
 			"rayClusterName", rayClusterInstance.Name,
-			"cacheKey", cacheKey,
 		)
 		return true
 	}
@@ -831,26 +1070,31 @@ func (r *RayServiceReconciler) checkIfNeedSubmitServeDeployment(ctx context.Cont
 		return true
 	}
 
-	// If the Serve config has been cached, check if it needs to be updated.
+	// If a Serve config has been applied, check if it needs to be updated.
 	shouldUpdate := false
-	reason := fmt.Sprintf("Current Serve config matches cached Serve config, "+
+	reason := fmt.Sprintf("Current Serve config matches the applied Serve config, "+
 		"and some deployments have been deployed for cluster %s", rayClusterInstance.Name)
 
-	if cachedServeConfigV2 != rayServiceInstance.Spec.ServeConfigV2 {
+	serveConfigHash, err := utils.GenerateJsonHash(serveConfigV2)
+	if err != nil {
+		logger.Error(err, "Failed to hash the Serve config")
+		return true
+	}
+	if appliedServeConfigHash != serveConfigHash {
 		shouldUpdate = true
-		reason = fmt.Sprintf("Current V2 Serve config doesn't match cached Serve config for cluster %s with key %s", rayClusterInstance.Name, cacheKey)
+		reason = fmt.Sprintf("Current V2 Serve config doesn't match the applied Serve config for cluster %s", rayClusterInstance.Name)
 	}
-	logger.Info("shouldUpdate", "shouldUpdateServe", shouldUpdate, "reason", reason, "cachedServeConfig", cachedServeConfigV2, "current Serve config", rayServiceInstance.Spec.ServeConfigV2)
+	logger.Info("shouldUpdate", "shouldUpdateServe", shouldUpdate, "reason", reason, "appliedServeConfigHash", appliedServeConfigHash, "current Serve config", serveConfigV2)
 
 	return shouldUpdate
 }
 
-func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, rayServiceInstance *rayv1.RayService, rayDashboardClient utils.RayDashboardClientInterface, clusterName string) error {
+func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, serveConfigV2 string, rayDashboardClient utils.RayDashboardClientInterface, rayClusterInstance *rayv1.RayCluster) error {
 	logger := ctrl.LoggerFrom(ctx)
-	logger.Info("updateServeDeployment", "V2 config", rayServiceInstance.Spec.ServeConfigV2)
+	logger.Info("updateServeDeployment", "V2 config", serveConfigV2)
 
 	serveConfig := make(map[string]interface{})
-	if err := yaml.Unmarshal([]byte(rayServiceInstance.Spec.ServeConfigV2), &serveConfig); err != nil {
+	if err := yaml.Unmarshal([]byte(serveConfigV2), &serveConfig); err != nil {
 		return err
 	}
 
@@ -868,12 +1112,40 @@ func (r *RayServiceReconciler) updateServeDeployment(ctx context.Context, raySer
 		return err
 	}
 
-	cacheKey := r.generateConfigKey(rayServiceInstance, clusterName)
-	r.ServeConfigs.Set(cacheKey, rayServiceInstance.Spec.ServeConfigV2)
-	logger.Info("updateServeDeployment", "message", "Cached Serve config for Ray cluster with the key", "rayClusterName", clusterName, "cacheKey", cacheKey)
+	// Record the hash of the applied Serve config on the RayCluster so that it isn't reapplied after the operator restarts.
+	serveConfigHash, err := utils.GenerateJsonHash(serveConfigV2)
+	if err != nil {
+		return err
+	}
+	patch := client.MergeFrom(rayClusterInstance.DeepCopy())
+	if rayClusterInstance.Annotations == nil {
+		rayClusterInstance.Annotations = make(map[string]string)
+	}
+	rayClusterInstance.Annotations[utils.ServeConfigHashAnnotationKey] = serveConfigHash
+	if err := r.Patch(ctx, rayClusterInstance, patch); err != nil {
+		return err
+	}
+	logger.Info("updateServeDeployment", "message", "Recorded the applied Serve config on the Ray cluster", "rayClusterName", rayClusterInstance.Name, "serveConfigHash", serveConfigHash)
 	return nil
 }
 
+// getServeConfigV2 returns the Serve config of the RayService, which is either inlined in the spec or read from the
+// referenced ConfigMap. An empty Serve config in the ConfigMap is an error, so that the Serve applications applied
+// before aren't deleted.
+func (r *RayServiceReconciler) getServeConfigV2(ctx context.Context, rayServiceInstance *rayv1.RayService) (string, error) {
+	if selector := rayServiceInstance.Spec.ServeConfigV2From; selector != nil {
+		serveConfigV2, err := utils.GetConfigMapKeyValue(ctx, r.Client, rayServiceInstance.Namespace, selector)
+		if err != nil {
+			return "", fmt.Errorf("failed to get the Serve config from ConfigMap %s/%s: %w", rayServiceInstance.Namespace, selector.Name, err)
+		}
+		if strings.TrimSpace(serveConfigV2) == "" {
+			return "", fmt.Errorf("the Serve config in key %s of ConfigMap %s/%s is empty", selector.Key, rayServiceInstance.Namespace, selector.Name)
+		}
+		return serveConfigV2, nil
+	}
+	return rayServiceInstance.Spec.ServeConfigV2, nil
+}
+
 // `getAndCheckServeStatus` gets Serve applications' and deployments' statuses and check whether the
 // Serve applications are ready to serve incoming traffic or not. It returns two values:
 //
@@ -908,9 +1180,15 @@ func (r *RayServiceReconciler) getAndCheckServeStatus(ctx context.Context, dashb
 		applicationStatus := rayv1.AppStatus{
 			Message:              app.Message,
 			Status:               app.Status,
+			RoutePrefix:          app.RoutePrefix,
 			HealthLastUpdateTime: &timeNow,
 			Deployments:          make(map[string]rayv1.ServeDeploymentStatus),
 		}
+		if app.LastDeployedTimeS > 0 {
+			// Truncate to seconds, the precision of `metav1.Time` once it is serialized, so that the status is stable across reconciliations.
+			lastDeployedTime := metav1.NewTime(time.Unix(int64(app.LastDeployedTimeS), 0))
+			applicationStatus.LastDeployedTime = &lastDeployedTime
+		}
 
 		if isServeAppUnhealthyOrDeployedFailed(app.Status) {
 			if isServeAppUnhealthyOrDeployedFailed(prevApplicationStatus.Status) {
@@ -936,6 +1214,22 @@ func (r *RayServiceReconciler) getAndCheckServeStatus(ctx context.Context, dashb
 				Status:               deployment.Status,
 				Message:              deployment.Message,
 				HealthLastUpdateTime: &timeNow,
+				TargetReplicas:       deployment.TargetNumReplicas,
+			}
+			// Older Ray versions don't report replicas, so leave the counts unset rather than reporting 0.
+			if deployment.Replicas != nil {
+				replicaStates := make(map[string]int32)
+				for _, replica := range deployment.Replicas {
+					replicaStates[replica.State]++
+				}
+				deploymentStatus.ReplicaStates = replicaStates
+				deploymentStatus.RunningReplicas = ptr.To(replicaStates[utils.ServeReplicaStateRunning])
+			}
+			if autoscalingConfig := deployment.DeploymentConfig.AutoscalingConfig; autoscalingConfig != nil {
+				deploymentStatus.Autoscaling = &rayv1.ServeDeploymentAutoscalingStatus{
+					MinReplicas: autoscalingConfig.MinReplicas,
+					MaxReplicas: autoscalingConfig.MaxReplicas,
+				}
 			}
 
 			if deployment.Status == rayv1.DeploymentStatusEnum.UNHEALTHY {
@@ -960,14 +1254,6 @@ func (r *RayServiceReconciler) getAndCheckServeStatus(ctx context.Context, dashb
 	return isReady, nil
 }
 
-func (r *RayServiceReconciler) generateConfigKey(rayServiceInstance *rayv1.RayService, clusterName string) string {
-	return r.generateConfigKeyPrefix(rayServiceInstance) + clusterName
-}
-
-func (r *RayServiceReconciler) generateConfigKeyPrefix(rayServiceInstance *rayv1.RayService) string {
-	return rayServiceInstance.Namespace + "/" + rayServiceInstance.Name + "/"
-}
-
 func (r *RayServiceReconciler) markRestartAndAddPendingClusterName(ctx context.Context, rayServiceInstance *rayv1.RayService) {
 	logger := ctrl.LoggerFrom(ctx)
 
@@ -983,11 +1269,138 @@ func (r *RayServiceReconciler) updateRayClusterInfo(ctx context.Context, rayServ
 	logger := ctrl.LoggerFrom(ctx)
 	logger.Info("updateRayClusterInfo", "ActiveRayClusterName", rayServiceInstance.Status.ActiveServiceStatus.RayClusterName, "healthyClusterName", healthyClusterName)
 	if rayServiceInstance.Status.ActiveServiceStatus.RayClusterName != healthyClusterName {
+		rayServiceInstance.Status.PreviousActiveRayClusterName = rayServiceInstance.Status.ActiveServiceStatus.RayClusterName
 		rayServiceInstance.Status.ActiveServiceStatus = rayServiceInstance.Status.PendingServiceStatus
 		rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
 	}
 }
 
+// isUpgradeTimedOut returns whether the pending RayCluster has exceeded `UpgradeTimeoutSeconds` without becoming ready.
+func isUpgradeTimedOut(rayServiceInstance *rayv1.RayService, pendingRayClusterInstance *rayv1.RayCluster, now time.Time) bool {
+	timeoutSeconds := rayServiceInstance.Spec.UpgradeTimeoutSeconds
+	if timeoutSeconds == nil {
+		return false
+	}
+	return now.After(pendingRayClusterInstance.CreationTimestamp.Add(time.Duration(*timeoutSeconds) * time.Second))
+}
+
+// rollBackUpgrade abandons the pending RayCluster of a timed out upgrade and records its spec in `LastFailedSpec` so
+// that the same upgrade isn't retried. The abandoned RayCluster is deleted by `cleanUpRayClusterInstance`.
+func (r *RayServiceReconciler) rollBackUpgrade(ctx context.Context, rayServiceInstance *rayv1.RayService, pendingRayClusterInstance *rayv1.RayCluster) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	logger.Info("The upgrade timed out. Rolling back to the active RayCluster.", "pendingRayCluster", pendingRayClusterInstance.Name)
+
+	if utils.IsIncrementalUpgradeEnabled(&rayServiceInstance.Spec) {
+		if err := r.labelServingPodsForTraffic(ctx, rayServiceInstance, pendingRayClusterInstance, 0); err != nil {
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+	}
+
+	rayServiceInstance.Status.LastFailedSpec = pendingRayClusterInstance.Annotations[utils.HashWithoutReplicasAndWorkersToDeleteKey]
+	rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
+	if err := r.Status().Update(ctx, rayServiceInstance); err != nil {
+		logger.Error(err, "Failed to update RayService status after rolling back the upgrade")
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+	}
+	r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.UpgradeRolledBack),
+		"Rolled back the upgrade because the Serve applications on the pending RayCluster %s/%s weren't ready within %d seconds",
+		pendingRayClusterInstance.Namespace, pendingRayClusterInstance.Name, *rayServiceInstance.Spec.UpgradeTimeoutSeconds)
+	return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
+}
+
+// rollBackToPreviousRayCluster handles the `ray.io/rollback` annotation. It makes the RayCluster that was active before
+// the last upgrade active again if it hasn't been deleted yet, records the spec of the current active RayCluster in
+// `LastFailedSpec`, and removes the annotation. The rollback is refused if the Serve config in the RayService spec
+// differs from the one applied to the previous RayCluster, because reapplying it could bring a bad Serve config to
+// the restored RayCluster.
+func (r *RayServiceReconciler) rollBackToPreviousRayCluster(ctx context.Context, rayServiceInstance *rayv1.RayService) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+	previousRayClusterName := rayServiceInstance.Status.PreviousActiveRayClusterName
+
+	previousRayClusterExists := false
+	previousRayCluster := &rayv1.RayCluster{}
+	if previousRayClusterName != "" {
+		err := r.Get(ctx, client.ObjectKey{Name: previousRayClusterName, Namespace: rayServiceInstance.Namespace}, previousRayCluster)
+		if client.IgnoreNotFound(err) != nil {
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		previousRayClusterExists = err == nil && previousRayCluster.DeletionTimestamp == nil
+	}
+
+	serveConfigChanged := false
+	if previousRayClusterExists {
+		serveConfigV2, err := r.getServeConfigV2(ctx, rayServiceInstance)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		serveConfigHash, err := utils.GenerateJsonHash(serveConfigV2)
+		if err != nil {
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		serveConfigChanged = previousRayCluster.Annotations[utils.ServeConfigHashAnnotationKey] != serveConfigHash
+	}
+
+	switch {
+	case !previousRayClusterExists:
+		logger.Info("The previous active RayCluster doesn't exist anymore. Skip rolling back.", "previousRayCluster", previousRayClusterName)
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToRollBack),
+			"Failed to roll back RayService %s/%s because the previous active RayCluster %q doesn't exist anymore",
+			rayServiceInstance.Namespace, rayServiceInstance.Name, previousRayClusterName)
+	case serveConfigChanged:
+		logger.Info("The Serve config has changed since the previous active RayCluster was active. Skip rolling back.", "previousRayCluster", previousRayClusterName)
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.FailedToRollBack),
+			"Failed to roll back RayService %s/%s because the Serve config differs from the one applied to the previous active RayCluster %q. "+
+				"Revert the Serve config and add the rollback annotation again",
+			rayServiceInstance.Namespace, rayServiceInstance.Name, previousRayClusterName)
+	default:
+		var activeRayCluster *rayv1.RayCluster
+		if activeName := rayServiceInstance.Status.ActiveServiceStatus.RayClusterName; activeName != "" {
+			activeRayCluster = &rayv1.RayCluster{}
+			if err := r.Get(ctx, client.ObjectKey{Name: activeName, Namespace: rayServiceInstance.Namespace}, activeRayCluster); err != nil {
+				if !errors.IsNotFound(err) {
+					return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+				}
+				activeRayCluster = nil
+			}
+		}
+		if utils.IsIncrementalUpgradeEnabled(&rayServiceInstance.Spec) {
+			// Route the traffic to the previous RayCluster before removing it from the current one.
+			if err := r.labelServingPodsForTraffic(ctx, rayServiceInstance, previousRayCluster, -1); err != nil {
+				return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+			}
+			if activeRayCluster != nil {
+				if err := r.labelServingPodsForTraffic(ctx, rayServiceInstance, activeRayCluster, 0); err != nil {
+					return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+				}
+			}
+		}
+
+		logger.Info("Rolling back to the previous active RayCluster", "previousRayCluster", previousRayClusterName)
+		if activeRayCluster != nil {
+			rayServiceInstance.Status.LastFailedSpec = activeRayCluster.Annotations[utils.HashWithoutReplicasAndWorkersToDeleteKey]
+		}
+		rayServiceInstance.Status.ActiveServiceStatus = rayv1.RayServiceStatus{RayClusterName: previousRayClusterName}
+		rayServiceInstance.Status.PendingServiceStatus = rayv1.RayServiceStatus{}
+		rayServiceInstance.Status.PreviousActiveRayClusterName = ""
+		// The previous RayCluster was scheduled for deletion when it became inactive.
+		delete(rayServiceInstance.Status.RayClusterDeletionTimestamps, previousRayClusterName)
+		if err := r.Status().Update(ctx, rayServiceInstance); err != nil {
+			logger.Error(err, "Failed to update RayService status after rolling back to the previous active RayCluster")
+			return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+		}
+		r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.UpgradeRolledBack),
+			"Rolled back RayService %s/%s to the previous active RayCluster %s",
+			rayServiceInstance.Namespace, rayServiceInstance.Name, previousRayClusterName)
+	}
+
+	delete(rayServiceInstance.Annotations, utils.RayServiceRollbackAnnotationKey)
+	if err := r.Update(ctx, rayServiceInstance); err != nil {
+		logger.Error(err, "Failed to remove the rollback annotation from RayService")
+		return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, err
+	}
+	return ctrl.Result{RequeueAfter: ServiceDefaultRequeueDuration}, nil
+}
+
 func (r *RayServiceReconciler) reconcileServices(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, serviceType utils.ServiceType) error {
 	logger := ctrl.LoggerFrom(ctx)
 	logger.Info(
@@ -1131,9 +1544,14 @@ func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceIns
 		return false, err
 	}
 
-	shouldUpdate := r.checkIfNeedSubmitServeDeployment(ctx, rayServiceInstance, rayClusterInstance, rayServiceStatus)
+	serveConfigV2, err := r.getServeConfigV2(ctx, rayServiceInstance)
+	if err != nil {
+		err = r.updateState(ctx, rayServiceInstance, rayv1.WaitForServeDeploymentReady, err)
+		return false, err
+	}
+	shouldUpdate := r.checkIfNeedSubmitServeDeployment(ctx, serveConfigV2, rayClusterInstance, rayServiceStatus)
 	if shouldUpdate {
-		if err = r.updateServeDeployment(ctx, rayServiceInstance, rayDashboardClient, rayClusterInstance.Name); err != nil {
+		if err = r.updateServeDeployment(ctx, serveConfigV2, rayDashboardClient, rayClusterInstance); err != nil {
 			err = r.updateState(ctx, rayServiceInstance, rayv1.WaitForServeDeploymentReady, err)
 			return false, err
 		}
@@ -1149,7 +1567,11 @@ func (r *RayServiceReconciler) reconcileServe(ctx context.Context, rayServiceIns
 
 	if isReady {
 		rayServiceInstance.Status.ServiceStatus = rayv1.Running
-		r.updateRayClusterInfo(ctx, rayServiceInstance, rayClusterInstance.Name)
+		// With the IncrementalUpgrade strategy, the pending RayCluster only becomes active once all the traffic is
+		// shifted to it in `reconcileIncrementalUpgrade`.
+		if isActive || rayServiceInstance.Status.ActiveServiceStatus.RayClusterName == "" || !utils.IsIncrementalUpgradeEnabled(&rayServiceInstance.Spec) {
+			r.updateRayClusterInfo(ctx, rayServiceInstance, rayClusterInstance.Name)
+		}
 	} else {
 		rayServiceInstance.Status.ServiceStatus = rayv1.WaitForServeDeploymentReady
 		if err := r.Status().Update(ctx, rayServiceInstance); err != nil {
@@ -1202,6 +1624,231 @@ func (r *RayServiceReconciler) labelHeadPodForServeStatus(ctx context.Context, r
 	return nil
 }
 
+// reconcileIncrementalUpgrade reconciles the Kubernetes services of a RayService with the IncrementalUpgrade strategy.
+// If only one RayCluster exists, all its serving Pods receive the traffic. If the pending RayCluster is ready, the
+// traffic is shifted to it step by step, and it becomes active once it receives all the traffic.
+func (r *RayServiceReconciler) reconcileIncrementalUpgrade(ctx context.Context, rayServiceInstance *rayv1.RayService, activeRayClusterInstance *rayv1.RayCluster, pendingRayClusterInstance *rayv1.RayCluster) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	if activeRayClusterInstance == nil || pendingRayClusterInstance == nil {
+		rayClusterInstance := activeRayClusterInstance
+		if pendingRayClusterInstance != nil {
+			rayClusterInstance = pendingRayClusterInstance
+		}
+		if rayClusterInstance == nil {
+			logger.Info("No Ray cluster found. Skipping ingress and service reconciliation.")
+			return nil
+		}
+		if err := r.reconcileServices(ctx, rayServiceInstance, rayClusterInstance, utils.HeadService); err != nil {
+			return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
+		}
+		if err := r.labelHeadPodForServeStatus(ctx, rayClusterInstance); err != nil {
+			return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
+		}
+		if err := r.labelServingPodsForTraffic(ctx, rayServiceInstance, rayClusterInstance, -1); err != nil {
+			return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
+		}
+		if err := r.reconcileServices(ctx, rayServiceInstance, rayClusterInstance, utils.ServingService); err != nil {
+			return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
+		}
+		rayServiceInstance.Status.ActiveServiceStatus.TrafficRoutedPercent = ptr.To[int32](100)
+		rayServiceInstance.Status.ActiveServiceStatus.TargetTrafficPercent = ptr.To[int32](100)
+		return nil
+	}
+
+	// The head service keeps pointing to the active RayCluster until the pending RayCluster becomes active.
+	if err := r.reconcileServices(ctx, rayServiceInstance, activeRayClusterInstance, utils.HeadService); err != nil {
+		return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
+	}
+	for _, rayClusterInstance := range []*rayv1.RayCluster{activeRayClusterInstance, pendingRayClusterInstance} {
+		if err := r.labelHeadPodForServeStatus(ctx, rayClusterInstance); err != nil {
+			return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
+		}
+	}
+
+	numActive, err := r.countServingPods(ctx, activeRayClusterInstance)
+	if err != nil {
+		return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
+	}
+	numPending, err := r.countServingPods(ctx, pendingRayClusterInstance)
+	if err != nil {
+		return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
+	}
+
+	activeStatus := &rayServiceInstance.Status.ActiveServiceStatus
+	pendingStatus := &rayServiceInstance.Status.PendingServiceStatus
+	if pendingPercent, shifted := nextTrafficStep(rayServiceInstance, pendingStatus, time.Now()); shifted {
+		// The traffic is split by the numbers of serving Pods, so a step is only taken if the pending RayCluster can
+		// receive some traffic without exceeding the step.
+		if _, _, ok := calculateServingPodCounts(numActive, numPending, pendingPercent); ok {
+			now := metav1.Now()
+			pendingStatus.TargetTrafficPercent = ptr.To(pendingPercent)
+			pendingStatus.LastTrafficMigratedTime = &now
+			activeStatus.TargetTrafficPercent = ptr.To(100 - pendingPercent)
+			logger.Info("Shifting the traffic to the pending RayCluster", "pendingRayCluster", pendingRayClusterInstance.Name, "targetTrafficPercent", pendingPercent)
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeNormal, string(utils.MigratedTraffic),
+				"Shifted %d%% of the traffic to the pending RayCluster %s/%s", pendingPercent, pendingRayClusterInstance.Namespace, pendingRayClusterInstance.Name)
+		} else {
+			logger.Info("The traffic step can't be honored with the ready serving Pods", "targetTrafficPercent", pendingPercent, "activeServingPods", numActive, "pendingServingPods", numPending)
+			r.Recorder.Eventf(rayServiceInstance, corev1.EventTypeWarning, string(utils.TrafficStepNotHonored),
+				"Can't route %d%% of the traffic to the pending RayCluster %s/%s with %d ready serving Pods in the active RayCluster and %d in the pending RayCluster",
+				pendingPercent, pendingRayClusterInstance.Namespace, pendingRayClusterInstance.Name, numActive, numPending)
+		}
+	}
+	pendingPercent := ptr.Deref(pendingStatus.TargetTrafficPercent, 0)
+
+	// If the ready serving Pods have changed since the step was taken, the traffic routed to the pending RayCluster may
+	// fall below the step, but it never exceeds it.
+	numActiveTraffic, numPendingTraffic, _ := calculateServingPodCounts(numActive, numPending, pendingPercent)
+	routedPercent := pendingPercent
+	if numActiveTraffic+numPendingTraffic > 0 {
+		routedPercent = int32(numPendingTraffic * 100 / (numActiveTraffic + numPendingTraffic)) //nolint:gosec // The percentage is between 0 and 100.
+	}
+	pendingStatus.TrafficRoutedPercent = ptr.To(routedPercent)
+	activeStatus.TrafficRoutedPercent = ptr.To(100 - routedPercent)
+	logger.Info("Splitting the traffic between the RayClusters", "activeServingPods", numActiveTraffic, "pendingServingPods", numPendingTraffic)
+	// Label the pending serving Pods first so that the Pods receiving the traffic never drop to zero.
+	if err := r.labelServingPodsForTraffic(ctx, rayServiceInstance, pendingRayClusterInstance, numPendingTraffic); err != nil {
+		return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
+	}
+	if err := r.labelServingPodsForTraffic(ctx, rayServiceInstance, activeRayClusterInstance, numActiveTraffic); err != nil {
+		return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateServingPodLabel, err)
+	}
+	if err := r.reconcileServices(ctx, rayServiceInstance, pendingRayClusterInstance, utils.ServingService); err != nil {
+		return r.updateState(ctx, rayServiceInstance, rayv1.FailedToUpdateService, err)
+	}
+
+	if pendingPercent == 100 {
+		logger.Info("All the traffic is shifted to the pending RayCluster", "pendingRayCluster", pendingRayClusterInstance.Name)
+		r.updateRayClusterInfo(ctx, rayServiceInstance, pendingRayClusterInstance.Name)
+	}
+	return nil
+}
+
+// nextTrafficStep returns the percentage of the traffic that should be routed to the pending RayCluster and whether it
+// differs from the current one. The traffic is shifted to the next step once the interval of the current step elapses.
+func nextTrafficStep(rayServiceInstance *rayv1.RayService, pendingStatus *rayv1.RayServiceStatus, now time.Time) (int32, bool) {
+	steps := DefaultIncrementalUpgradeTrafficSteps
+	intervalSeconds := DefaultIncrementalUpgradeIntervalSeconds
+	if options := rayServiceInstance.Spec.IncrementalUpgradeOptions; options != nil {
+		if len(options.TrafficSteps) > 0 {
+			steps = options.TrafficSteps
+		}
+		if options.IntervalSeconds != nil {
+			intervalSeconds = *options.IntervalSeconds
+		}
+	}
+
+	current := ptr.Deref(pendingStatus.TargetTrafficPercent, 0)
+	if lastMigrated := pendingStatus.LastTrafficMigratedTime; lastMigrated != nil && now.Before(lastMigrated.Add(time.Duration(intervalSeconds)*time.Second)) {
+		return current, false
+	}
+	for _, step := range steps {
+		if step > current {
+			return step, true
+		}
+	}
+	return current, false
+}
+
+// calculateServingPodCounts returns how many of the ready serving Pods of the active and the pending RayClusters should
+// receive the traffic so that the share of the pending RayCluster is as close as possible to `pendingPercent` without
+// exceeding it. Both RayClusters keep at least one serving Pod unless the percentage is 0 or 100. It also returns whether
+// the split honors `pendingPercent`. If the pending RayCluster can't receive any traffic without exceeding the percentage,
+// e.g., 10% with one serving Pod in each RayCluster, all the traffic is routed to the active RayCluster instead.
+func calculateServingPodCounts(numActive, numPending int, pendingPercent int32) (int, int, bool) {
+	if pendingPercent <= 0 {
+		return numActive, 0, true
+	}
+	if pendingPercent >= 100 || numActive == 0 {
+		return 0, numPending, true
+	}
+	if numPending == 0 {
+		return numActive, 0, false
+	}
+
+	bestActive, bestPending := numActive, 0
+	bestPercent := -1.0
+	for a := 1; a <= numActive; a++ {
+		for p := 1; p <= numPending; p++ {
+			percent := float64(p) / float64(a+p) * 100
+			if percent > float64(pendingPercent) {
+				continue
+			}
+			// Prefer more Pods when two splits are equally close to spread the load.
+			if percent > bestPercent || (percent == bestPercent && a+p > bestActive+bestPending) {
+				bestActive, bestPending, bestPercent = a, p, percent
+			}
+		}
+	}
+	return bestActive, bestPending, bestPending > 0
+}
+
+// getServingPods returns the running and ready Pods of the RayCluster that have the serving label, sorted by name.
+func (r *RayServiceReconciler) getServingPods(ctx context.Context, rayClusterInstance *rayv1.RayCluster) ([]corev1.Pod, []corev1.Pod, error) {
+	podList := corev1.PodList{}
+	if err := r.List(ctx, &podList, common.RayClusterAllPodsAssociationOptions(rayClusterInstance).ToListOptions()...); err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(podList.Items, func(i, j int) bool {
+		return podList.Items[i].Name < podList.Items[j].Name
+	})
+
+	servingPods := []corev1.Pod{}
+	otherPods := []corev1.Pod{}
+	for _, pod := range podList.Items {
+		if pod.Labels[utils.RayClusterServingServiceLabelKey] == utils.EnableRayClusterServingServiceTrue && utils.IsRunningAndReady(&pod) {
+			servingPods = append(servingPods, pod)
+		} else {
+			otherPods = append(otherPods, pod)
+		}
+	}
+	return servingPods, otherPods, nil
+}
+
+func (r *RayServiceReconciler) countServingPods(ctx context.Context, rayClusterInstance *rayv1.RayCluster) (int, error) {
+	servingPods, _, err := r.getServingPods(ctx, rayClusterInstance)
+	return len(servingPods), err
+}
+
+// labelServingPodsForTraffic adds the traffic label to the first `numPods` serving Pods of the RayCluster and removes it
+// from the other Pods. All the serving Pods are labeled if `numPods` is negative.
+func (r *RayServiceReconciler) labelServingPodsForTraffic(ctx context.Context, rayServiceInstance *rayv1.RayService, rayClusterInstance *rayv1.RayCluster, numPods int) error {
+	servingPods, otherPods, err := r.getServingPods(ctx, rayClusterInstance)
+	if err != nil {
+		return err
+	}
+	if numPods < 0 || numPods > len(servingPods) {
+		numPods = len(servingPods)
+	}
+
+	labelValue := utils.CheckLabel(rayServiceInstance.Name)
+	pods := make([]corev1.Pod, 0, len(servingPods)+len(otherPods))
+	pods = append(pods, servingPods...)
+	pods = append(pods, otherPods...)
+	for i := range pods {
+		pod := &pods[i]
+		shouldReceiveTraffic := i < numPods
+		value, ok := pod.Labels[utils.RayServiceTrafficLabelKey]
+		if (shouldReceiveTraffic && value == labelValue) || (!shouldReceiveTraffic && !ok) {
+			continue
+		}
+		patch := client.MergeFrom(pod.DeepCopy())
+		if shouldReceiveTraffic {
+			if pod.Labels == nil {
+				pod.Labels = make(map[string]string)
+			}
+			pod.Labels[utils.RayServiceTrafficLabelKey] = labelValue
+		} else {
+			delete(pod.Labels, utils.RayServiceTrafficLabelKey)
+		}
+		if err := r.Patch(ctx, pod, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getClusterAction(oldSpec rayv1.RayClusterSpec, newSpec rayv1.RayClusterSpec) (ClusterAction, error) {
 	// Return the appropriate action based on the difference in the old and new RayCluster specs.
 
@@ -1250,6 +1897,19 @@ func generateHashWithoutReplicasAndWorkersToDelete(rayClusterSpec rayv1.RayClust
 	return utils.GenerateJsonHash(updatedRayClusterSpec)
 }
 
+// generateHashWithoutWorkerGroups generates the hash of the RayClusterSpec without the worker groups.
+func generateHashWithoutWorkerGroups(rayClusterSpec rayv1.RayClusterSpec) (string, error) {
+	updatedRayClusterSpec := rayClusterSpec.DeepCopy()
+	updatedRayClusterSpec.WorkerGroupSpecs = nil
+	return utils.GenerateJsonHash(updatedRayClusterSpec)
+}
+
+// isInPlaceWorkerGroupUpgradeEnabled returns whether the changes to the worker groups of the RayService are applied to
+// the active RayCluster.
+func isInPlaceWorkerGroupUpgradeEnabled(rayServiceSpec *rayv1.RayServiceSpec) bool {
+	return rayServiceSpec.WorkerGroupUpgradeStrategy != nil && *rayServiceSpec.WorkerGroupUpgradeStrategy == rayv1.InPlaceWorkerGroupUpgrade
+}
+
 func compareRayClusterJsonHash(spec1 rayv1.RayClusterSpec, spec2 rayv1.RayClusterSpec, hashFunc func(rayv1.RayClusterSpec) (string, error)) (bool, error) {
 	hash1, err1 := hashFunc(spec1)
 	if err1 != nil {
@@ -9,15 +9,16 @@ import (
 	"testing"
 	"time"
 
-	cmap "github.com/orcaman/concurrent-map/v2"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	"github.com/ray-project/kuberay/ray-operator/controllers/ray/utils"
@@ -52,6 +53,83 @@ func TestValidateRayServiceSpec(t *testing.T) {
 		},
 	})
 	assert.Error(t, err, "spec.UpgradeStrategy is invalid")
+
+	err = validateRayServiceSpec(&rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			UpgradeTimeoutSeconds: ptr.To[int32](0),
+		},
+	})
+	assert.Error(t, err, "spec.upgradeTimeoutSeconds must be a positive integer")
+
+	err = validateRayServiceSpec(&rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			WorkerGroupUpgradeStrategy: ptr.To(rayv1.InPlaceWorkerGroupUpgrade),
+		},
+	})
+	assert.Error(t, err, "spec.rayClusterConfig.upgradeStrategy.type must be set with the InPlace worker group upgrade strategy")
+
+	err = validateRayServiceSpec(&rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			WorkerGroupUpgradeStrategy: ptr.To(rayv1.InPlaceWorkerGroupUpgrade),
+			RayClusterSpec: rayv1.RayClusterSpec{
+				UpgradeStrategy: &rayv1.RayClusterUpgradeStrategy{Type: ptr.To(rayv1.Recreate)},
+			},
+		},
+	})
+	assert.NoError(t, err, "The InPlace worker group upgrade strategy is valid.")
+
+	err = validateRayServiceSpec(&rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2: "applications: []",
+			ServeConfigV2From: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+				Key:                  "serve.yaml",
+			},
+		},
+	})
+	assert.Error(t, err, "spec.serveConfigV2 and spec.serveConfigV2From can't be set at the same time")
+
+	err = validateRayServiceSpec(&rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2From: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+				Key:                  "serve.yaml",
+				Optional:             ptr.To(true),
+			},
+		},
+	})
+	assert.Error(t, err, "spec.serveConfigV2From.optional must not be true")
+
+	incrementalUpgrade := rayv1.IncrementalUpgrade
+	err = validateRayServiceSpec(&rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			UpgradeStrategy: &incrementalUpgrade,
+			IncrementalUpgradeOptions: &rayv1.IncrementalUpgradeOptions{
+				IntervalSeconds: ptr.To[int32](30),
+				TrafficSteps:    []int32{20, 60, 100},
+			},
+		},
+	})
+	assert.NoError(t, err, "The IncrementalUpgrade options are valid.")
+
+	newCluster := rayv1.NewCluster
+	err = validateRayServiceSpec(&rayv1.RayService{
+		Spec: rayv1.RayServiceSpec{
+			UpgradeStrategy:           &newCluster,
+			IncrementalUpgradeOptions: &rayv1.IncrementalUpgradeOptions{},
+		},
+	})
+	assert.Error(t, err, "spec.incrementalUpgradeOptions can only be set with the IncrementalUpgrade strategy")
+
+	for _, steps := range [][]int32{{50, 20, 100}, {10, 50}, {0, 100}, {50, 50, 100}, {10, 200}} {
+		err = validateRayServiceSpec(&rayv1.RayService{
+			Spec: rayv1.RayServiceSpec{
+				UpgradeStrategy:           &incrementalUpgrade,
+				IncrementalUpgradeOptions: &rayv1.IncrementalUpgradeOptions{TrafficSteps: steps},
+			},
+		})
+		assert.Error(t, err, "spec.incrementalUpgradeOptions.trafficSteps %v is invalid", steps)
+	}
 }
 
 func TestGenerateHashWithoutReplicasAndWorkersToDelete(t *testing.T) {
@@ -238,6 +316,85 @@ func TestInconsistentRayServiceStatuses(t *testing.T) {
 	// Test 2: Test RayServiceStatus
 	newStatus = oldStatus.DeepCopy()
 	assert.False(t, r.inconsistentRayServiceStatuses(ctx, oldStatus, *newStatus))
+
+	// Test 3: Update Conditions only.
+	newStatus = oldStatus.DeepCopy()
+	meta.SetStatusCondition(&newStatus.Conditions, metav1.Condition{Type: string(rayv1.UpgradeInProgress), Status: metav1.ConditionTrue, Reason: rayv1.PendingRayClusterPreparing})
+	assert.True(t, r.inconsistentRayServiceStatuses(ctx, oldStatus, *newStatus))
+}
+
+func TestSetRayServiceConditions(t *testing.T) {
+	runningApp := rayv1.AppStatus{Status: rayv1.ApplicationStatusEnum.RUNNING}
+	deployingApp := rayv1.AppStatus{Status: rayv1.ApplicationStatusEnum.DEPLOYING}
+
+	tests := map[string]struct {
+		expectedServeApplicationsReady metav1.ConditionStatus
+		expectedServeReason            string
+		expectedUpgradeInProgress      metav1.ConditionStatus
+		status                         rayv1.RayServiceStatuses
+	}{
+		"No RayCluster exists": {
+			status:                         rayv1.RayServiceStatuses{},
+			expectedServeApplicationsReady: metav1.ConditionFalse,
+			expectedServeReason:            rayv1.NoActiveRayCluster,
+			expectedUpgradeInProgress:      metav1.ConditionFalse,
+		},
+		"The first RayCluster is pending": {
+			status: rayv1.RayServiceStatuses{
+				PendingServiceStatus: rayv1.RayServiceStatus{RayClusterName: "pending-cluster"},
+			},
+			expectedServeApplicationsReady: metav1.ConditionFalse,
+			expectedServeReason:            rayv1.NoActiveRayCluster,
+			expectedUpgradeInProgress:      metav1.ConditionFalse,
+		},
+		"All Serve applications on the active RayCluster are running": {
+			status: rayv1.RayServiceStatuses{
+				ActiveServiceStatus: rayv1.RayServiceStatus{
+					RayClusterName: "active-cluster",
+					Applications:   map[string]rayv1.AppStatus{"app1": runningApp, "app2": runningApp},
+				},
+			},
+			expectedServeApplicationsReady: metav1.ConditionTrue,
+			expectedServeReason:            rayv1.AllServeApplicationsRunning,
+			expectedUpgradeInProgress:      metav1.ConditionFalse,
+		},
+		"A Serve application on the active RayCluster is not running": {
+			status: rayv1.RayServiceStatuses{
+				ActiveServiceStatus: rayv1.RayServiceStatus{
+					RayClusterName: "active-cluster",
+					Applications:   map[string]rayv1.AppStatus{"app1": runningApp, "app2": deployingApp},
+				},
+			},
+			expectedServeApplicationsReady: metav1.ConditionFalse,
+			expectedServeReason:            rayv1.ServeApplicationsNotRunning,
+			expectedUpgradeInProgress:      metav1.ConditionFalse,
+		},
+		"A pending RayCluster is replacing the active RayCluster": {
+			status: rayv1.RayServiceStatuses{
+				ActiveServiceStatus: rayv1.RayServiceStatus{
+					RayClusterName: "active-cluster",
+					Applications:   map[string]rayv1.AppStatus{"app1": runningApp},
+				},
+				PendingServiceStatus: rayv1.RayServiceStatus{RayClusterName: "pending-cluster"},
+			},
+			expectedServeApplicationsReady: metav1.ConditionTrue,
+			expectedServeReason:            rayv1.AllServeApplicationsRunning,
+			expectedUpgradeInProgress:      metav1.ConditionTrue,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rayService := &rayv1.RayService{Status: tc.status}
+			setRayServiceConditions(rayService)
+
+			serveApplicationsReady := meta.FindStatusCondition(rayService.Status.Conditions, string(rayv1.ServeApplicationsReady))
+			assert.NotNil(t, serveApplicationsReady)
+			assert.Equal(t, tc.expectedServeApplicationsReady, serveApplicationsReady.Status)
+			assert.Equal(t, tc.expectedServeReason, serveApplicationsReady.Reason)
+			assert.True(t, meta.IsStatusConditionPresentAndEqual(rayService.Status.Conditions, string(rayv1.UpgradeInProgress), tc.expectedUpgradeInProgress))
+		})
+	}
 }
 
 func TestInconsistentRayServiceStatus(t *testing.T) {
@@ -282,6 +439,22 @@ func TestInconsistentRayServiceStatus(t *testing.T) {
 		newStatus.Applications[appName] = application
 	}
 	assert.False(t, r.inconsistentRayServiceStatus(ctx, oldStatus, *newStatus))
+
+	// Test 2: The number of running replicas of a deployment changes.
+	newStatus = oldStatus.DeepCopy()
+	deployment := newStatus.Applications["app1"].Deployments["serve-1"]
+	deployment.TargetReplicas = ptr.To[int32](2)
+	deployment.RunningReplicas = ptr.To[int32](1)
+	deployment.ReplicaStates = map[string]int32{utils.ServeReplicaStateRunning: 1, "STARTING": 1}
+	newStatus.Applications["app1"].Deployments["serve-1"] = deployment
+	assert.True(t, r.inconsistentRayServiceStatus(ctx, oldStatus, *newStatus))
+
+	// Test 3: The route prefix of an application changes.
+	newStatus = oldStatus.DeepCopy()
+	application := newStatus.Applications["app2"]
+	application.RoutePrefix = "/app2"
+	newStatus.Applications["app2"] = application
+	assert.True(t, r.inconsistentRayServiceStatus(ctx, oldStatus, *newStatus))
 }
 
 func TestIsHeadPodRunningAndReady(t *testing.T) {
@@ -652,24 +825,70 @@ func TestGetAndCheckServeStatus(t *testing.T) {
 	}
 }
 
+func TestGetAndCheckServeStatus_Replicas(t *testing.T) {
+	ctx := context.TODO()
+	r := RayServiceReconciler{Recorder: &record.FakeRecorder{}}
+
+	dashboardClient := &utils.FakeRayDashboardClient{}
+	dashboardClient.SetMultiApplicationStatuses(map[string]*utils.ServeApplicationStatus{
+		"app": {
+			Name:              "app",
+			Status:            rayv1.ApplicationStatusEnum.RUNNING,
+			RoutePrefix:       "/app",
+			LastDeployedTimeS: 1700000000.5,
+			Deployments: map[string]utils.ServeDeploymentStatus{
+				"autoscaling": {
+					Name:              "autoscaling",
+					Status:            rayv1.DeploymentStatusEnum.HEALTHY,
+					TargetNumReplicas: ptr.To[int32](3),
+					DeploymentConfig: utils.ServeDeploymentConfig{
+						AutoscalingConfig: &utils.ServeAutoscalingConfig{MinReplicas: 1, MaxReplicas: 3},
+					},
+					Replicas: []utils.ServeReplicaDetails{
+						{ReplicaId: "r1", State: utils.ServeReplicaStateRunning},
+						{ReplicaId: "r2", State: utils.ServeReplicaStateRunning},
+						{ReplicaId: "r3", State: "STARTING"},
+					},
+				},
+				// Older Ray versions don't report replicas.
+				"fixed": {
+					Name:   "fixed",
+					Status: rayv1.DeploymentStatusEnum.HEALTHY,
+				},
+			},
+		},
+	})
+
+	status := rayv1.RayServiceStatus{}
+	isReady, err := r.getAndCheckServeStatus(ctx, dashboardClient, &status)
+	assert.NoError(t, err)
+	assert.True(t, isReady)
+
+	app := status.Applications["app"]
+	assert.Equal(t, "/app", app.RoutePrefix)
+	if assert.NotNil(t, app.LastDeployedTime) {
+		assert.Equal(t, int64(1700000000), app.LastDeployedTime.Unix())
+	}
+
+	autoscaling := app.Deployments["autoscaling"]
+	assert.Equal(t, ptr.To[int32](3), autoscaling.TargetReplicas)
+	assert.Equal(t, ptr.To[int32](2), autoscaling.RunningReplicas)
+	assert.Equal(t, map[string]int32{utils.ServeReplicaStateRunning: 2, "STARTING": 1}, autoscaling.ReplicaStates)
+	assert.Equal(t, &rayv1.ServeDeploymentAutoscalingStatus{MinReplicas: 1, MaxReplicas: 3}, autoscaling.Autoscaling)
+
+	fixed := app.Deployments["fixed"]
+	assert.Nil(t, fixed.TargetReplicas)
+	assert.Nil(t, fixed.RunningReplicas)
+	assert.Nil(t, fixed.ReplicaStates)
+	assert.Nil(t, fixed.Autoscaling)
+}
+
 func TestCheckIfNeedSubmitServeDeployment(t *testing.T) {
 	// Create a new scheme with CRDs, Pod, Service schemes.
 	newScheme := runtime.NewScheme()
 	_ = rayv1.AddToScheme(newScheme)
 	_ = corev1.AddToScheme(newScheme)
 
-	// Initialize a fake client with newScheme and runtimeObjects.
-	runtimeObjects := []runtime.Object{}
-	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
-
-	// Initialize RayService reconciler.
-	r := RayServiceReconciler{
-		Client:       fakeClient,
-		Recorder:     &record.FakeRecorder{},
-		Scheme:       scheme.Scheme,
-		ServeConfigs: cmap.New[string](),
-	}
-
 	namespace := "ray"
 	cluster := rayv1.RayCluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -677,6 +896,17 @@ func TestCheckIfNeedSubmitServeDeployment(t *testing.T) {
 			Namespace: namespace,
 		},
 	}
+
+	// Initialize a fake client with newScheme and runtimeObjects.
+	runtimeObjects := []runtime.Object{}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
+
+	// Initialize RayService reconciler.
+	r := RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
 	rayService := rayv1.RayService{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "test-service",
@@ -701,24 +931,21 @@ applications:
 	ctx := context.Background()
 
 	// Test 1: The RayCluster is new, and this is the first reconciliation after the RayCluster becomes ready.
-	// No Serve application has been created yet, so the RayService's serve configuration has not been cached in
-	// `r.ServeConfigs`.
-	cacheKey := r.generateConfigKey(&rayService, cluster.Name)
-	_, exist := r.ServeConfigs.Get(cacheKey)
+	// No Serve application has been created yet, so the RayCluster doesn't have the Serve config hash annotation.
+	_, exist := cluster.Annotations[utils.ServeConfigHashAnnotationKey]
 	assert.False(t, exist)
-	shouldCreate := r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &rayv1.RayServiceStatus{})
+	shouldCreate := r.checkIfNeedSubmitServeDeployment(ctx, rayService.Spec.ServeConfigV2, &cluster, &rayv1.RayServiceStatus{})
 	assert.True(t, shouldCreate)
 
 	// Test 2: The RayCluster is not new, but the head Pod without GCS FT-enabled crashes and restarts.
-	// Hence, the RayService's Serve application status is empty, but the KubeRay operator has cached the Serve
-	// application's configuration.
-	r.ServeConfigs.Set(cacheKey, rayService.Spec.ServeConfigV2) // Simulate the Serve application's configuration has been cached.
-	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &rayv1.RayServiceStatus{})
+	// Hence, the RayService's Serve application status is empty, but the Serve config has been applied to the RayCluster.
+	serveConfigHash, err := utils.GenerateJsonHash(rayService.Spec.ServeConfigV2)
+	assert.Nil(t, err)
+	cluster.Annotations = map[string]string{utils.ServeConfigHashAnnotationKey: serveConfigHash} // Simulate the Serve config has been applied.
+	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, rayService.Spec.ServeConfigV2, &cluster, &rayv1.RayServiceStatus{})
 	assert.True(t, shouldCreate)
 
 	// Test 3: The Serve application has been created, and the RayService's status has been updated.
-	_, exist = r.ServeConfigs.Get(cacheKey)
-	assert.True(t, exist)
 	serveStatus := rayv1.RayServiceStatus{
 		Applications: map[string]rayv1.AppStatus{
 			"myapp": {
@@ -726,7 +953,7 @@ applications:
 			},
 		},
 	}
-	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &serveStatus)
+	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, rayService.Spec.ServeConfigV2, &cluster, &serveStatus)
 	assert.False(t, shouldCreate)
 
 	// Test 4: The Serve application has been created, but the Serve config has been updated.
@@ -735,7 +962,7 @@ applications:
 applications:
 - name: new_app_name
   import_path: fruit.deployment_graph`
-	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, &rayService, &cluster, &serveStatus)
+	shouldCreate = r.checkIfNeedSubmitServeDeployment(ctx, rayService.Spec.ServeConfigV2, &cluster, &serveStatus)
 	assert.True(t, shouldCreate)
 }
 
@@ -777,6 +1004,7 @@ func TestReconcileRayCluster(t *testing.T) {
 		enableZeroDowntime        bool
 		shouldPrepareNewCluster   bool
 		updateKubeRayVersion      bool
+		upgradeRolledBack         bool
 	}{
 		// Test 1: Neither active nor pending clusters exist. The `markRestart` function will be called, so the `PendingServiceStatus.RayClusterName` should be set.
 		"Zero-downtime upgrade is enabled. Neither active nor pending clusters exist.": {
@@ -863,6 +1091,14 @@ func TestReconcileRayCluster(t *testing.T) {
 			shouldPrepareNewCluster:   false,
 			rayServiceUpgradeStrategy: rayv1.None,
 		},
+		// Test 12: The upgrade to the goal config was rolled back. Don't retry it.
+		"Zero-downtime upgrade is enabled. The upgrade to the goal config was rolled back.": {
+			activeCluster:           activeCluster.DeepCopy(),
+			updateRayClusterSpec:    true,
+			enableZeroDowntime:      true,
+			shouldPrepareNewCluster: false,
+			upgradeRolledBack:       true,
+		},
 	}
 
 	for name, tc := range tests {
@@ -882,8 +1118,9 @@ func TestReconcileRayCluster(t *testing.T) {
 			}
 			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
 			r := RayServiceReconciler{
-				Client: fakeClient,
-				Scheme: newScheme,
+				Client:   fakeClient,
+				Recorder: record.NewFakeRecorder(100),
+				Scheme:   newScheme,
 			}
 			service := rayService.DeepCopy()
 			if tc.rayServiceUpgradeStrategy != "" {
@@ -895,6 +1132,10 @@ func TestReconcileRayCluster(t *testing.T) {
 			if tc.activeCluster != nil {
 				service.Status.ActiveServiceStatus.RayClusterName = tc.activeCluster.Name
 			}
+			if tc.upgradeRolledBack {
+				service.Status.LastFailedSpec, err = generateHashWithoutReplicasAndWorkersToDelete(service.Spec.RayClusterSpec)
+				assert.Nil(t, err)
+			}
 			assert.Equal(t, "", service.Status.PendingServiceStatus.RayClusterName)
 			activeRayCluster, _, err := r.reconcileRayCluster(ctx, service)
 			assert.Nil(t, err)
@@ -914,9 +1155,783 @@ func TestReconcileRayCluster(t *testing.T) {
 	}
 }
 
+func TestReconcileRayCluster_WorkerGroupUpgradeStrategy(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	ctx := context.TODO()
+	namespace := "ray"
+	rayService := rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayServiceSpec{
+			RayClusterSpec: rayv1.RayClusterSpec{
+				UpgradeStrategy: &rayv1.RayClusterUpgradeStrategy{
+					Type: ptr.To(rayv1.RollingUpdate),
+				},
+				HeadGroupSpec: rayv1.HeadGroupSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{Name: "ray-head", Image: "rayproject/ray:2.9.0"}},
+						},
+					},
+				},
+				WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+					{
+						GroupName: "gpu-group",
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.9.0"}},
+							},
+						},
+					},
+					{
+						GroupName: "cpu-group",
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.9.0"}},
+							},
+						},
+					},
+				},
+			},
+		},
+		Status: rayv1.RayServiceStatuses{
+			ActiveServiceStatus: rayv1.RayServiceStatus{RayClusterName: "active-cluster"},
+		},
+	}
+
+	tests := map[string]struct {
+		updateSpec                 func(spec *rayv1.RayClusterSpec)
+		workerGroupUpgradeStrategy *rayv1.RayServiceWorkerGroupUpgradeStrategy
+		expectedEvent              utils.K8sEventType
+		shouldPrepareNewCluster    bool
+	}{
+		"InPlace: the worker image is changed": {
+			updateSpec: func(spec *rayv1.RayClusterSpec) {
+				spec.WorkerGroupSpecs[0].Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+			},
+			workerGroupUpgradeStrategy: ptr.To(rayv1.InPlaceWorkerGroupUpgrade),
+			shouldPrepareNewCluster:    false,
+			expectedEvent:              utils.UpdatedWorkerGroups,
+		},
+		"InPlace: a worker group is removed": {
+			updateSpec: func(spec *rayv1.RayClusterSpec) {
+				spec.WorkerGroupSpecs = spec.WorkerGroupSpecs[:1]
+			},
+			workerGroupUpgradeStrategy: ptr.To(rayv1.InPlaceWorkerGroupUpgrade),
+			shouldPrepareNewCluster:    false,
+			expectedEvent:              utils.UpdatedWorkerGroups,
+		},
+		"InPlace: the head image is changed": {
+			updateSpec: func(spec *rayv1.RayClusterSpec) {
+				spec.HeadGroupSpec.Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+				spec.WorkerGroupSpecs[0].Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+			},
+			workerGroupUpgradeStrategy: ptr.To(rayv1.InPlaceWorkerGroupUpgrade),
+			shouldPrepareNewCluster:    true,
+			expectedEvent:              utils.PreparingRayCluster,
+		},
+		"NewCluster: the worker image is changed": {
+			updateSpec: func(spec *rayv1.RayClusterSpec) {
+				spec.WorkerGroupSpecs[0].Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+			},
+			workerGroupUpgradeStrategy: ptr.To(rayv1.NewClusterWorkerGroupUpgrade),
+			shouldPrepareNewCluster:    true,
+			expectedEvent:              utils.PreparingRayCluster,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := rayService.DeepCopy()
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).Build()
+			recorder := record.NewFakeRecorder(100)
+			r := RayServiceReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+			activeCluster, err := r.constructRayClusterForRayService(ctx, rayService.DeepCopy(), "active-cluster")
+			assert.Nil(t, err)
+			err = fakeClient.Create(ctx, activeCluster)
+			assert.Nil(t, err)
+
+			service.Spec.WorkerGroupUpgradeStrategy = tc.workerGroupUpgradeStrategy
+			tc.updateSpec(&service.Spec.RayClusterSpec)
+			_, _, err = r.reconcileRayCluster(ctx, service)
+			assert.Nil(t, err)
+
+			updatedCluster := &rayv1.RayCluster{}
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(activeCluster), updatedCluster)
+			assert.Nil(t, err)
+			if tc.shouldPrepareNewCluster {
+				assert.NotEqual(t, "", service.Status.PendingServiceStatus.RayClusterName)
+				assert.Equal(t, activeCluster.Spec, updatedCluster.Spec)
+			} else {
+				assert.Equal(t, "", service.Status.PendingServiceStatus.RayClusterName)
+				assert.Equal(t, service.Spec.RayClusterSpec, updatedCluster.Spec)
+			}
+			assert.Contains(t, <-recorder.Events, string(tc.expectedEvent))
+		})
+	}
+}
+
 func initFakeDashboardClient(appName string, deploymentStatus string, appStatus string) utils.RayDashboardClientInterface {
 	fakeDashboardClient := utils.FakeRayDashboardClient{}
 	status := generateServeStatus(deploymentStatus, appStatus)
 	fakeDashboardClient.SetMultiApplicationStatuses(map[string]*utils.ServeApplicationStatus{appName: &status})
 	return &fakeDashboardClient
 }
+
+func TestNextTrafficStep(t *testing.T) {
+	now := time.Now()
+	rayService := &rayv1.RayService{}
+
+	// Test 1: The traffic is shifted to the first default step immediately.
+	percent, shifted := nextTrafficStep(rayService, &rayv1.RayServiceStatus{}, now)
+	assert.True(t, shifted)
+	assert.Equal(t, int32(10), percent)
+
+	// Test 2: The traffic is held until the interval elapses.
+	pendingStatus := &rayv1.RayServiceStatus{
+		TargetTrafficPercent:    ptr.To[int32](10),
+		LastTrafficMigratedTime: &metav1.Time{Time: now.Add(-30 * time.Second)},
+	}
+	percent, shifted = nextTrafficStep(rayService, pendingStatus, now)
+	assert.False(t, shifted)
+	assert.Equal(t, int32(10), percent)
+
+	percent, shifted = nextTrafficStep(rayService, pendingStatus, now.Add(30*time.Second))
+	assert.True(t, shifted)
+	assert.Equal(t, int32(50), percent)
+
+	// Test 3: The configured steps and interval are used.
+	rayService.Spec.IncrementalUpgradeOptions = &rayv1.IncrementalUpgradeOptions{
+		IntervalSeconds: ptr.To[int32](10),
+		TrafficSteps:    []int32{25, 100},
+	}
+	percent, shifted = nextTrafficStep(rayService, pendingStatus, now)
+	assert.True(t, shifted)
+	assert.Equal(t, int32(25), percent)
+
+	// Test 4: The traffic is not shifted after the last step.
+	pendingStatus.TargetTrafficPercent = ptr.To[int32](100)
+	percent, shifted = nextTrafficStep(rayService, pendingStatus, now)
+	assert.False(t, shifted)
+	assert.Equal(t, int32(100), percent)
+}
+
+func TestCalculateServingPodCounts(t *testing.T) {
+	tests := map[string]struct {
+		numActive       int
+		numPending      int
+		expectedActive  int
+		expectedPending int
+		pendingPercent  int32
+		expectedOk      bool
+	}{
+		"No traffic to the pending RayCluster": {
+			numActive:       3,
+			numPending:      3,
+			pendingPercent:  0,
+			expectedActive:  3,
+			expectedPending: 0,
+			expectedOk:      true,
+		},
+		"All traffic to the pending RayCluster": {
+			numActive:       3,
+			numPending:      3,
+			pendingPercent:  100,
+			expectedActive:  0,
+			expectedPending: 3,
+			expectedOk:      true,
+		},
+		"Even split": {
+			numActive:       4,
+			numPending:      4,
+			pendingPercent:  50,
+			expectedActive:  4,
+			expectedPending: 4,
+			expectedOk:      true,
+		},
+		"Small share keeps one pending Pod": {
+			numActive:       9,
+			numPending:      3,
+			pendingPercent:  10,
+			expectedActive:  9,
+			expectedPending: 1,
+			expectedOk:      true,
+		},
+		"The split falls below the share instead of exceeding it": {
+			numActive:       3,
+			numPending:      3,
+			pendingPercent:  30,
+			expectedActive:  3,
+			expectedPending: 1,
+			expectedOk:      true,
+		},
+		"A single serving Pod in each RayCluster can't honor a small share": {
+			numActive:       1,
+			numPending:      1,
+			pendingPercent:  10,
+			expectedActive:  1,
+			expectedPending: 0,
+			expectedOk:      false,
+		},
+		"No ready pending Pods": {
+			numActive:       3,
+			numPending:      0,
+			pendingPercent:  50,
+			expectedActive:  3,
+			expectedPending: 0,
+			expectedOk:      false,
+		},
+		"No ready active Pods": {
+			numActive:       0,
+			numPending:      2,
+			pendingPercent:  10,
+			expectedActive:  0,
+			expectedPending: 2,
+			expectedOk:      true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			numActive, numPending, ok := calculateServingPodCounts(tc.numActive, tc.numPending, tc.pendingPercent)
+			assert.Equal(t, tc.expectedActive, numActive)
+			assert.Equal(t, tc.expectedPending, numPending)
+			assert.Equal(t, tc.expectedOk, ok)
+		})
+	}
+}
+
+func TestLabelServingPodsForTraffic(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	cluster := rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: namespace,
+		},
+	}
+	rayService := rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+		},
+	}
+	readyStatus := corev1.PodStatus{
+		Phase:      corev1.PodRunning,
+		Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+	}
+	newPod := func(name string, serving string, status corev1.PodStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					utils.RayClusterLabelKey:               cluster.Name,
+					utils.RayClusterServingServiceLabelKey: serving,
+					utils.RayServiceTrafficLabelKey:        rayService.Name,
+				},
+			},
+			Status: status,
+		}
+	}
+	runtimeObjects := []runtime.Object{
+		newPod("pod-a", utils.EnableRayClusterServingServiceTrue, readyStatus),
+		newPod("pod-b", utils.EnableRayClusterServingServiceTrue, readyStatus),
+		newPod("pod-c", utils.EnableRayClusterServingServiceFalse, readyStatus),
+		newPod("pod-d", utils.EnableRayClusterServingServiceTrue, corev1.PodStatus{Phase: corev1.PodPending}),
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).Build()
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   scheme.Scheme,
+	}
+	ctx := context.TODO()
+
+	labeledPods := func() []string {
+		podList := corev1.PodList{}
+		err := fakeClient.List(ctx, &podList, client.InNamespace(namespace), client.MatchingLabels{utils.RayServiceTrafficLabelKey: rayService.Name})
+		assert.Nil(t, err)
+		names := []string{}
+		for _, pod := range podList.Items {
+			names = append(names, pod.Name)
+		}
+		return names
+	}
+
+	// Only the first serving Pod receives the traffic.
+	err := r.labelServingPodsForTraffic(ctx, &rayService, &cluster, 1)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"pod-a"}, labeledPods())
+
+	// All the running and ready serving Pods receive the traffic.
+	err = r.labelServingPodsForTraffic(ctx, &rayService, &cluster, -1)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"pod-a", "pod-b"}, labeledPods())
+
+	// No Pods receive the traffic.
+	err = r.labelServingPodsForTraffic(ctx, &rayService, &cluster, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, labeledPods())
+}
+
+func TestIsUpgradeTimedOut(t *testing.T) {
+	now := time.Now()
+	pendingCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Minute)),
+		},
+	}
+	rayService := &rayv1.RayService{}
+
+	// The upgrade never times out if `UpgradeTimeoutSeconds` isn't set.
+	assert.False(t, isUpgradeTimedOut(rayService, pendingCluster, now))
+
+	rayService.Spec.UpgradeTimeoutSeconds = ptr.To[int32](300)
+	assert.False(t, isUpgradeTimedOut(rayService, pendingCluster, now))
+
+	rayService.Spec.UpgradeTimeoutSeconds = ptr.To[int32](60)
+	assert.True(t, isUpgradeTimedOut(rayService, pendingCluster, now))
+}
+
+func TestRollBackUpgrade(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayServiceSpec{
+			UpgradeTimeoutSeconds: ptr.To[int32](60),
+		},
+		Status: rayv1.RayServiceStatuses{
+			ActiveServiceStatus:  rayv1.RayServiceStatus{RayClusterName: "active-cluster"},
+			PendingServiceStatus: rayv1.RayServiceStatus{RayClusterName: "pending-cluster"},
+		},
+	}
+	pendingCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pending-cluster",
+			Namespace: namespace,
+			Annotations: map[string]string{
+				utils.HashWithoutReplicasAndWorkersToDeleteKey: "failed-hash",
+			},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayService, pendingCluster).WithStatusSubresource(rayService).Build()
+	recorder := record.NewFakeRecorder(100)
+	r := &RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: recorder,
+		Scheme:   newScheme,
+	}
+	ctx := context.TODO()
+
+	_, err := r.rollBackUpgrade(ctx, rayService, pendingCluster)
+	assert.Nil(t, err)
+
+	updatedRayService := &rayv1.RayService{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), updatedRayService)
+	assert.Nil(t, err)
+	assert.Equal(t, "failed-hash", updatedRayService.Status.LastFailedSpec)
+	assert.Equal(t, "active-cluster", updatedRayService.Status.ActiveServiceStatus.RayClusterName)
+	assert.Equal(t, "", updatedRayService.Status.PendingServiceStatus.RayClusterName)
+	assert.Contains(t, <-recorder.Events, string(utils.UpgradeRolledBack))
+}
+
+func TestRollBackToPreviousRayCluster(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+			Annotations: map[string]string{
+				utils.RayServiceRollbackAnnotationKey: "true",
+			},
+		},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2: "applications:\n- name: myapp\n  import_path: fruit.deployment_graph",
+		},
+		Status: rayv1.RayServiceStatuses{
+			ActiveServiceStatus:          rayv1.RayServiceStatus{RayClusterName: "active-cluster"},
+			PreviousActiveRayClusterName: "previous-cluster",
+		},
+	}
+	activeCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "active-cluster",
+			Namespace: namespace,
+			Annotations: map[string]string{
+				utils.HashWithoutReplicasAndWorkersToDeleteKey: "failed-hash",
+			},
+		},
+	}
+	serveConfigHash, err := utils.GenerateJsonHash(rayService.Spec.ServeConfigV2)
+	assert.Nil(t, err)
+	previousCluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "previous-cluster",
+			Namespace: namespace,
+			Annotations: map[string]string{
+				utils.ServeConfigHashAnnotationKey: serveConfigHash,
+			},
+		},
+	}
+	ctx := context.TODO()
+
+	tests := map[string]struct {
+		expectedActiveRayClusterName string
+		expectedLastFailedSpec       string
+		expectedEvent                utils.K8sEventType
+		previousClusterExists        bool
+		serveConfigChanged           bool
+	}{
+		"The previous active RayCluster exists": {
+			previousClusterExists:        true,
+			expectedActiveRayClusterName: "previous-cluster",
+			expectedLastFailedSpec:       "failed-hash",
+			expectedEvent:                utils.UpgradeRolledBack,
+		},
+		"The previous active RayCluster has been deleted": {
+			previousClusterExists:        false,
+			expectedActiveRayClusterName: "active-cluster",
+			expectedLastFailedSpec:       "",
+			expectedEvent:                utils.FailedToRollBack,
+		},
+		"The Serve config has changed since the previous active RayCluster was active": {
+			previousClusterExists:        true,
+			serveConfigChanged:           true,
+			expectedActiveRayClusterName: "active-cluster",
+			expectedLastFailedSpec:       "",
+			expectedEvent:                utils.FailedToRollBack,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			service := rayService.DeepCopy()
+			runtimeObjects := []runtime.Object{service, activeCluster.DeepCopy()}
+			if tc.previousClusterExists {
+				cluster := previousCluster.DeepCopy()
+				if tc.serveConfigChanged {
+					cluster.Annotations[utils.ServeConfigHashAnnotationKey] = "stale-hash"
+				}
+				runtimeObjects = append(runtimeObjects, cluster)
+			}
+			fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(runtimeObjects...).WithStatusSubresource(service).Build()
+			recorder := record.NewFakeRecorder(100)
+			r := &RayServiceReconciler{
+				Client:   fakeClient,
+				Recorder: recorder,
+				Scheme:   newScheme,
+			}
+
+			_, err := r.rollBackToPreviousRayCluster(ctx, service)
+			assert.Nil(t, err)
+
+			updatedRayService := &rayv1.RayService{}
+			err = fakeClient.Get(ctx, client.ObjectKeyFromObject(service), updatedRayService)
+			assert.Nil(t, err)
+			assert.NotContains(t, updatedRayService.Annotations, utils.RayServiceRollbackAnnotationKey)
+			assert.Equal(t, tc.expectedActiveRayClusterName, updatedRayService.Status.ActiveServiceStatus.RayClusterName)
+			assert.Equal(t, tc.expectedLastFailedSpec, updatedRayService.Status.LastFailedSpec)
+			assert.Contains(t, <-recorder.Events, string(tc.expectedEvent))
+		})
+	}
+}
+
+func TestUpdateServeDeployment_RecordsServeConfigHash(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: "ray",
+		},
+	}
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: cluster.Namespace,
+		},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2: `
+applications:
+- name: myapp
+  import_path: fruit.deployment_graph`,
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(cluster.DeepCopy()).Build()
+	r := RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+	ctx := context.TODO()
+
+	err := r.updateServeDeployment(ctx, rayService.Spec.ServeConfigV2, &utils.FakeRayDashboardClient{}, cluster)
+	assert.Nil(t, err)
+
+	// The hash of the applied Serve config is persisted on the RayCluster, so the Serve config isn't reapplied
+	// after the operator restarts.
+	storedCluster := &rayv1.RayCluster{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(cluster), storedCluster)
+	assert.Nil(t, err)
+	expectedHash, err := utils.GenerateJsonHash(rayService.Spec.ServeConfigV2)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHash, storedCluster.Annotations[utils.ServeConfigHashAnnotationKey])
+	assert.False(t, r.checkIfNeedSubmitServeDeployment(ctx, rayService.Spec.ServeConfigV2, storedCluster, &rayv1.RayServiceStatus{
+		Applications: map[string]rayv1.AppStatus{"myapp": {Status: rayv1.ApplicationStatusEnum.RUNNING}},
+	}))
+}
+
+func TestGetServeConfigV2(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "serve-config",
+			Namespace: namespace,
+		},
+		Data: map[string]string{"serve.yaml": "applications:\n- name: myapp\n  import_path: fruit.deployment_graph"},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(configMap).Build()
+	r := RayServiceReconciler{
+		Client: fakeClient,
+		Scheme: newScheme,
+	}
+	ctx := context.TODO()
+
+	// The Serve config is inlined in the spec.
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+		},
+		Spec: rayv1.RayServiceSpec{
+			ServeConfigV2: "applications: []",
+		},
+	}
+	serveConfigV2, err := r.getServeConfigV2(ctx, rayService)
+	assert.Nil(t, err)
+	assert.Equal(t, "applications: []", serveConfigV2)
+
+	// The Serve config is read from the ConfigMap.
+	rayService.Spec.ServeConfigV2 = ""
+	rayService.Spec.ServeConfigV2From = &corev1.ConfigMapKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"},
+		Key:                  "serve.yaml",
+	}
+	serveConfigV2, err = r.getServeConfigV2(ctx, rayService)
+	assert.Nil(t, err)
+	assert.Equal(t, configMap.Data["serve.yaml"], serveConfigV2)
+
+	// An update to the ConfigMap triggers a Serve config update.
+	serveConfigHash, err := utils.GenerateJsonHash(serveConfigV2)
+	assert.Nil(t, err)
+	cluster := &rayv1.RayCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-cluster",
+			Namespace:   namespace,
+			Annotations: map[string]string{utils.ServeConfigHashAnnotationKey: serveConfigHash},
+		},
+	}
+	serveStatus := &rayv1.RayServiceStatus{
+		Applications: map[string]rayv1.AppStatus{"myapp": {Status: rayv1.ApplicationStatusEnum.RUNNING}},
+	}
+	assert.False(t, r.checkIfNeedSubmitServeDeployment(ctx, serveConfigV2, cluster, serveStatus))
+	configMap.Data["serve.yaml"] = "applications:\n- name: new_app_name\n  import_path: fruit.deployment_graph"
+	err = fakeClient.Update(ctx, configMap)
+	assert.Nil(t, err)
+	serveConfigV2, err = r.getServeConfigV2(ctx, rayService)
+	assert.Nil(t, err)
+	assert.True(t, r.checkIfNeedSubmitServeDeployment(ctx, serveConfigV2, cluster, serveStatus))
+
+	// The Serve config in the ConfigMap is empty, which would delete all the Serve applications if it were applied.
+	configMap.Data["serve.yaml"] = "  \n"
+	err = fakeClient.Update(ctx, configMap)
+	assert.Nil(t, err)
+	_, err = r.getServeConfigV2(ctx, rayService)
+	assert.NotNil(t, err)
+
+	// The key doesn't exist in the ConfigMap.
+	rayService.Spec.ServeConfigV2From.Key = "missing.yaml"
+	_, err = r.getServeConfigV2(ctx, rayService)
+	assert.NotNil(t, err)
+
+	// The ConfigMap doesn't exist.
+	rayService.Spec.ServeConfigV2From.Name = "missing"
+	_, err = r.getServeConfigV2(ctx, rayService)
+	assert.NotNil(t, err)
+}
+
+func TestRayServicesForConfigMap(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+	_ = corev1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	newRayService := func(name string, configMapName string) *rayv1.RayService {
+		rayService := &rayv1.RayService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+		if configMapName != "" {
+			rayService.Spec.ServeConfigV2From = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+				Key:                  "serve.yaml",
+			}
+		}
+		return rayService
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(
+		newRayService("service-1", "serve-config"),
+		newRayService("service-2", "other-serve-config"),
+		newRayService("service-3", ""),
+	).WithIndex(&rayv1.RayService{}, rayServiceServeConfigMapIndexField, rayServiceServeConfigMapName).Build()
+	r := RayServiceReconciler{
+		Client: fakeClient,
+		Scheme: newScheme,
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "serve-config",
+			Namespace: namespace,
+		},
+	}
+	requests := r.rayServicesForConfigMap(context.TODO(), configMap)
+	assert.Equal(t, []reconcile.Request{{NamespacedName: client.ObjectKey{Namespace: namespace, Name: "service-1"}}}, requests)
+}
+
+func TestCleanUpRayClusterInstance(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	namespace := "ray"
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: namespace,
+		},
+		Status: rayv1.RayServiceStatuses{
+			ActiveServiceStatus: rayv1.RayServiceStatus{RayClusterName: "active-cluster"},
+			RayClusterDeletionTimestamps: map[string]metav1.Time{
+				"expired-cluster": metav1.NewTime(time.Now().Add(-time.Second)),
+				"deleted-cluster": metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+		},
+	}
+	newCluster := func(name string) *rayv1.RayCluster {
+		return &rayv1.RayCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					utils.RayOriginatedFromCRNameLabelKey: rayService.Name,
+					utils.RayOriginatedFromCRDLabelKey:    utils.RayOriginatedFromCRDLabelValue(utils.RayServiceCRD),
+				},
+			},
+		}
+	}
+	fakeClient := clientFake.NewClientBuilder().
+		WithScheme(newScheme).
+		WithRuntimeObjects(rayService, newCluster("active-cluster"), newCluster("dangling-cluster"), newCluster("expired-cluster")).
+		Build()
+	r := RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+	ctx := context.TODO()
+
+	err := r.cleanUpRayClusterInstance(ctx, rayService)
+	assert.Nil(t, err)
+
+	// The expired RayCluster is deleted, and the new dangling RayCluster is scheduled for deletion.
+	rayClusterList := rayv1.RayClusterList{}
+	err = fakeClient.List(ctx, &rayClusterList, client.InNamespace(namespace))
+	assert.Nil(t, err)
+	names := []string{}
+	for _, cluster := range rayClusterList.Items {
+		names = append(names, cluster.Name)
+	}
+	assert.ElementsMatch(t, []string{"active-cluster", "dangling-cluster"}, names)
+
+	// The deletion schedule is recorded in the in-memory RayService status, which `Reconcile` persists.
+	assert.Contains(t, rayService.Status.RayClusterDeletionTimestamps, "dangling-cluster")
+	assert.Contains(t, rayService.Status.RayClusterDeletionTimestamps, "expired-cluster")
+	assert.NotContains(t, rayService.Status.RayClusterDeletionTimestamps, "deleted-cluster")
+	assert.NotContains(t, rayService.Status.RayClusterDeletionTimestamps, "active-cluster")
+
+	// `cleanUpRayClusterInstance` doesn't update the RayService status in the middle of the reconciliation.
+	storedRayService := &rayv1.RayService{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), storedRayService)
+	assert.Nil(t, err)
+	assert.NotContains(t, storedRayService.Status.RayClusterDeletionTimestamps, "dangling-cluster")
+}
+
+func TestUpdateRayClusterDeletionTimestamps(t *testing.T) {
+	newScheme := runtime.NewScheme()
+	_ = rayv1.AddToScheme(newScheme)
+
+	rayService := &rayv1.RayService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: "ray",
+		},
+		Status: rayv1.RayServiceStatuses{
+			ActiveServiceStatus: rayv1.RayServiceStatus{RayClusterName: "active-cluster"},
+			RayClusterDeletionTimestamps: map[string]metav1.Time{
+				"deleted-cluster": metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+		},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithScheme(newScheme).WithRuntimeObjects(rayService).WithStatusSubresource(rayService).Build()
+	r := RayServiceReconciler{
+		Client:   fakeClient,
+		Recorder: &record.FakeRecorder{},
+		Scheme:   newScheme,
+	}
+	ctx := context.TODO()
+
+	// The reconciliation fails after the deletion schedule and the other status fields have changed in memory.
+	originalRayService := rayService.DeepCopy()
+	rayService.Status.ActiveServiceStatus = rayv1.RayServiceStatus{}
+	rayService.Status.RayClusterDeletionTimestamps = map[string]metav1.Time{
+		"dangling-cluster": metav1.NewTime(time.Now().Add(RayClusterDeletionDelayDuration)),
+	}
+	err := r.updateRayClusterDeletionTimestamps(ctx, originalRayService, rayService)
+	assert.Nil(t, err)
+
+	// Only the deletion schedule is persisted.
+	storedRayService := &rayv1.RayService{}
+	err = fakeClient.Get(ctx, client.ObjectKeyFromObject(rayService), storedRayService)
+	assert.Nil(t, err)
+	assert.Contains(t, storedRayService.Status.RayClusterDeletionTimestamps, "dangling-cluster")
+	assert.NotContains(t, storedRayService.Status.RayClusterDeletionTimestamps, "deleted-cluster")
+	assert.Equal(t, "active-cluster", storedRayService.Status.ActiveServiceStatus.RayClusterName)
+}
@@ -6,6 +6,8 @@ const (
 
 	// Default application name
 	DefaultServeAppName = "default"
+	// State of a Serve replica that is serving traffic
+	ServeReplicaStateRunning = "RUNNING"
 	// Belows used as label key
 
 	// RayOriginatedFromCRNameLabelKey and RayOriginatedFromCRDLabelKey are the labels used to associate the root KubeRay Custom Resource.
@@ -26,6 +28,39 @@ const (
 	HashWithoutReplicasAndWorkersToDeleteKey = "ray.io/hash-without-replicas-and-workers-to-delete"
 	NumWorkerGroupsKey                       = "ray.io/num-worker-groups"
 	KubeRayVersion                           = "ray.io/kuberay-version"
+	// RayPodTemplateHashKey is the annotation on worker Pods that records the hash of the worker group template
+	// the Pod was created from. It is used to detect outdated worker Pods when an upgrade strategy is configured.
+	RayPodTemplateHashKey = "ray.io/pod-template-hash"
+	// RayNodeDrainStartTimeAnnotationKey is the annotation on worker Pods that records when KubeRay asked the Ray node
+	// running in the Pod to drain. The Pod is deleted once the node is drained or the drain grace period has elapsed.
+	RayNodeDrainStartTimeAnnotationKey = "ray.io/drain-start-time"
+	// RayWorkerReplicaIndexKey is the label on the worker Pods of a multi-host worker group that records the replica
+	// the Pod belongs to. All the hosts of a replica share the same value and are created and deleted together.
+	RayWorkerReplicaIndexKey = "ray.io/worker-group-replica-index"
+	// RayHostIndexKey is the label on the worker Pods of a multi-host worker group that records the index of the host
+	// within its replica.
+	RayHostIndexKey = "ray.io/replica-host-index"
+	// RayCronJobScheduledTimestampAnnotationKey is the annotation on the RayJobs created by a RayCronJob that records
+	// the scheduled time of the run in RFC3339 format.
+	RayCronJobScheduledTimestampAnnotationKey = "ray.io/cronjob-scheduled-timestamp"
+	// RayClusterPoolClaimedByAnnotationKey is the annotation on a RayCluster of a RayClusterPool that records the name
+	// of the RayJob that claimed it. The RayCluster is idle if the annotation isn't set.
+	RayClusterPoolClaimedByAnnotationKey = "ray.io/claimed-by"
+	// RayClusterPoolTemplateHashAnnotationKey is the annotation on a RayCluster of a RayClusterPool that records the
+	// hash of the pool template the RayCluster was created from.
+	RayClusterPoolTemplateHashAnnotationKey = "ray.io/cluster-pool-template-hash"
+	// RayServiceTrafficLabelKey is the label on the serving Pods of a RayService with the IncrementalUpgrade strategy
+	// that receive traffic from the serve Service. Its value is the name of the RayService.
+	RayServiceTrafficLabelKey = "ray.io/serve-traffic"
+	// RayServiceRollbackAnnotationKey is the annotation that asks KubeRay to restore the RayCluster that was active before
+	// the last upgrade of a RayService if its value is "true". KubeRay removes the annotation once it's handled.
+	RayServiceRollbackAnnotationKey = "ray.io/rollback"
+	// ServeConfigHashAnnotationKey is the annotation on a RayCluster of a RayService that records the hash of the Serve
+	// config last applied to the RayCluster.
+	ServeConfigHashAnnotationKey = "ray.io/serve-config-hash"
+	// HashWithoutWorkerGroupsKey is the annotation on a RayCluster of a RayService that records the hash of the
+	// RayCluster spec without the worker groups. It is used to detect changes that only affect the worker groups.
+	HashWithoutWorkerGroupsKey = "ray.io/hash-without-worker-groups"
 
 	// In KubeRay, the Ray container must be the first application container in a head or worker Pod.
 	RayContainerIndex = 0
@@ -144,6 +179,20 @@ const (
 	// If set to true, the RayJob CR itself will be deleted if shutdownAfterJobFinishes is set to true. Note that all resources created by the RayJob CR will be deleted, including the K8s Job.
 	DELETE_RAYJOB_CR_AFTER_JOB_FINISHES = "DELETE_RAYJOB_CR_AFTER_JOB_FINISHES"
 
+	// If RAYCLUSTER_HEAD_RESTART_BACKOFF_SECONDS is set, the KubeRay operator waits before restarting a failed head Pod
+	// again if the previous restart was recent. The wait starts at RAYCLUSTER_HEAD_RESTART_BACKOFF_SECONDS and doubles
+	// with every restart of the head Pod, up to RAYCLUSTER_HEAD_RESTART_MAX_BACKOFF_SECONDS. By default, failed head
+	// Pods are restarted right away.
+	RAYCLUSTER_HEAD_RESTART_BACKOFF_SECONDS     = "RAYCLUSTER_HEAD_RESTART_BACKOFF_SECONDS"
+	RAYCLUSTER_HEAD_RESTART_MAX_BACKOFF_SECONDS = "RAYCLUSTER_HEAD_RESTART_MAX_BACKOFF_SECONDS"
+	DefaultHeadRestartBackoffSeconds            = 0
+	DefaultHeadRestartMaxBackoffSeconds         = 300
+
+	// WorkerReplicaCreationGracePeriodSeconds is how long a multi-host replica that misses some of its hosts is
+	// considered to be still being created, because the informer cache may not contain all of its Pods yet.
+	// Afterwards, the replica is considered incomplete and recreated.
+	WorkerReplicaCreationGracePeriodSeconds = 30
+
 	// Ray core default configurations
 	DefaultWorkerRayGcsReconnectTimeoutS = "600"
 
@@ -245,25 +294,62 @@ const (
 	FailedToCreateWorkerPod K8sEventType = "FailedToCreateWorkerPod"
 	DeletedWorkerPod        K8sEventType = "DeletedWorkerPod"
 	FailedToDeleteWorkerPod K8sEventType = "FailedToDeleteWorkerPod"
+	DrainingWorkerPod       K8sEventType = "DrainingWorkerPod"
+	DrainedWorkerPod        K8sEventType = "DrainedWorkerPod"
+	FailedToDrainWorkerPod  K8sEventType = "FailedToDrainWorkerPod"
 
 	// Redis Cleanup Job event list
 	CreatedRedisCleanupJob        K8sEventType = "CreatedRedisCleanupJob"
 	FailedToCreateRedisCleanupJob K8sEventType = "FailedToCreateRedisCleanupJob"
 
 	// RayJob event list
-	InvalidRayJobSpec             K8sEventType = "InvalidRayJobSpec"
-	InvalidRayJobStatus           K8sEventType = "InvalidRayJobStatus"
-	CreatedRayJobSubmitter        K8sEventType = "CreatedRayJobSubmitter"
-	DeletedRayJobSubmitter        K8sEventType = "DeletedRayJobSubmitter"
-	FailedToCreateRayJobSubmitter K8sEventType = "FailedToCreateRayJobSubmitter"
-	FailedToDeleteRayJobSubmitter K8sEventType = "FailedToDeleteRayJobSubmitter"
-	CreatedRayCluster             K8sEventType = "CreatedRayCluster"
-	DeletedRayCluster             K8sEventType = "DeletedRayCluster"
-	FailedToCreateRayCluster      K8sEventType = "FailedToCreateRayCluster"
-	FailedToDeleteRayCluster      K8sEventType = "FailedToDeleteRayCluster"
+	InvalidRayJobSpec               K8sEventType = "InvalidRayJobSpec"
+	InvalidRayJobStatus             K8sEventType = "InvalidRayJobStatus"
+	CreatedRayJobSubmitter          K8sEventType = "CreatedRayJobSubmitter"
+	DeletedRayJobSubmitter          K8sEventType = "DeletedRayJobSubmitter"
+	FailedToCreateRayJobSubmitter   K8sEventType = "FailedToCreateRayJobSubmitter"
+	FailedToDeleteRayJobSubmitter   K8sEventType = "FailedToDeleteRayJobSubmitter"
+	CreatedRayCluster               K8sEventType = "CreatedRayCluster"
+	DeletedRayCluster               K8sEventType = "DeletedRayCluster"
+	FailedToCreateRayCluster        K8sEventType = "FailedToCreateRayCluster"
+	FailedToDeleteRayCluster        K8sEventType = "FailedToDeleteRayCluster"
+	DeletedRayClusterWorkers        K8sEventType = "DeletedRayClusterWorkers"
+	FailedToDeleteRayClusterWorkers K8sEventType = "FailedToDeleteRayClusterWorkers"
+	RetainedRayJobLogs              K8sEventType = "RetainedRayJobLogs"
+	FailedToRetainRayJobLogs        K8sEventType = "FailedToRetainRayJobLogs"
+	RayClusterPodImagePullBackOff   K8sEventType = "RayClusterPodImagePullBackOff"
+	RayClusterPodUnschedulable      K8sEventType = "RayClusterPodUnschedulable"
+	RayClusterPodOOMKilled          K8sEventType = "RayClusterPodOOMKilled"
+	RayClusterHeadPodCrashLoop      K8sEventType = "RayClusterHeadPodCrashLoop"
+	RayClusterProvisioningTimeout   K8sEventType = "RayClusterProvisioningTimeout"
+	RayJobSubmitterPodFailed        K8sEventType = "RayJobSubmitterPodFailed"
+	RayJobDependencyNotFound        K8sEventType = "RayJobDependencyNotFound"
+
+	// RayCronJob event list
+	InvalidRayCronJobSpec K8sEventType = "InvalidRayCronJobSpec"
+	CreatedRayJob         K8sEventType = "CreatedRayJob"
+	DeletedRayJob         K8sEventType = "DeletedRayJob"
+	FailedToCreateRayJob  K8sEventType = "FailedToCreateRayJob"
+	FailedToDeleteRayJob  K8sEventType = "FailedToDeleteRayJob"
+	MissedSchedule        K8sEventType = "MissedSchedule"
+	RayJobAlreadyActive   K8sEventType = "RayJobAlreadyActive"
+
+	// RayJobSet event list
+	InvalidRayJobSetSpec K8sEventType = "InvalidRayJobSetSpec"
+
+	// RayClusterPool event list
+	InvalidRayClusterPoolSpec K8sEventType = "InvalidRayClusterPoolSpec"
+	ClaimedRayCluster         K8sEventType = "ClaimedRayCluster"
+	ReleasedRayCluster        K8sEventType = "ReleasedRayCluster"
 
 	// RayService event list
 	InvalidRayServiceSpec K8sEventType = "InvalidRayServiceSpec"
+	MigratedTraffic       K8sEventType = "MigratedTraffic"
+	TrafficStepNotHonored K8sEventType = "TrafficStepNotHonored"
+	UpgradeRolledBack     K8sEventType = "UpgradeRolledBack"
+	FailedToRollBack      K8sEventType = "FailedToRollBack"
+	UpdatedWorkerGroups   K8sEventType = "UpdatedWorkerGroups"
+	PreparingRayCluster   K8sEventType = "PreparingRayCluster"
 
 	// Generic Pod event list
 	DeletedPod        K8sEventType = "DeletedPod"
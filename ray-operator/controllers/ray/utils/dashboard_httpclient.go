@@ -28,6 +28,15 @@ var (
 	DeployPathV2     = "/api/serve/applications/"
 	// Job URL paths
 	JobPath = "/api/jobs/"
+	// Node URL paths
+	NodesSummaryPath = "/nodes?view=summary"
+)
+
+const (
+	// RayNodeStateDead is the state of a Ray node whose Raylet has exited, e.g. after the node was drained.
+	RayNodeStateDead = "DEAD"
+	// RayNodeSnapshotStateIdle is the snapshot state of an alive Ray node that is not running any tasks or actors.
+	RayNodeSnapshotStateIdle = "IDLE"
 )
 
 type RayDashboardClientInterface interface {
@@ -43,6 +52,7 @@ type RayDashboardClientInterface interface {
 	GetJobLog(ctx context.Context, jobName string) (*string, error)
 	StopJob(ctx context.Context, jobName string) error
 	DeleteJob(ctx context.Context, jobName string) error
+	ListNodes(ctx context.Context) ([]RayNodeInfo, error)
 }
 
 type BaseDashboardClient struct {
@@ -449,6 +459,68 @@ func (r *RayDashboardClient) DeleteJob(ctx context.Context, jobName string) erro
 	return nil
 }
 
+// RayNodeInfo is the Raylet information of a Ray node returned by the dashboard's node summary API.
+// It is the JSON representation of the GcsNodeInfo protobuf message.
+// Reference to https://github.com/ray-project/ray/blob/ray-2.34.0/src/ray/protobuf/gcs.proto
+type RayNodeInfo struct {
+	NodeId        string               `json:"nodeId"`
+	NodeIP        string               `json:"nodeManagerAddress"`
+	State         string               `json:"state"`
+	StateSnapshot RayNodeStateSnapshot `json:"stateSnapshot"`
+}
+
+// RayNodeStateSnapshot is the state of an alive Ray node reported by its Raylet.
+type RayNodeStateSnapshot struct {
+	// State is one of IDLE, ACTIVE and DRAINING.
+	State string `json:"state,omitempty"`
+	// IdleDurationMs is how long the node has been idle. It is 0 if the node is running tasks or actors.
+	// int64 fields are encoded as strings in the JSON representation of protobuf messages.
+	IdleDurationMs int64 `json:"idleDurationMs,string,omitempty"`
+}
+
+type RayNodeSummaryResponse struct {
+	Msg  string `json:"msg"`
+	Data struct {
+		Summary []struct {
+			Raylet RayNodeInfo `json:"raylet"`
+		} `json:"summary"`
+	} `json:"data"`
+	Result bool `json:"result"`
+}
+
+// ListNodes returns the Raylet information of all Ray nodes known to the dashboard, including the dead ones.
+func (r *RayDashboardClient) ListNodes(ctx context.Context) ([]RayNodeInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.dashboardURL+NodesSummaryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("ListNodes fail: %s %s", resp.Status, string(body))
+	}
+
+	var summary RayNodeSummaryResponse
+	if err = json.Unmarshal(body, &summary); err != nil {
+		return nil, fmt.Errorf("ListNodes fail: %s", string(body))
+	}
+	if !summary.Result {
+		return nil, fmt.Errorf("ListNodes fail: %s", summary.Msg)
+	}
+
+	nodes := make([]RayNodeInfo, 0, len(summary.Data.Summary))
+	for _, node := range summary.Data.Summary {
+		nodes = append(nodes, node.Raylet)
+	}
+	return nodes, nil
+}
+
 func ConvertRayJobToReq(rayJob *rayv1.RayJob) (*RayJobRequest, error) {
 	req := &RayJobRequest{
 		Entrypoint:   rayJob.Spec.Entrypoint,
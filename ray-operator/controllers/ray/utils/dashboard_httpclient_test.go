@@ -28,6 +28,88 @@ conda:
 eager_install: false`
 )
 
+// nodesSummaryStr is a response of the node summary API of Ray 2.34 for a head node, an idle worker node, a busy
+// worker node and a dead worker node. Fields the operator doesn't use are trimmed.
+const nodesSummaryStr = `{
+  "result": true,
+  "msg": "Node summary fetched.",
+  "data": {
+    "summary": [
+      {
+        "now": 1723000000.123,
+        "hostname": "raycluster-head-abcde",
+        "ip": "10.0.0.1",
+        "cpu": 12.5,
+        "raylet": {
+          "nodeId": "b1c9d3b3e5b34c2f6a3a4de3a1c5b0f6b0a3e1f2c4d5e6f7a8b9c0d1",
+          "nodeManagerAddress": "10.0.0.1",
+          "nodeManagerPort": 37125,
+          "objectManagerPort": 41231,
+          "nodeName": "10.0.0.1",
+          "state": "ALIVE",
+          "isHeadNode": true,
+          "startTimeMs": "1722990000000",
+          "endTimeMs": "0",
+          "labels": {"ray.io/node_id": "b1c9d3b3e5b34c2f6a3a4de3a1c5b0f6b0a3e1f2c4d5e6f7a8b9c0d1"},
+          "stateSnapshot": {"state": "ACTIVE", "nodeActivity": ["Resource: CPU currently in use."]},
+          "stateMessage": null
+        }
+      },
+      {
+        "now": 1723000000.456,
+        "hostname": "raycluster-worker-fghij",
+        "ip": "10.0.0.2",
+        "cpu": 0.5,
+        "raylet": {
+          "nodeId": "c2d0e4c4f6c45d307b4b5ef4b2d6c107c1b4f203d5e6f708b9c0d1e2",
+          "nodeManagerAddress": "10.0.0.2",
+          "nodeManagerPort": 38125,
+          "nodeName": "10.0.0.2",
+          "state": "ALIVE",
+          "isHeadNode": false,
+          "startTimeMs": "1722990060000",
+          "endTimeMs": "0",
+          "stateSnapshot": {"state": "IDLE", "idleDurationMs": "615342"},
+          "stateMessage": null
+        }
+      },
+      {
+        "now": 1723000000.789,
+        "hostname": "raycluster-worker-klmno",
+        "ip": "10.0.0.3",
+        "cpu": 98.0,
+        "raylet": {
+          "nodeId": "d3e1f5d507d56e418c5c6f05c3e7d218d2c50314e6f708192c0d1e2f",
+          "nodeManagerAddress": "10.0.0.3",
+          "nodeManagerPort": 39125,
+          "nodeName": "10.0.0.3",
+          "state": "ALIVE",
+          "isHeadNode": false,
+          "startTimeMs": "1722990120000",
+          "endTimeMs": "0",
+          "stateSnapshot": {"state": "ACTIVE", "nodeActivity": ["Resource: CPU currently in use."]},
+          "stateMessage": null
+        }
+      },
+      {
+        "raylet": {
+          "nodeId": "e4f206e618e67f529d6d7016d4f8e329e3d61425f708192a3d0e1f20",
+          "nodeManagerAddress": "10.0.0.4",
+          "nodeManagerPort": 40125,
+          "nodeName": "10.0.0.4",
+          "state": "DEAD",
+          "isHeadNode": false,
+          "startTimeMs": "1722990180000",
+          "endTimeMs": "1722999000000",
+          "deathInfo": {"reason": "EXPECTED_TERMINATION", "reasonMessage": "received SIGTERM"},
+          "stateSnapshot": {},
+          "stateMessage": null
+        }
+      }
+    ]
+  }
+}`
+
 var _ = Describe("RayFrameworkGenerator", func() {
 	var (
 		rayJob             *rayv1.RayJob
@@ -171,4 +253,67 @@ var _ = Describe("RayFrameworkGenerator", func() {
 		err := rayDashboardClient.StopJob(context.TODO(), "stop-job-1")
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	It("Test list nodes", func() {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("GET", rayDashboardClient.dashboardURL+NodesSummaryPath,
+			httpmock.NewStringResponder(200, nodesSummaryStr))
+
+		nodes, err := rayDashboardClient.ListNodes(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodes).To(Equal([]RayNodeInfo{
+			{
+				NodeId:        "b1c9d3b3e5b34c2f6a3a4de3a1c5b0f6b0a3e1f2c4d5e6f7a8b9c0d1",
+				NodeIP:        "10.0.0.1",
+				State:         "ALIVE",
+				StateSnapshot: RayNodeStateSnapshot{State: "ACTIVE"},
+			},
+			{
+				NodeId:        "c2d0e4c4f6c45d307b4b5ef4b2d6c107c1b4f203d5e6f708b9c0d1e2",
+				NodeIP:        "10.0.0.2",
+				State:         "ALIVE",
+				StateSnapshot: RayNodeStateSnapshot{State: RayNodeSnapshotStateIdle, IdleDurationMs: 615342},
+			},
+			{
+				NodeId:        "d3e1f5d507d56e418c5c6f05c3e7d218d2c50314e6f708192c0d1e2f",
+				NodeIP:        "10.0.0.3",
+				State:         "ALIVE",
+				StateSnapshot: RayNodeStateSnapshot{State: "ACTIVE"},
+			},
+			{
+				NodeId: "e4f206e618e67f529d6d7016d4f8e329e3d61425f708192a3d0e1f20",
+				NodeIP: "10.0.0.4",
+				State:  RayNodeStateDead,
+			},
+		}))
+	})
+
+	It("Test get multi-application status with replicas", func() {
+		httpmock.Activate()
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder("GET", rayDashboardClient.dashboardURL+ServeDetailsPath,
+			httpmock.NewStringResponder(200, `{"deploy_mode": "MULTI_APP", "applications": {"fruit_app": {
+				"name": "fruit_app", "route_prefix": "/fruit", "docs_path": null, "status": "RUNNING", "message": "",
+				"last_deployed_time_s": 1700000000.5, "deployments": {"MangoStand": {
+					"name": "MangoStand", "status": "UPSCALING", "message": "", "target_num_replicas": 3,
+					"deployment_config": {"name": "MangoStand", "num_replicas": null, "autoscaling_config": {"min_replicas": 1, "max_replicas": 3}},
+					"replicas": [{"replica_id": "r1", "state": "RUNNING"}, {"replica_id": "r2", "state": "RUNNING"}, {"replica_id": "r3", "state": "STARTING"}]}}}}}`))
+
+		statuses, err := rayDashboardClient.GetMultiApplicationStatus(context.TODO())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statuses).To(HaveKey("fruit_app"))
+		app := statuses["fruit_app"]
+		Expect(app.RoutePrefix).To(Equal("/fruit"))
+		Expect(app.LastDeployedTimeS).To(Equal(1700000000.5))
+		Expect(app.Deployments).To(HaveKey("MangoStand"))
+		deployment := app.Deployments["MangoStand"]
+		Expect(deployment.TargetNumReplicas).To(HaveValue(Equal(int32(3))))
+		Expect(deployment.DeploymentConfig.AutoscalingConfig).To(Equal(&ServeAutoscalingConfig{MinReplicas: 1, MaxReplicas: 3}))
+		Expect(deployment.Replicas).To(Equal([]ServeReplicaDetails{
+			{ReplicaId: "r1", State: ServeReplicaStateRunning},
+			{ReplicaId: "r2", State: ServeReplicaStateRunning},
+			{ReplicaId: "r3", State: "STARTING"},
+		}))
+	})
 })
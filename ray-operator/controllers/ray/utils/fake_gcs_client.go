@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type FakeRayGcsClient struct {
+	drainedNodes []string
+	gcsAddresses []string
+	mu           sync.Mutex
+}
+
+var _ RayGcsClientInterface = (*FakeRayGcsClient)(nil)
+
+func (r *FakeRayGcsClient) DrainNode(_ context.Context, gcsAddress string, nodeId string, _ time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.drainedNodes = append(r.drainedNodes, nodeId)
+	r.gcsAddresses = append(r.gcsAddresses, gcsAddress)
+	return nil
+}
+
+func (r *FakeRayGcsClient) GetDrainedNodes() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.drainedNodes...)
+}
+
+func (r *FakeRayGcsClient) GetGcsAddresses() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.gcsAddresses...)
+}
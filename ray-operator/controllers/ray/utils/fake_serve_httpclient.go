@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 	"sync/atomic"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
@@ -14,6 +15,8 @@ type FakeRayDashboardClient struct {
 	GetJobInfoMock   atomic.Pointer[func(context.Context, string) (*RayJobInfo, error)]
 	BaseDashboardClient
 	serveDetails ServeDetails
+	nodes        []RayNodeInfo
+	mu           sync.Mutex
 }
 
 var _ RayDashboardClientInterface = (*FakeRayDashboardClient)(nil)
@@ -79,3 +82,15 @@ func (r *FakeRayDashboardClient) StopJob(_ context.Context, _ string) (err error
 func (r *FakeRayDashboardClient) DeleteJob(_ context.Context, _ string) error {
 	return nil
 }
+
+func (r *FakeRayDashboardClient) ListNodes(_ context.Context) ([]RayNodeInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RayNodeInfo(nil), r.nodes...), nil
+}
+
+func (r *FakeRayDashboardClient) SetNodes(nodes []RayNodeInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nodes = nodes
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protowire"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// DrainNodeMethod is the full name of the DrainNode RPC of the GCS autoscaler state service.
+	// Reference to https://github.com/ray-project/ray/blob/ray-2.34.0/src/ray/protobuf/autoscaler.proto
+	DrainNodeMethod = "/ray.rpc.autoscaler.AutoscalerStateService/DrainNode"
+	// DrainNodeReasonPreemption asks Ray to drain the node even if it is not idle. Ray stops scheduling new
+	// work on the node and lets the running tasks and actors finish until the drain deadline.
+	DrainNodeReasonPreemption = 2
+	// DrainNodeTimeout bounds a DrainNode RPC so that an unreachable GCS server doesn't block the reconciliation.
+	DrainNodeTimeout = 5 * time.Second
+)
+
+// RayGcsClientInterface calls the RPCs of the Ray GCS server that are not exposed by the Ray dashboard.
+type RayGcsClientInterface interface {
+	DrainNode(ctx context.Context, gcsAddress string, nodeId string, deadline time.Time) error
+}
+
+func NewRayGcsClient() RayGcsClientInterface {
+	return &RayGcsClient{}
+}
+
+type RayGcsClient struct{}
+
+// DrainNode asks the GCS server at gcsAddress to drain the node with the given hex ID. The Raylet stops
+// accepting new work and exits once it becomes idle. The node is expected to be terminated by the caller
+// if it is still alive at the deadline.
+func (r *RayGcsClient) DrainNode(ctx context.Context, gcsAddress string, nodeId string, deadline time.Time) error {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Drain a Ray node", "nodeId", nodeId, "deadline", deadline)
+
+	nodeIdBytes, err := hex.DecodeString(nodeId)
+	if err != nil {
+		return fmt.Errorf("invalid Ray node ID %s: %w", nodeId, err)
+	}
+
+	conn, err := grpc.NewClient(gcsAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req := marshalDrainNodeRequest(nodeIdBytes, "The KubeRay operator is going to delete the worker Pod", deadline)
+	var reply []byte
+	ctx, cancel := context.WithTimeout(ctx, DrainNodeTimeout)
+	defer cancel()
+	if err := conn.Invoke(ctx, DrainNodeMethod, &req, &reply, grpc.ForceCodec(rawCodec{})); err != nil {
+		return fmt.Errorf("DrainNode fail: %w", err)
+	}
+
+	isAccepted, rejectionReasonMessage, err := unmarshalDrainNodeReply(reply)
+	if err != nil {
+		return fmt.Errorf("DrainNode fail: %w", err)
+	}
+	if !isAccepted {
+		return fmt.Errorf("DrainNode of %s is rejected: %s", nodeId, rejectionReasonMessage)
+	}
+	return nil
+}
+
+// marshalDrainNodeRequest encodes a ray.rpc.autoscaler.DrainNodeRequest. The message is encoded by hand
+// because the operator does not vendor the Ray protobuf definitions.
+func marshalDrainNodeRequest(nodeId []byte, reasonMessage string, deadline time.Time) []byte {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendBytes(b, nodeId)
+	b = protowire.AppendTag(b, 2, protowire.VarintType)
+	b = protowire.AppendVarint(b, DrainNodeReasonPreemption)
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendString(b, reasonMessage)
+	b = protowire.AppendTag(b, 4, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(deadline.UnixMilli()))
+	return b
+}
+
+// unmarshalDrainNodeReply decodes a ray.rpc.autoscaler.DrainNodeReply and skips unknown fields.
+func unmarshalDrainNodeReply(b []byte) (isAccepted bool, rejectionReasonMessage string, err error) {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return false, "", protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return false, "", protowire.ParseError(n)
+			}
+			isAccepted = protowire.DecodeBool(v)
+			b = b[n:]
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			if n < 0 {
+				return false, "", protowire.ParseError(n)
+			}
+			rejectionReasonMessage = v
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return false, "", protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+	return isAccepted, rejectionReasonMessage, nil
+}
+
+// rawCodec passes already encoded protobuf messages through gRPC.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return nil, fmt.Errorf("rawCodec: unexpected message type %T", v)
+	}
+	return *b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("rawCodec: unexpected message type %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// startFakeGcsServer serves the DrainNode RPC with the given handler and returns the address of the server.
+func startFakeGcsServer(t *testing.T, handler func(req []byte) []byte) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer(
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+			if method, _ := grpc.MethodFromServerStream(stream); method != DrainNodeMethod {
+				return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+			}
+			var req []byte
+			if err := stream.RecvMsg(&req); err != nil {
+				return err
+			}
+			reply := handler(req)
+			return stream.SendMsg(&reply)
+		}),
+	)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func TestDrainNode(t *testing.T) {
+	nodeId := "b1c9d3b3e5b34c2f6a3a4de3a1c5b0f6b0a3e1f2c4d5e6f7a8b9c0d1"
+	deadline := time.Now().Add(time.Minute)
+
+	gcsAddress := startFakeGcsServer(t, func(req []byte) []byte {
+		expectedNodeId, _ := hex.DecodeString(nodeId)
+		var reply []byte
+		if string(req) == string(marshalDrainNodeRequest(expectedNodeId, "The KubeRay operator is going to delete the worker Pod", deadline)) {
+			reply = protowire.AppendTag(reply, 1, protowire.VarintType)
+			reply = protowire.AppendVarint(reply, 1)
+		} else {
+			reply = protowire.AppendTag(reply, 2, protowire.BytesType)
+			reply = protowire.AppendString(reply, "unexpected request")
+		}
+		// Unknown fields in the reply are ignored.
+		reply = protowire.AppendTag(reply, 100, protowire.VarintType)
+		reply = protowire.AppendVarint(reply, 1)
+		return reply
+	})
+
+	gcsClient := NewRayGcsClient()
+	err := gcsClient.DrainNode(context.Background(), gcsAddress, nodeId, deadline)
+	assert.Nil(t, err)
+
+	err = gcsClient.DrainNode(context.Background(), gcsAddress, nodeId, deadline.Add(time.Minute))
+	assert.ErrorContains(t, err, "unexpected request")
+
+	err = gcsClient.DrainNode(context.Background(), gcsAddress, "not-a-hex-id", deadline)
+	assert.ErrorContains(t, err, "invalid Ray node ID")
+}
+
+func TestMarshalDrainNodeRequest(t *testing.T) {
+	deadline := time.UnixMilli(1700000000000)
+	req := marshalDrainNodeRequest([]byte{0xab, 0xcd}, "reason", deadline)
+
+	fields := map[protowire.Number][]byte{}
+	for len(req) > 0 {
+		num, typ, n := protowire.ConsumeTag(req)
+		assert.Greater(t, n, 0)
+		req = req[n:]
+		n = protowire.ConsumeFieldValue(num, typ, req)
+		assert.Greater(t, n, 0)
+		fields[num] = req[:n]
+		req = req[n:]
+	}
+
+	nodeId, _ := protowire.ConsumeBytes(fields[1])
+	assert.Equal(t, []byte{0xab, 0xcd}, nodeId)
+	reason, _ := protowire.ConsumeVarint(fields[2])
+	assert.Equal(t, uint64(DrainNodeReasonPreemption), reason)
+	reasonMessage, _ := protowire.ConsumeString(fields[3])
+	assert.Equal(t, "reason", reasonMessage)
+	deadlineMs, _ := protowire.ConsumeVarint(fields[4])
+	assert.Equal(t, uint64(deadline.UnixMilli()), deadlineMs)
+}
@@ -8,32 +8,54 @@ package utils
 // be returned by the GetMultiApplicationStatus method of the dashboard client
 // Describes the status of a deployment
 type ServeDeploymentStatus struct {
-	Name    string `json:"name,omitempty"`
-	Status  string `json:"status,omitempty"`
-	Message string `json:"message,omitempty"`
+	DeploymentConfig  ServeDeploymentConfig `json:"deployment_config,omitempty"`
+	TargetNumReplicas *int32                `json:"target_num_replicas,omitempty"`
+	Name              string                `json:"name,omitempty"`
+	Status            string                `json:"status,omitempty"`
+	Message           string                `json:"message,omitempty"`
+	Replicas          []ServeReplicaDetails `json:"replicas,omitempty"`
+}
+
+// Describes the config that a deployment is running with. Only the fields that KubeRay reports are listed.
+type ServeDeploymentConfig struct {
+	AutoscalingConfig *ServeAutoscalingConfig `json:"autoscaling_config,omitempty"`
+	NumReplicas       *int32                  `json:"num_replicas,omitempty"`
+}
+
+// Describes the replica bounds of an autoscaling deployment
+type ServeAutoscalingConfig struct {
+	MinReplicas int32 `json:"min_replicas"`
+	MaxReplicas int32 `json:"max_replicas"`
+}
+
+// Describes a single replica of a deployment
+type ServeReplicaDetails struct {
+	ReplicaId string `json:"replica_id,omitempty"`
+	State     string `json:"state,omitempty"`
 }
 
 // Describes the status of an application
 type ServeApplicationStatus struct {
-	Deployments map[string]ServeDeploymentStatus `json:"deployments"`
-	Name        string                           `json:"name,omitempty"`
-	Status      string                           `json:"status"`
-	Message     string                           `json:"message,omitempty"`
+	Deployments       map[string]ServeDeploymentStatus `json:"deployments"`
+	Name              string                           `json:"name,omitempty"`
+	Status            string                           `json:"status"`
+	Message           string                           `json:"message,omitempty"`
+	RoutePrefix       string                           `json:"route_prefix,omitempty"`
+	LastDeployedTimeS float64                          `json:"last_deployed_time_s,omitempty"`
 }
 
 // V2 Serve API Response format. These extend the ServeDeploymentStatus and ServeApplicationStatus structs,
-// but contain more information such as route prefix because the V2/multi-app GET API fetchs general metadata,
+// but contain more information such as the docs path because the V2/multi-app GET API fetchs general metadata,
 // not just statuses.
 type ServeDeploymentDetails struct {
-	ServeDeploymentStatus
 	RoutePrefix string `json:"route_prefix,omitempty"`
+	ServeDeploymentStatus
 }
 
 type ServeApplicationDetails struct {
 	Deployments map[string]ServeDeploymentDetails `json:"deployments"`
+	DocsPath    string                            `json:"docs_path,omitempty"`
 	ServeApplicationStatus
-	RoutePrefix string `json:"route_prefix,omitempty"`
-	DocsPath    string `json:"docs_path,omitempty"`
 }
 
 type ServeDetails struct {
@@ -22,8 +22,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/rand"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 )
@@ -39,9 +42,12 @@ const (
 type CRDType string
 
 const (
-	RayClusterCRD CRDType = "RayCluster"
-	RayJobCRD     CRDType = "RayJob"
-	RayServiceCRD CRDType = "RayService"
+	RayClusterCRD     CRDType = "RayCluster"
+	RayJobCRD         CRDType = "RayJob"
+	RayServiceCRD     CRDType = "RayService"
+	RayCronJobCRD     CRDType = "RayCronJob"
+	RayJobSetCRD      CRDType = "RayJobSet"
+	RayClusterPoolCRD CRDType = "RayClusterPool"
 )
 
 var crdMap = map[string]CRDType{
@@ -144,6 +150,67 @@ func IsRunningAndReady(pod *corev1.Pod) bool {
 	return false
 }
 
+// IsIncrementalUpgradeEnabled returns whether the RayService shifts the traffic to the new RayCluster in steps during upgrades.
+func IsIncrementalUpgradeEnabled(spec *rayv1.RayServiceSpec) bool {
+	return spec.UpgradeStrategy != nil && *spec.UpgradeStrategy == rayv1.IncrementalUpgrade
+}
+
+// DiagnosePodFailure inspects the conditions and the container statuses of a Pod and returns the reason and a
+// human-readable message if the Pod is stuck because of a failure that KubeRay can classify. It returns an empty
+// reason if no such failure is found. The reason is one of the reasons of the RayCluster ReplicaFailure condition, and
+// the `HeadPodCrashLoopBackOff` reason is only returned for the head Pod.
+func DiagnosePodFailure(pod *corev1.Pod) (string, string) {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return rayv1.PodUnschedulable, fmt.Sprintf("Pod %s/%s is unschedulable: %s", pod.Namespace, pod.Name, cond.Message)
+		}
+	}
+
+	isHeadPod := pod.Labels[RayNodeTypeLabelKey] == string(rayv1.HeadNode)
+	containerStatuses := make([]corev1.ContainerStatus, 0, len(pod.Status.InitContainerStatuses)+len(pod.Status.ContainerStatuses))
+	containerStatuses = append(containerStatuses, pod.Status.InitContainerStatuses...)
+	containerStatuses = append(containerStatuses, pod.Status.ContainerStatuses...)
+	for _, status := range containerStatuses {
+		if waiting := status.State.Waiting; waiting != nil && (waiting.Reason == "ImagePullBackOff" || waiting.Reason == "ErrImagePull") {
+			return rayv1.PodImagePullBackOff, fmt.Sprintf("Container %s of Pod %s/%s failed to pull image %s: %s", status.Name, pod.Namespace, pod.Name, status.Image, waiting.Message)
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.Reason == "OOMKilled" {
+			return rayv1.PodOOMKilled, fmt.Sprintf("Container %s of Pod %s/%s was OOMKilled", status.Name, pod.Namespace, pod.Name)
+		}
+		// A container that was OOMKilled and has become ready again has recovered, so only the
+		// containers that are still not ready are taken into account.
+		if terminated := status.LastTerminationState.Terminated; !status.Ready && terminated != nil && terminated.Reason == "OOMKilled" {
+			return rayv1.PodOOMKilled, fmt.Sprintf("Container %s of Pod %s/%s was OOMKilled and has restarted %d times", status.Name, pod.Namespace, pod.Name, status.RestartCount)
+		}
+		if waiting := status.State.Waiting; isHeadPod && waiting != nil && waiting.Reason == "CrashLoopBackOff" {
+			return rayv1.HeadPodCrashLoopBackOff, fmt.Sprintf("Container %s of head Pod %s/%s is in CrashLoopBackOff and has restarted %d times: %s", status.Name, pod.Namespace, pod.Name, status.RestartCount, waiting.Message)
+		}
+	}
+	return "", ""
+}
+
+// DiagnoseRayClusterPodsFailure returns the first failure found by DiagnosePodFailure in the Pods of a RayCluster.
+// The head Pod is inspected first because the RayCluster can't work without it.
+func DiagnoseRayClusterPodsFailure(pods []corev1.Pod) (string, string) {
+	for i := range pods {
+		if pods[i].Labels[RayNodeTypeLabelKey] != string(rayv1.HeadNode) {
+			continue
+		}
+		if reason, message := DiagnosePodFailure(&pods[i]); reason != "" {
+			return reason, message
+		}
+	}
+	for i := range pods {
+		if pods[i].Labels[RayNodeTypeLabelKey] == string(rayv1.HeadNode) {
+			continue
+		}
+		if reason, message := DiagnosePodFailure(&pods[i]); reason != "" {
+			return reason, message
+		}
+	}
+	return "", ""
+}
+
 func CheckRouteName(ctx context.Context, s string, n string) string {
 	log := ctrl.LoggerFrom(ctx)
 
@@ -338,6 +405,12 @@ func GetWorkerGroupDesiredReplicas(ctx context.Context, workerGroupSpec rayv1.Wo
 	return workerReplicas
 }
 
+// getWorkerGroupDesiredPods returns the desired number of worker Pods of a group. Each desired replica of a
+// multi-host group has NumOfHosts Pods, and a NumOfHosts that is not set is treated as 1.
+func getWorkerGroupDesiredPods(ctx context.Context, workerGroupSpec rayv1.WorkerGroupSpec) int32 {
+	return GetWorkerGroupDesiredReplicas(ctx, workerGroupSpec) * max(workerGroupSpec.NumOfHosts, 1)
+}
+
 // CalculateDesiredReplicas calculate desired worker replicas at the cluster level
 func CalculateDesiredReplicas(ctx context.Context, cluster *rayv1.RayCluster) int32 {
 	count := int32(0)
@@ -400,6 +473,76 @@ func CalculateAvailableReplicas(pods corev1.PodList) int32 {
 	return count
 }
 
+// CalculateWorkerGroupStatuses calculates the observed state of each worker group in the cluster.
+// The LastScaleTime of a group is carried over from the current status unless its desired replicas changed.
+func CalculateWorkerGroupStatuses(ctx context.Context, cluster *rayv1.RayCluster, pods corev1.PodList) []rayv1.WorkerGroupStatus {
+	if len(cluster.Spec.WorkerGroupSpecs) == 0 {
+		return nil
+	}
+	oldStatuses := make(map[string]rayv1.WorkerGroupStatus, len(cluster.Status.WorkerGroupStatuses))
+	for _, status := range cluster.Status.WorkerGroupStatuses {
+		oldStatuses[status.GroupName] = status
+	}
+	now := metav1.Now()
+	statuses := make([]rayv1.WorkerGroupStatus, 0, len(cluster.Spec.WorkerGroupSpecs))
+	for _, workerGroup := range cluster.Spec.WorkerGroupSpecs {
+		status := rayv1.WorkerGroupStatus{
+			GroupName:       workerGroup.GroupName,
+			DesiredReplicas: getWorkerGroupDesiredPods(ctx, workerGroup),
+		}
+		if oldStatus, ok := oldStatuses[workerGroup.GroupName]; ok && oldStatus.DesiredReplicas == status.DesiredReplicas {
+			status.LastScaleTime = oldStatus.LastScaleTime
+		} else {
+			status.LastScaleTime = &now
+		}
+
+		desiredResources := CalculateWorkerGroupDesiredResources(ctx, workerGroup)
+		status.DesiredCPU = desiredResources[corev1.ResourceCPU]
+		status.DesiredMemory = desiredResources[corev1.ResourceMemory]
+		status.DesiredGPU = SumGPUs(desiredResources)
+		status.DesiredTPU = desiredResources[corev1.ResourceName("google.com/tpu")]
+
+		// If the template hash cannot be computed, every Pod is counted as updated.
+		templateHash, hashErr := GenerateWorkerGroupTemplateHash(workerGroup)
+		for _, pod := range pods.Items {
+			if pod.Labels[RayNodeTypeLabelKey] != string(rayv1.WorkerNode) || pod.Labels[RayNodeGroupLabelKey] != workerGroup.GroupName {
+				continue
+			}
+			if !pod.DeletionTimestamp.IsZero() {
+				continue
+			}
+			if hashErr == nil && IsPodOutdated(pod, templateHash) {
+				status.OutdatedReplicas++
+			} else {
+				status.UpdatedReplicas++
+			}
+			switch pod.Status.Phase {
+			case corev1.PodPending:
+				status.PendingReplicas++
+			case corev1.PodFailed:
+				status.FailedReplicas++
+			case corev1.PodRunning:
+				status.AvailableReplicas++
+			}
+			if IsRunningAndReady(&pod) {
+				status.ReadyReplicas++
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// CalculateWorkerGroupDesiredResources returns the total resources requested by the desired worker Pods of a group.
+func CalculateWorkerGroupDesiredResources(ctx context.Context, workerGroup rayv1.WorkerGroupSpec) corev1.ResourceList {
+	desiredResourcesList := []corev1.ResourceList{{}}
+	podResource := CalculatePodResource(workerGroup.Template.Spec)
+	for i := int32(0); i < getWorkerGroupDesiredPods(ctx, workerGroup); i++ {
+		desiredResourcesList = append(desiredResourcesList, podResource)
+	}
+	return sumResourceList(desiredResourcesList)
+}
+
 func CalculateDesiredResources(cluster *rayv1.RayCluster) corev1.ResourceList {
 	desiredResourcesList := []corev1.ResourceList{{}}
 	headPodResource := CalculatePodResource(cluster.Spec.HeadGroupSpec.Template.Spec)
@@ -452,6 +595,19 @@ func CalculatePodResource(podSpec corev1.PodSpec) corev1.ResourceList {
 	return podResource
 }
 
+// SumGPUs sums the GPUs in the given resource list.
+func SumGPUs(resources map[corev1.ResourceName]resource.Quantity) resource.Quantity {
+	totalGPUs := resource.Quantity{}
+
+	for key, val := range resources {
+		if strings.HasSuffix(string(key), "gpu") && !val.IsZero() {
+			totalGPUs.Add(val)
+		}
+	}
+
+	return totalGPUs
+}
+
 func ConvertResourceListToMapString(resourceList corev1.ResourceList) map[string]resource.Quantity {
 	result := make(map[string]resource.Quantity)
 	for key, value := range resourceList {
@@ -553,6 +709,25 @@ func GenerateJsonHash(obj interface{}) (string, error) {
 	return hashStr, nil
 }
 
+// GenerateWorkerGroupTemplateHash returns the hash of the parts of a worker group that are rendered into its Pods.
+// Changes to the replica counts or the scale strategy of the worker group do not change the hash.
+func GenerateWorkerGroupTemplateHash(worker rayv1.WorkerGroupSpec) (string, error) {
+	return GenerateJsonHash(struct {
+		RayStartParams map[string]string
+		Template       corev1.PodTemplateSpec
+	}{
+		RayStartParams: worker.RayStartParams,
+		Template:       worker.Template,
+	})
+}
+
+// IsPodOutdated returns whether the Pod was created from a worker group template other than the one with `templateHash`.
+// Pods created before the template hash annotation was introduced are not considered outdated.
+func IsPodOutdated(pod corev1.Pod, templateHash string) bool {
+	podHash, ok := pod.Annotations[RayPodTemplateHashKey]
+	return ok && podHash != templateHash
+}
+
 // FindContainerPort searches for a specific port $portName in the container.
 // If the port is found in the container, the corresponding port is returned.
 // If the port is not found, the $defaultPort is returned instead.
@@ -597,6 +772,23 @@ func EnvVarByName(envName string, envVars []corev1.EnvVar) (corev1.EnvVar, bool)
 	return corev1.EnvVar{}, false
 }
 
+// GetConfigMapKeyValue returns the value of the ConfigMap key referenced by the selector in the given namespace.
+// If the ConfigMap or the key doesn't exist, an empty string is returned when the selector is optional.
+func GetConfigMapKeyValue(ctx context.Context, cli client.Client, namespace string, selector *corev1.ConfigMapKeySelector) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: selector.Name}, configMap); err != nil {
+		if errors.IsNotFound(err) && ptr.Deref(selector.Optional, false) {
+			return "", nil
+		}
+		return "", err
+	}
+	value, ok := configMap.Data[selector.Key]
+	if !ok && !ptr.Deref(selector.Optional, false) {
+		return "", fmt.Errorf("key %s not found in ConfigMap %s/%s", selector.Key, namespace, selector.Name)
+	}
+	return value, nil
+}
+
 type ClientProvider interface {
 	GetDashboardClient(mgr manager.Manager) func() RayDashboardClientInterface
 	GetHttpProxyClient(mgr manager.Manager) func() RayHttpProxyClientInterface
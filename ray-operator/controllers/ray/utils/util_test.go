@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	clientFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -515,6 +518,195 @@ func TestGetWorkerGroupDesiredReplicas(t *testing.T) {
 	assert.Equal(t, GetWorkerGroupDesiredReplicas(ctx, workerGroupSpec), *workerGroupSpec.MaxReplicas)
 }
 
+func TestGenerateWorkerGroupTemplateHash(t *testing.T) {
+	workerGroupSpec := rayv1.WorkerGroupSpec{
+		GroupName:      "small-group",
+		Replicas:       ptr.To[int32](1),
+		RayStartParams: map[string]string{"num-cpus": "1"},
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "ray-worker", Image: "rayproject/ray:2.9.0"}},
+			},
+		},
+	}
+	hash, err := GenerateWorkerGroupTemplateHash(workerGroupSpec)
+	assert.Nil(t, err)
+
+	// Changing the replicas or the scale strategy does not change the hash.
+	scaled := workerGroupSpec.DeepCopy()
+	scaled.Replicas = ptr.To[int32](5)
+	scaled.ScaleStrategy.WorkersToDelete = []string{"pod1"}
+	scaledHash, err := GenerateWorkerGroupTemplateHash(*scaled)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, scaledHash)
+
+	// Changing the template changes the hash.
+	updated := workerGroupSpec.DeepCopy()
+	updated.Template.Spec.Containers[0].Image = "rayproject/ray:2.10.0"
+	updatedHash, err := GenerateWorkerGroupTemplateHash(*updated)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, updatedHash)
+
+	// Changing the rayStartParams changes the hash.
+	updated = workerGroupSpec.DeepCopy()
+	updated.RayStartParams["num-cpus"] = "2"
+	updatedHash, err = GenerateWorkerGroupTemplateHash(*updated)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, updatedHash)
+
+	// Pods without the annotation are not considered outdated.
+	pod := corev1.Pod{}
+	assert.False(t, IsPodOutdated(pod, hash))
+	pod.Annotations = map[string]string{RayPodTemplateHashKey: hash}
+	assert.False(t, IsPodOutdated(pod, hash))
+	assert.True(t, IsPodOutdated(pod, updatedHash))
+}
+
+func TestCalculateWorkerGroupStatuses(t *testing.T) {
+	ctx := context.Background()
+	cluster := &rayv1.RayCluster{
+		Spec: rayv1.RayClusterSpec{
+			WorkerGroupSpecs: []rayv1.WorkerGroupSpec{
+				{
+					GroupName:   "cpu-group",
+					Replicas:    ptr.To[int32](4),
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: ptr.To[int32](10),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name: "ray-worker",
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resource.MustParse("1"),
+										corev1.ResourceMemory: resource.MustParse("1Gi"),
+									},
+								},
+							}},
+						},
+					},
+				},
+				{
+					GroupName:   "gpu-group",
+					Replicas:    ptr.To[int32](1),
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: ptr.To[int32](10),
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name: "ray-worker",
+								Resources: corev1.ResourceRequirements{
+									Limits: corev1.ResourceList{
+										corev1.ResourceName("nvidia.com/gpu"): resource.MustParse("2"),
+									},
+								},
+							}},
+						},
+					},
+				},
+				{
+					GroupName:   "multi-host-group",
+					Replicas:    ptr.To[int32](2),
+					MinReplicas: ptr.To[int32](0),
+					MaxReplicas: ptr.To[int32](10),
+					NumOfHosts:  2,
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name: "ray-worker",
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU: resource.MustParse("1"),
+									},
+								},
+							}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	newWorkerPod := func(groupName string, phase corev1.PodPhase, ready bool) corev1.Pod {
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					RayNodeTypeLabelKey:  string(rayv1.WorkerNode),
+					RayNodeGroupLabelKey: groupName,
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+		if ready {
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return pod
+	}
+	pods := corev1.PodList{
+		Items: []corev1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{RayNodeTypeLabelKey: string(rayv1.HeadNode)},
+				},
+				Status: corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			newWorkerPod("cpu-group", corev1.PodRunning, true),
+			newWorkerPod("cpu-group", corev1.PodRunning, false),
+			newWorkerPod("cpu-group", corev1.PodPending, false),
+			newWorkerPod("cpu-group", corev1.PodFailed, false),
+			newWorkerPod("gpu-group", corev1.PodPending, false),
+			newWorkerPod("multi-host-group", corev1.PodRunning, true),
+			newWorkerPod("multi-host-group", corev1.PodRunning, true),
+		},
+	}
+	// Pods that are being deleted are not counted.
+	deletingPod := newWorkerPod("cpu-group", corev1.PodRunning, true)
+	deletingPod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	pods.Items = append(pods.Items, deletingPod)
+
+	statuses := CalculateWorkerGroupStatuses(ctx, cluster, pods)
+	assert.Equal(t, 3, len(statuses))
+
+	cpuGroup := statuses[0]
+	assert.Equal(t, "cpu-group", cpuGroup.GroupName)
+	assert.Equal(t, int32(4), cpuGroup.DesiredReplicas)
+	assert.Equal(t, int32(1), cpuGroup.ReadyReplicas)
+	assert.Equal(t, int32(2), cpuGroup.AvailableReplicas)
+	assert.Equal(t, int32(1), cpuGroup.PendingReplicas)
+	assert.Equal(t, int32(1), cpuGroup.FailedReplicas)
+	assert.Equal(t, int32(4), cpuGroup.UpdatedReplicas)
+	assert.True(t, resource.MustParse("4").Equal(cpuGroup.DesiredCPU))
+	assert.True(t, resource.MustParse("4Gi").Equal(cpuGroup.DesiredMemory))
+	assert.True(t, cpuGroup.DesiredGPU.IsZero())
+	assert.NotNil(t, cpuGroup.LastScaleTime)
+
+	gpuGroup := statuses[1]
+	assert.Equal(t, "gpu-group", gpuGroup.GroupName)
+	assert.Equal(t, int32(1), gpuGroup.DesiredReplicas)
+	assert.Equal(t, int32(1), gpuGroup.PendingReplicas)
+	assert.Equal(t, int32(0), gpuGroup.ReadyReplicas)
+	assert.True(t, resource.MustParse("2").Equal(gpuGroup.DesiredGPU))
+
+	// The desired replicas and resources of a multi-host group are counted in Pods.
+	multiHostGroup := statuses[2]
+	assert.Equal(t, "multi-host-group", multiHostGroup.GroupName)
+	assert.Equal(t, int32(4), multiHostGroup.DesiredReplicas)
+	assert.Equal(t, int32(2), multiHostGroup.ReadyReplicas)
+	assert.True(t, resource.MustParse("4").Equal(multiHostGroup.DesiredCPU))
+
+	// LastScaleTime is only refreshed when the desired replicas of a group change.
+	lastScaleTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	cluster.Status.WorkerGroupStatuses = []rayv1.WorkerGroupStatus{
+		{GroupName: "cpu-group", DesiredReplicas: 4, LastScaleTime: &lastScaleTime},
+		{GroupName: "gpu-group", DesiredReplicas: 3, LastScaleTime: &lastScaleTime},
+		{GroupName: "multi-host-group", DesiredReplicas: 4, LastScaleTime: &lastScaleTime},
+	}
+	statuses = CalculateWorkerGroupStatuses(ctx, cluster, pods)
+	assert.Equal(t, lastScaleTime, *statuses[0].LastScaleTime)
+	assert.True(t, statuses[1].LastScaleTime.After(lastScaleTime.Time))
+	assert.Equal(t, lastScaleTime, *statuses[2].LastScaleTime)
+}
+
 func TestCalculateDesiredReplicas(t *testing.T) {
 	tests := map[string]struct {
 		group1Replicas    *int32
@@ -579,6 +771,47 @@ func TestCalculateDesiredReplicas(t *testing.T) {
 	}
 }
 
+func TestSumGPUs(t *testing.T) {
+	nvidiaGPUResourceName := corev1.ResourceName("nvidia.com/gpu")
+	googleTPUResourceName := corev1.ResourceName("google.com/tpu")
+
+	tests := map[string]struct {
+		input    map[corev1.ResourceName]resource.Quantity
+		expected resource.Quantity
+	}{
+		"no GPUs specified": {
+			map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			},
+			resource.MustParse("0"),
+		},
+		"one GPU type specified": {
+			map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				nvidiaGPUResourceName: resource.MustParse("1"),
+				googleTPUResourceName: resource.MustParse("1"),
+			},
+			resource.MustParse("1"),
+		},
+		"multiple GPUs specified": {
+			map[corev1.ResourceName]resource.Quantity{
+				corev1.ResourceCPU:                 resource.MustParse("1"),
+				nvidiaGPUResourceName:              resource.MustParse("3"),
+				corev1.ResourceName("foo.bar/gpu"): resource.MustParse("2"),
+				googleTPUResourceName:              resource.MustParse("1"),
+			},
+			resource.MustParse("5"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := SumGPUs(tc.input)
+			assert.True(t, tc.expected.Equal(result), "GPU number is wrong")
+		})
+	}
+}
+
 func TestUnmarshalRuntimeEnv(t *testing.T) {
 	tests := map[string]struct {
 		runtimeEnvYAML string
@@ -662,3 +895,191 @@ func TestErrRayClusterReplicaFailureReason(t *testing.T) {
 	assert.Equal(t, RayClusterReplicaFailureReason(errors.Join(ErrFailedCreateWorkerPod, errors.New("other error"))), "FailedCreateWorkerPod")
 	assert.Equal(t, RayClusterReplicaFailureReason(errors.New("other error")), "")
 }
+
+func TestDiagnosePodFailure(t *testing.T) {
+	newPod := func(nodeType rayv1.RayNodeType, status corev1.PodStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "raycluster-sample-" + string(nodeType),
+				Namespace: "default",
+				Labels: map[string]string{
+					RayNodeTypeLabelKey: string(nodeType),
+				},
+			},
+			Status: status,
+		}
+	}
+
+	tests := map[string]struct {
+		pod      *corev1.Pod
+		expected string
+	}{
+		"no failure if the Pod is running and ready": {
+			pod:      createRayHeadPodWithPhaseAndCondition(corev1.PodRunning, corev1.PodReady, corev1.ConditionTrue),
+			expected: "",
+		},
+		"Unschedulable if the Pod can't be scheduled": {
+			pod: newPod(rayv1.WorkerNode, corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+				},
+			}),
+			expected: rayv1.PodUnschedulable,
+		},
+		"ImagePullBackOff if the image of an init container can't be pulled": {
+			pod: newPod(rayv1.WorkerNode, corev1.PodStatus{
+				Phase: corev1.PodPending,
+				InitContainerStatuses: []corev1.ContainerStatus{
+					{Name: "wait-gcs-ready", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}}},
+				},
+			}),
+			expected: rayv1.PodImagePullBackOff,
+		},
+		"ImagePullBackOff if the image of a container can't be pulled": {
+			pod: newPod(rayv1.HeadNode, corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "ray-head", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+				},
+			}),
+			expected: rayv1.PodImagePullBackOff,
+		},
+		"OOMKilled if a container was OOMKilled and isn't ready": {
+			pod: newPod(rayv1.HeadNode, corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:                 "ray-head",
+						State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+					},
+				},
+			}),
+			expected: rayv1.PodOOMKilled,
+		},
+		"no failure if a container was OOMKilled but has become ready again": {
+			pod: newPod(rayv1.WorkerNode, corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:                 "ray-worker",
+						Ready:                true,
+						State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+						LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+					},
+				},
+			}),
+			expected: "",
+		},
+		"HeadPodCrashLoop if the head container is in CrashLoopBackOff": {
+			pod: newPod(rayv1.HeadNode, corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "ray-head", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				},
+			}),
+			expected: rayv1.HeadPodCrashLoopBackOff,
+		},
+		"no failure if a worker container is in CrashLoopBackOff": {
+			pod: newPod(rayv1.WorkerNode, corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "ray-worker", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				},
+			}),
+			expected: "",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, message := DiagnosePodFailure(tc.pod)
+			assert.Equal(t, tc.expected, reason)
+			assert.Equal(t, tc.expected == "", message == "")
+		})
+	}
+}
+
+func TestDiagnoseRayClusterPodsFailure(t *testing.T) {
+	workerPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "raycluster-sample-worker",
+			Labels: map[string]string{RayNodeTypeLabelKey: string(rayv1.WorkerNode)},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable},
+			},
+		},
+	}
+	headPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "raycluster-sample-head",
+			Labels: map[string]string{RayNodeTypeLabelKey: string(rayv1.HeadNode)},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "ray-head", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+			},
+		},
+	}
+
+	// The head Pod is inspected before the worker Pods regardless of the order of the Pods.
+	reason, _ := DiagnoseRayClusterPodsFailure([]corev1.Pod{workerPod, headPod})
+	assert.Equal(t, rayv1.HeadPodCrashLoopBackOff, reason)
+
+	reason, _ = DiagnoseRayClusterPodsFailure([]corev1.Pod{workerPod, *createRayHeadPodWithPhaseAndCondition(corev1.PodRunning, corev1.PodReady, corev1.ConditionTrue)})
+	assert.Equal(t, rayv1.PodUnschedulable, reason)
+
+	reason, _ = DiagnoseRayClusterPodsFailure(nil)
+	assert.Empty(t, reason)
+}
+
+func TestGetConfigMapKeyValue(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "serve-config",
+			Namespace: "default",
+		},
+		Data: map[string]string{"serve.yaml": "applications: []"},
+	}
+	fakeClient := clientFake.NewClientBuilder().WithRuntimeObjects(configMap).Build()
+
+	tests := map[string]struct {
+		selector      corev1.ConfigMapKeySelector
+		expectedValue string
+		expectError   bool
+	}{
+		"The key exists": {
+			selector:      corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"}, Key: "serve.yaml"},
+			expectedValue: "applications: []",
+		},
+		"The key doesn't exist": {
+			selector:    corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"}, Key: "missing.yaml"},
+			expectError: true,
+		},
+		"The optional key doesn't exist": {
+			selector: corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "serve-config"}, Key: "missing.yaml", Optional: ptr.To(true)},
+		},
+		"The ConfigMap doesn't exist": {
+			selector:    corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "serve.yaml"},
+			expectError: true,
+		},
+		"The optional ConfigMap doesn't exist": {
+			selector: corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "missing"}, Key: "serve.yaml", Optional: ptr.To(true)},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			value, err := GetConfigMapKeyValue(context.Background(), fakeClient, "default", &tc.selector)
+			if tc.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedValue, value)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"math/rand"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// VictimSelector chooses which worker Pods to delete when a worker group has more Pods than desired.
+type VictimSelector interface {
+	// SelectVictims returns at most numVictims Pods from pods, in the order in which they should be deleted.
+	SelectVictims(pods []corev1.Pod, numVictims int) []corev1.Pod
+}
+
+// NewVictimSelector returns the VictimSelector for the given policy. idleNodeIPs is the set of Pod IPs of the Ray
+// nodes that the dashboard reports as idle, and is only used by the IdleNode policy.
+func NewVictimSelector(policy *rayv1.VictimSelectionPolicy, idleNodeIPs map[string]struct{}) VictimSelector {
+	if policy == nil {
+		return listOrderVictimSelector{}
+	}
+	switch *policy {
+	case rayv1.RandomVictimSelection:
+		return randomVictimSelector{}
+	case rayv1.PodStatusVictimSelection:
+		return podStatusVictimSelector{}
+	case rayv1.IdleNodeVictimSelection:
+		return podStatusVictimSelector{idleNodeIPs: idleNodeIPs}
+	default:
+		return listOrderVictimSelector{}
+	}
+}
+
+// listOrderVictimSelector deletes Pods in the order in which they are listed.
+type listOrderVictimSelector struct{}
+
+func (listOrderVictimSelector) SelectVictims(pods []corev1.Pod, numVictims int) []corev1.Pod {
+	return pods[:max(0, min(numVictims, len(pods)))]
+}
+
+// randomVictimSelector deletes randomly chosen Pods.
+type randomVictimSelector struct{}
+
+func (randomVictimSelector) SelectVictims(pods []corev1.Pod, numVictims int) []corev1.Pod {
+	candidates := make([]corev1.Pod, len(pods))
+	copy(candidates, pods)
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	return candidates[:max(0, min(numVictims, len(candidates)))]
+}
+
+// podStatusVictimSelector prefers Pods that are doing the least useful work: Pods that are pending or unscheduled,
+// then Pods that are not ready, then Pods hosting idle Ray nodes, and finally the remaining Pods. Within each of
+// these tiers, the youngest Pods are deleted first.
+type podStatusVictimSelector struct {
+	idleNodeIPs map[string]struct{}
+}
+
+func (s podStatusVictimSelector) SelectVictims(pods []corev1.Pod, numVictims int) []corev1.Pod {
+	candidates := make([]corev1.Pod, len(pods))
+	copy(candidates, pods)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if ri, rj := s.rank(&candidates[i]), s.rank(&candidates[j]); ri != rj {
+			return ri < rj
+		}
+		return candidates[j].CreationTimestamp.Before(&candidates[i].CreationTimestamp)
+	})
+	return candidates[:max(0, min(numVictims, len(candidates)))]
+}
+
+func (s podStatusVictimSelector) rank(pod *corev1.Pod) int {
+	switch {
+	case isPendingOrUnscheduled(pod):
+		return 0
+	case !IsRunningAndReady(pod):
+		return 1
+	case s.isIdle(pod):
+		return 2
+	default:
+		return 3
+	}
+}
+
+func (s podStatusVictimSelector) isIdle(pod *corev1.Pod) bool {
+	if pod.Status.PodIP == "" {
+		return false
+	}
+	_, ok := s.idleNodeIPs[pod.Status.PodIP]
+	return ok
+}
+
+func isPendingOrUnscheduled(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodPending || pod.Status.Phase == "" {
+		return true
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse {
+			return true
+		}
+	}
+	return false
+}
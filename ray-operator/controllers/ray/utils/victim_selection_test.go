@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+func newVictimTestPod(name string, phase corev1.PodPhase, ready bool, podIP string, age time.Duration) corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			PodIP: podIP,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: readyStatus},
+			},
+		},
+	}
+}
+
+func victimNames(pods []corev1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func TestSelectVictims(t *testing.T) {
+	unscheduledPod := newVictimTestPod("unscheduled", corev1.PodRunning, false, "", time.Hour)
+	unscheduledPod.Status.Conditions = append(unscheduledPod.Status.Conditions, corev1.PodCondition{Type: corev1.PodScheduled, Status: corev1.ConditionFalse})
+	pods := []corev1.Pod{
+		newVictimTestPod("old-ready", corev1.PodRunning, true, "10.0.0.1", 3*time.Hour),
+		newVictimTestPod("young-ready", corev1.PodRunning, true, "10.0.0.2", time.Hour),
+		newVictimTestPod("idle", corev1.PodRunning, true, "10.0.0.3", 2*time.Hour),
+		newVictimTestPod("not-ready", corev1.PodRunning, false, "10.0.0.4", 2*time.Hour),
+		newVictimTestPod("pending", corev1.PodPending, false, "", 3*time.Hour),
+		unscheduledPod,
+	}
+	idleNodeIPs := map[string]struct{}{"10.0.0.3": {}}
+
+	tests := []struct {
+		policy      *rayv1.VictimSelectionPolicy
+		idleNodeIPs map[string]struct{}
+		name        string
+		want        []string
+		numVictims  int
+	}{
+		{
+			name:       "No policy deletes Pods in list order",
+			numVictims: 2,
+			want:       []string{"old-ready", "young-ready"},
+		},
+		{
+			name:        "PodStatus policy prefers pending, then not ready, then young Pods",
+			policy:      ptr.To(rayv1.PodStatusVictimSelection),
+			numVictims:  len(pods),
+			idleNodeIPs: idleNodeIPs,
+			want:        []string{"unscheduled", "pending", "not-ready", "young-ready", "idle", "old-ready"},
+		},
+		{
+			name:        "IdleNode policy prefers idle Ray nodes over busy ones",
+			policy:      ptr.To(rayv1.IdleNodeVictimSelection),
+			numVictims:  4,
+			idleNodeIPs: idleNodeIPs,
+			want:        []string{"unscheduled", "pending", "not-ready", "idle"},
+		},
+		{
+			name:       "IdleNode policy without idle nodes falls back to Pod status",
+			policy:     ptr.To(rayv1.IdleNodeVictimSelection),
+			numVictims: 4,
+			want:       []string{"unscheduled", "pending", "not-ready", "young-ready"},
+		},
+		{
+			name:       "More victims than Pods",
+			policy:     ptr.To(rayv1.PodStatusVictimSelection),
+			numVictims: len(pods) + 1,
+			want:       []string{"unscheduled", "pending", "not-ready", "young-ready", "idle", "old-ready"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			victims := NewVictimSelector(tc.policy, tc.idleNodeIPs).SelectVictims(pods, tc.numVictims)
+			assert.Equal(t, tc.want, victimNames(victims))
+		})
+	}
+
+	// The Random policy deletes the requested number of distinct Pods.
+	victims := NewVictimSelector(ptr.To(rayv1.RandomVictimSelection), nil).SelectVictims(pods, 3)
+	assert.Len(t, victims, 3)
+	assert.Subset(t, victimNames(pods), victimNames(victims))
+	assert.ElementsMatch(t, victimNames(pods), victimNames(NewVictimSelector(ptr.To(rayv1.RandomVictimSelection), nil).SelectVictims(pods, len(pods))))
+
+	// Selecting victims must not reorder the Pods passed in.
+	assert.Equal(t, []string{"old-ready", "young-ready", "idle", "not-ready", "pending", "unscheduled"}, victimNames(pods))
+}
@@ -14,6 +14,7 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -207,6 +208,11 @@ func main() {
 	selectorsByObject, err := cacheSelectors()
 	exitOnError(err, "unable to create cache selectors")
 	options.Cache.ByObject = selectorsByObject
+	// The RayService and RayJob controllers only watch the metadata of ConfigMaps, and read the few ConfigMaps
+	// they reference from the API server, so that the content of every ConfigMap in the cluster isn't cached.
+	options.Client = client.Options{
+		Cache: &client.CacheOptions{DisableFor: []client.Object{&corev1.ConfigMap{}}},
+	}
 
 	if watchNamespaces := strings.Split(config.WatchNamespace, ","); len(watchNamespaces) == 1 { // It is not possible for len(watchNamespaces) == 0 to be true. The length of `strings.Split("", ",")` is still 1.
 		if watchNamespaces[0] == "" {
@@ -239,10 +245,24 @@ func main() {
 		"unable to create controller", "controller", "RayService")
 	exitOnError(ray.NewRayJobReconciler(ctx, mgr, config).SetupWithManager(mgr, config.ReconcileConcurrency),
 		"unable to create controller", "controller", "RayJob")
+	if features.Enabled(features.RayCronJob) {
+		exitOnError(ray.NewRayCronJobReconciler(ctx, mgr).SetupWithManager(mgr, config.ReconcileConcurrency),
+			"unable to create controller", "controller", "RayCronJob")
+	}
+	if features.Enabled(features.RayJobSet) {
+		exitOnError(ray.NewRayJobSetReconciler(ctx, mgr).SetupWithManager(mgr, config.ReconcileConcurrency),
+			"unable to create controller", "controller", "RayJobSet")
+	}
+	if features.Enabled(features.RayClusterPool) {
+		exitOnError(ray.NewRayClusterPoolReconciler(ctx, mgr).SetupWithManager(mgr, config.ReconcileConcurrency),
+			"unable to create controller", "controller", "RayClusterPool")
+	}
 
 	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
 		exitOnError((&rayv1.RayCluster{}).SetupWebhookWithManager(mgr),
 			"unable to create webhook", "webhook", "RayCluster")
+		exitOnError((&rayv1.RayService{}).SetupWebhookWithManager(mgr),
+			"unable to create webhook", "webhook", "RayService")
 	}
 	// +kubebuilder:scaffold:builder
 
@@ -10,9 +10,11 @@ import (
 // with apply.
 type AppStatusApplyConfiguration struct {
 	HealthLastUpdateTime *v1.Time                                           `json:"healthLastUpdateTime,omitempty"`
+	LastDeployedTime     *v1.Time                                           `json:"lastDeployedTime,omitempty"`
 	Deployments          map[string]ServeDeploymentStatusApplyConfiguration `json:"serveDeploymentStatuses,omitempty"`
 	Status               *string                                            `json:"status,omitempty"`
 	Message              *string                                            `json:"message,omitempty"`
+	RoutePrefix          *string                                            `json:"routePrefix,omitempty"`
 }
 
 // AppStatusApplyConfiguration constructs an declarative configuration of the AppStatus type for use with
@@ -29,6 +31,14 @@ func (b *AppStatusApplyConfiguration) WithHealthLastUpdateTime(value v1.Time) *A
 	return b
 }
 
+// WithLastDeployedTime sets the LastDeployedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastDeployedTime field is set to the value of the last call.
+func (b *AppStatusApplyConfiguration) WithLastDeployedTime(value v1.Time) *AppStatusApplyConfiguration {
+	b.LastDeployedTime = &value
+	return b
+}
+
 // WithDeployments puts the entries into the Deployments field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the Deployments field,
@@ -58,3 +68,11 @@ func (b *AppStatusApplyConfiguration) WithMessage(value string) *AppStatusApplyC
 	b.Message = &value
 	return b
 }
+
+// WithRoutePrefix sets the RoutePrefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RoutePrefix field is set to the value of the last call.
+func (b *AppStatusApplyConfiguration) WithRoutePrefix(value string) *AppStatusApplyConfiguration {
+	b.RoutePrefix = &value
+	return b
+}
@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// DeletionPolicyApplyConfiguration represents an declarative configuration of the DeletionPolicy type for use
+// with apply.
+type DeletionPolicyApplyConfiguration struct {
+	OnSuccess *v1.DeletionPolicyType `json:"onSuccess,omitempty"`
+	OnFailure *v1.DeletionPolicyType `json:"onFailure,omitempty"`
+}
+
+// DeletionPolicyApplyConfiguration constructs an declarative configuration of the DeletionPolicy type for use with
+// apply.
+func DeletionPolicy() *DeletionPolicyApplyConfiguration {
+	return &DeletionPolicyApplyConfiguration{}
+}
+
+// WithOnSuccess sets the OnSuccess field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnSuccess field is set to the value of the last call.
+func (b *DeletionPolicyApplyConfiguration) WithOnSuccess(value v1.DeletionPolicyType) *DeletionPolicyApplyConfiguration {
+	b.OnSuccess = &value
+	return b
+}
+
+// WithOnFailure sets the OnFailure field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnFailure field is set to the value of the last call.
+func (b *DeletionPolicyApplyConfiguration) WithOnFailure(value v1.DeletionPolicyType) *DeletionPolicyApplyConfiguration {
+	b.OnFailure = &value
+	return b
+}
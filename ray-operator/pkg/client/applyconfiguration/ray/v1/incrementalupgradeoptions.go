@@ -0,0 +1,34 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// IncrementalUpgradeOptionsApplyConfiguration represents an declarative configuration of the IncrementalUpgradeOptions type for use
+// with apply.
+type IncrementalUpgradeOptionsApplyConfiguration struct {
+	IntervalSeconds *int32  `json:"intervalSeconds,omitempty"`
+	TrafficSteps    []int32 `json:"trafficSteps,omitempty"`
+}
+
+// IncrementalUpgradeOptionsApplyConfiguration constructs an declarative configuration of the IncrementalUpgradeOptions type for use with
+// apply.
+func IncrementalUpgradeOptions() *IncrementalUpgradeOptionsApplyConfiguration {
+	return &IncrementalUpgradeOptionsApplyConfiguration{}
+}
+
+// WithIntervalSeconds sets the IntervalSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IntervalSeconds field is set to the value of the last call.
+func (b *IncrementalUpgradeOptionsApplyConfiguration) WithIntervalSeconds(value int32) *IncrementalUpgradeOptionsApplyConfiguration {
+	b.IntervalSeconds = &value
+	return b
+}
+
+// WithTrafficSteps adds the given value to the TrafficSteps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TrafficSteps field.
+func (b *IncrementalUpgradeOptionsApplyConfiguration) WithTrafficSteps(values ...int32) *IncrementalUpgradeOptionsApplyConfiguration {
+	for i := range values {
+		b.TrafficSteps = append(b.TrafficSteps, values[i])
+	}
+	return b
+}
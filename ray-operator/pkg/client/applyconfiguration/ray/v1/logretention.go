@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// LogRetentionApplyConfiguration represents an declarative configuration of the LogRetention type for use
+// with apply.
+type LogRetentionApplyConfiguration struct {
+	ConfigMap             *ConfigMapLogRetentionApplyConfiguration             `json:"configMap,omitempty"`
+	PersistentVolumeClaim *PersistentVolumeClaimLogRetentionApplyConfiguration `json:"persistentVolumeClaim,omitempty"`
+}
+
+// LogRetentionApplyConfiguration constructs an declarative configuration of the LogRetention type for use with
+// apply.
+func LogRetention() *LogRetentionApplyConfiguration {
+	return &LogRetentionApplyConfiguration{}
+}
+
+// WithConfigMap sets the ConfigMap field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMap field is set to the value of the last call.
+func (b *LogRetentionApplyConfiguration) WithConfigMap(value *ConfigMapLogRetentionApplyConfiguration) *LogRetentionApplyConfiguration {
+	b.ConfigMap = value
+	return b
+}
+
+// WithPersistentVolumeClaim sets the PersistentVolumeClaim field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeClaim field is set to the value of the last call.
+func (b *LogRetentionApplyConfiguration) WithPersistentVolumeClaim(value *PersistentVolumeClaimLogRetentionApplyConfiguration) *LogRetentionApplyConfiguration {
+	b.PersistentVolumeClaim = value
+	return b
+}
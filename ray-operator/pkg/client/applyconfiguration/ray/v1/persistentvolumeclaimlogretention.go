@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// PersistentVolumeClaimLogRetentionApplyConfiguration represents an declarative configuration of the PersistentVolumeClaimLogRetention type for use
+// with apply.
+type PersistentVolumeClaimLogRetentionApplyConfiguration struct {
+	ClaimName *string `json:"claimName,omitempty"`
+	SubPath   *string `json:"subPath,omitempty"`
+}
+
+// PersistentVolumeClaimLogRetentionApplyConfiguration constructs an declarative configuration of the PersistentVolumeClaimLogRetention type for use with
+// apply.
+func PersistentVolumeClaimLogRetention() *PersistentVolumeClaimLogRetentionApplyConfiguration {
+	return &PersistentVolumeClaimLogRetentionApplyConfiguration{}
+}
+
+// WithClaimName sets the ClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClaimName field is set to the value of the last call.
+func (b *PersistentVolumeClaimLogRetentionApplyConfiguration) WithClaimName(value string) *PersistentVolumeClaimLogRetentionApplyConfiguration {
+	b.ClaimName = &value
+	return b
+}
+
+// WithSubPath sets the SubPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubPath field is set to the value of the last call.
+func (b *PersistentVolumeClaimLogRetentionApplyConfiguration) WithSubPath(value string) *PersistentVolumeClaimLogRetentionApplyConfiguration {
+	b.SubPath = &value
+	return b
+}
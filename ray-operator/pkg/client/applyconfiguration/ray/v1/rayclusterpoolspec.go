@@ -0,0 +1,45 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// RayClusterPoolSpecApplyConfiguration represents an declarative configuration of the RayClusterPoolSpec type for use
+// with apply.
+type RayClusterPoolSpecApplyConfiguration struct {
+	ReleasePolicy *v1.RayClusterPoolReleasePolicy   `json:"releasePolicy,omitempty"`
+	Template      *RayClusterSpecApplyConfiguration `json:"template,omitempty"`
+	Size          *int32                            `json:"size,omitempty"`
+}
+
+// RayClusterPoolSpecApplyConfiguration constructs an declarative configuration of the RayClusterPoolSpec type for use with
+// apply.
+func RayClusterPoolSpec() *RayClusterPoolSpecApplyConfiguration {
+	return &RayClusterPoolSpecApplyConfiguration{}
+}
+
+// WithReleasePolicy sets the ReleasePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ReleasePolicy field is set to the value of the last call.
+func (b *RayClusterPoolSpecApplyConfiguration) WithReleasePolicy(value v1.RayClusterPoolReleasePolicy) *RayClusterPoolSpecApplyConfiguration {
+	b.ReleasePolicy = &value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *RayClusterPoolSpecApplyConfiguration) WithTemplate(value *RayClusterSpecApplyConfiguration) *RayClusterPoolSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *RayClusterPoolSpecApplyConfiguration) WithSize(value int32) *RayClusterPoolSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
@@ -0,0 +1,50 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RayClusterPoolStatusApplyConfiguration represents an declarative configuration of the RayClusterPoolStatus type for use
+// with apply.
+type RayClusterPoolStatusApplyConfiguration struct {
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+	Idle               *int32 `json:"idle,omitempty"`
+	Ready              *int32 `json:"ready,omitempty"`
+	Claimed            *int32 `json:"claimed,omitempty"`
+}
+
+// RayClusterPoolStatusApplyConfiguration constructs an declarative configuration of the RayClusterPoolStatus type for use with
+// apply.
+func RayClusterPoolStatus() *RayClusterPoolStatusApplyConfiguration {
+	return &RayClusterPoolStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithObservedGeneration(value int64) *RayClusterPoolStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithIdle sets the Idle field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Idle field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithIdle(value int32) *RayClusterPoolStatusApplyConfiguration {
+	b.Idle = &value
+	return b
+}
+
+// WithReady sets the Ready field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ready field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithReady(value int32) *RayClusterPoolStatusApplyConfiguration {
+	b.Ready = &value
+	return b
+}
+
+// WithClaimed sets the Claimed field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Claimed field is set to the value of the last call.
+func (b *RayClusterPoolStatusApplyConfiguration) WithClaimed(value int32) *RayClusterPoolStatusApplyConfiguration {
+	b.Claimed = &value
+	return b
+}
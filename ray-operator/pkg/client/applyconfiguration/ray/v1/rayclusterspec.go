@@ -5,13 +5,14 @@ package v1
 // RayClusterSpecApplyConfiguration represents an declarative configuration of the RayClusterSpec type for use
 // with apply.
 type RayClusterSpecApplyConfiguration struct {
-	Suspend                 *bool                                `json:"suspend,omitempty"`
-	AutoscalerOptions       *AutoscalerOptionsApplyConfiguration `json:"autoscalerOptions,omitempty"`
-	HeadServiceAnnotations  map[string]string                    `json:"headServiceAnnotations,omitempty"`
-	EnableInTreeAutoscaling *bool                                `json:"enableInTreeAutoscaling,omitempty"`
-	HeadGroupSpec           *HeadGroupSpecApplyConfiguration     `json:"headGroupSpec,omitempty"`
-	RayVersion              *string                              `json:"rayVersion,omitempty"`
-	WorkerGroupSpecs        []WorkerGroupSpecApplyConfiguration  `json:"workerGroupSpecs,omitempty"`
+	Suspend                 *bool                                        `json:"suspend,omitempty"`
+	AutoscalerOptions       *AutoscalerOptionsApplyConfiguration         `json:"autoscalerOptions,omitempty"`
+	HeadServiceAnnotations  map[string]string                            `json:"headServiceAnnotations,omitempty"`
+	EnableInTreeAutoscaling *bool                                        `json:"enableInTreeAutoscaling,omitempty"`
+	UpgradeStrategy         *RayClusterUpgradeStrategyApplyConfiguration `json:"upgradeStrategy,omitempty"`
+	HeadGroupSpec           *HeadGroupSpecApplyConfiguration             `json:"headGroupSpec,omitempty"`
+	RayVersion              *string                                      `json:"rayVersion,omitempty"`
+	WorkerGroupSpecs        []WorkerGroupSpecApplyConfiguration          `json:"workerGroupSpecs,omitempty"`
 }
 
 // RayClusterSpecApplyConfiguration constructs an declarative configuration of the RayClusterSpec type for use with
@@ -58,6 +59,14 @@ func (b *RayClusterSpecApplyConfiguration) WithEnableInTreeAutoscaling(value boo
 	return b
 }
 
+// WithUpgradeStrategy sets the UpgradeStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeStrategy field is set to the value of the last call.
+func (b *RayClusterSpecApplyConfiguration) WithUpgradeStrategy(value *RayClusterUpgradeStrategyApplyConfiguration) *RayClusterSpecApplyConfiguration {
+	b.UpgradeStrategy = value
+	return b
+}
+
 // WithHeadGroupSpec sets the HeadGroupSpec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the HeadGroupSpec field is set to the value of the last call.
@@ -11,23 +11,26 @@ import (
 // RayClusterStatusApplyConfiguration represents an declarative configuration of the RayClusterStatus type for use
 // with apply.
 type RayClusterStatusApplyConfiguration struct {
-	State                   *v1.ClusterState                 `json:"state,omitempty"`
-	DesiredCPU              *resource.Quantity               `json:"desiredCPU,omitempty"`
-	DesiredMemory           *resource.Quantity               `json:"desiredMemory,omitempty"`
-	DesiredGPU              *resource.Quantity               `json:"desiredGPU,omitempty"`
-	DesiredTPU              *resource.Quantity               `json:"desiredTPU,omitempty"`
-	LastUpdateTime          *metav1.Time                     `json:"lastUpdateTime,omitempty"`
-	StateTransitionTimes    map[v1.ClusterState]*metav1.Time `json:"stateTransitionTimes,omitempty"`
-	Endpoints               map[string]string                `json:"endpoints,omitempty"`
-	Head                    *HeadInfoApplyConfiguration      `json:"head,omitempty"`
-	Reason                  *string                          `json:"reason,omitempty"`
-	Conditions              []metav1.Condition               `json:"conditions,omitempty"`
-	ReadyWorkerReplicas     *int32                           `json:"readyWorkerReplicas,omitempty"`
-	AvailableWorkerReplicas *int32                           `json:"availableWorkerReplicas,omitempty"`
-	DesiredWorkerReplicas   *int32                           `json:"desiredWorkerReplicas,omitempty"`
-	MinWorkerReplicas       *int32                           `json:"minWorkerReplicas,omitempty"`
-	MaxWorkerReplicas       *int32                           `json:"maxWorkerReplicas,omitempty"`
-	ObservedGeneration      *int64                           `json:"observedGeneration,omitempty"`
+	State                   *v1.ClusterState                      `json:"state,omitempty"`
+	DesiredCPU              *resource.Quantity                    `json:"desiredCPU,omitempty"`
+	DesiredMemory           *resource.Quantity                    `json:"desiredMemory,omitempty"`
+	DesiredGPU              *resource.Quantity                    `json:"desiredGPU,omitempty"`
+	DesiredTPU              *resource.Quantity                    `json:"desiredTPU,omitempty"`
+	LastUpdateTime          *metav1.Time                          `json:"lastUpdateTime,omitempty"`
+	LastHeadRestartTime     *metav1.Time                          `json:"lastHeadRestartTime,omitempty"`
+	StateTransitionTimes    map[v1.ClusterState]*metav1.Time      `json:"stateTransitionTimes,omitempty"`
+	Endpoints               map[string]string                     `json:"endpoints,omitempty"`
+	Head                    *HeadInfoApplyConfiguration           `json:"head,omitempty"`
+	Reason                  *string                               `json:"reason,omitempty"`
+	Conditions              []metav1.Condition                    `json:"conditions,omitempty"`
+	WorkerGroupStatuses     []WorkerGroupStatusApplyConfiguration `json:"workerGroupStatuses,omitempty"`
+	ReadyWorkerReplicas     *int32                                `json:"readyWorkerReplicas,omitempty"`
+	AvailableWorkerReplicas *int32                                `json:"availableWorkerReplicas,omitempty"`
+	DesiredWorkerReplicas   *int32                                `json:"desiredWorkerReplicas,omitempty"`
+	MinWorkerReplicas       *int32                                `json:"minWorkerReplicas,omitempty"`
+	MaxWorkerReplicas       *int32                                `json:"maxWorkerReplicas,omitempty"`
+	HeadRestarts            *int32                                `json:"headRestarts,omitempty"`
+	ObservedGeneration      *int64                                `json:"observedGeneration,omitempty"`
 }
 
 // RayClusterStatusApplyConfiguration constructs an declarative configuration of the RayClusterStatus type for use with
@@ -84,6 +87,14 @@ func (b *RayClusterStatusApplyConfiguration) WithLastUpdateTime(value metav1.Tim
 	return b
 }
 
+// WithLastHeadRestartTime sets the LastHeadRestartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastHeadRestartTime field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithLastHeadRestartTime(value metav1.Time) *RayClusterStatusApplyConfiguration {
+	b.LastHeadRestartTime = &value
+	return b
+}
+
 // WithStateTransitionTimes puts the entries into the StateTransitionTimes field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, the entries provided by each call will be put on the StateTransitionTimes field,
@@ -138,6 +149,19 @@ func (b *RayClusterStatusApplyConfiguration) WithConditions(values ...metav1.Con
 	return b
 }
 
+// WithWorkerGroupStatuses adds the given value to the WorkerGroupStatuses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkerGroupStatuses field.
+func (b *RayClusterStatusApplyConfiguration) WithWorkerGroupStatuses(values ...*WorkerGroupStatusApplyConfiguration) *RayClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkerGroupStatuses")
+		}
+		b.WorkerGroupStatuses = append(b.WorkerGroupStatuses, *values[i])
+	}
+	return b
+}
+
 // WithReadyWorkerReplicas sets the ReadyWorkerReplicas field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ReadyWorkerReplicas field is set to the value of the last call.
@@ -178,6 +202,14 @@ func (b *RayClusterStatusApplyConfiguration) WithMaxWorkerReplicas(value int32)
 	return b
 }
 
+// WithHeadRestarts sets the HeadRestarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HeadRestarts field is set to the value of the last call.
+func (b *RayClusterStatusApplyConfiguration) WithHeadRestarts(value int32) *RayClusterStatusApplyConfiguration {
+	b.HeadRestarts = &value
+	return b
+}
+
 // WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ObservedGeneration field is set to the value of the last call.
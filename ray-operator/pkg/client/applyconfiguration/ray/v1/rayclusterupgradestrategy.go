@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// RayClusterUpgradeStrategyApplyConfiguration represents an declarative configuration of the RayClusterUpgradeStrategy type for use
+// with apply.
+type RayClusterUpgradeStrategyApplyConfiguration struct {
+	Type          *v1.RayClusterUpgradeType              `json:"type,omitempty"`
+	RollingUpdate *RollingUpdateConfigApplyConfiguration `json:"rollingUpdate,omitempty"`
+}
+
+// RayClusterUpgradeStrategyApplyConfiguration constructs an declarative configuration of the RayClusterUpgradeStrategy type for use with
+// apply.
+func RayClusterUpgradeStrategy() *RayClusterUpgradeStrategyApplyConfiguration {
+	return &RayClusterUpgradeStrategyApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *RayClusterUpgradeStrategyApplyConfiguration) WithType(value v1.RayClusterUpgradeType) *RayClusterUpgradeStrategyApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithRollingUpdate sets the RollingUpdate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RollingUpdate field is set to the value of the last call.
+func (b *RayClusterUpgradeStrategyApplyConfiguration) WithRollingUpdate(value *RollingUpdateConfigApplyConfiguration) *RayClusterUpgradeStrategyApplyConfiguration {
+	b.RollingUpdate = value
+	return b
+}
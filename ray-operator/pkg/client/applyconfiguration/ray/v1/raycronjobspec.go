@@ -0,0 +1,72 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// RayCronJobSpecApplyConfiguration represents an declarative configuration of the RayCronJobSpec type for use
+// with apply.
+type RayCronJobSpecApplyConfiguration struct {
+	StartingDeadlineSeconds    *int64                        `json:"startingDeadlineSeconds,omitempty"`
+	SuccessfulJobsHistoryLimit *int32                        `json:"successfulJobsHistoryLimit,omitempty"`
+	FailedJobsHistoryLimit     *int32                        `json:"failedJobsHistoryLimit,omitempty"`
+	Schedule                   *string                       `json:"schedule,omitempty"`
+	ConcurrencyPolicy          *v1.ConcurrencyPolicy         `json:"concurrencyPolicy,omitempty"`
+	JobTemplate                *RayJobSpecApplyConfiguration `json:"jobTemplate,omitempty"`
+}
+
+// RayCronJobSpecApplyConfiguration constructs an declarative configuration of the RayCronJobSpec type for use with
+// apply.
+func RayCronJobSpec() *RayCronJobSpecApplyConfiguration {
+	return &RayCronJobSpecApplyConfiguration{}
+}
+
+// WithStartingDeadlineSeconds sets the StartingDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartingDeadlineSeconds field is set to the value of the last call.
+func (b *RayCronJobSpecApplyConfiguration) WithStartingDeadlineSeconds(value int64) *RayCronJobSpecApplyConfiguration {
+	b.StartingDeadlineSeconds = &value
+	return b
+}
+
+// WithSuccessfulJobsHistoryLimit sets the SuccessfulJobsHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuccessfulJobsHistoryLimit field is set to the value of the last call.
+func (b *RayCronJobSpecApplyConfiguration) WithSuccessfulJobsHistoryLimit(value int32) *RayCronJobSpecApplyConfiguration {
+	b.SuccessfulJobsHistoryLimit = &value
+	return b
+}
+
+// WithFailedJobsHistoryLimit sets the FailedJobsHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailedJobsHistoryLimit field is set to the value of the last call.
+func (b *RayCronJobSpecApplyConfiguration) WithFailedJobsHistoryLimit(value int32) *RayCronJobSpecApplyConfiguration {
+	b.FailedJobsHistoryLimit = &value
+	return b
+}
+
+// WithSchedule sets the Schedule field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Schedule field is set to the value of the last call.
+func (b *RayCronJobSpecApplyConfiguration) WithSchedule(value string) *RayCronJobSpecApplyConfiguration {
+	b.Schedule = &value
+	return b
+}
+
+// WithConcurrencyPolicy sets the ConcurrencyPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConcurrencyPolicy field is set to the value of the last call.
+func (b *RayCronJobSpecApplyConfiguration) WithConcurrencyPolicy(value v1.ConcurrencyPolicy) *RayCronJobSpecApplyConfiguration {
+	b.ConcurrencyPolicy = &value
+	return b
+}
+
+// WithJobTemplate sets the JobTemplate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JobTemplate field is set to the value of the last call.
+func (b *RayCronJobSpecApplyConfiguration) WithJobTemplate(value *RayJobSpecApplyConfiguration) *RayCronJobSpecApplyConfiguration {
+	b.JobTemplate = value
+	return b
+}
@@ -0,0 +1,56 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RayCronJobStatusApplyConfiguration represents an declarative configuration of the RayCronJobStatus type for use
+// with apply.
+type RayCronJobStatusApplyConfiguration struct {
+	LastScheduleTime   *v1.Time `json:"lastScheduleTime,omitempty"`
+	LastSuccessfulTime *v1.Time `json:"lastSuccessfulTime,omitempty"`
+	Active             []string `json:"active,omitempty"`
+	ObservedGeneration *int64   `json:"observedGeneration,omitempty"`
+}
+
+// RayCronJobStatusApplyConfiguration constructs an declarative configuration of the RayCronJobStatus type for use with
+// apply.
+func RayCronJobStatus() *RayCronJobStatusApplyConfiguration {
+	return &RayCronJobStatusApplyConfiguration{}
+}
+
+// WithLastScheduleTime sets the LastScheduleTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastScheduleTime field is set to the value of the last call.
+func (b *RayCronJobStatusApplyConfiguration) WithLastScheduleTime(value v1.Time) *RayCronJobStatusApplyConfiguration {
+	b.LastScheduleTime = &value
+	return b
+}
+
+// WithLastSuccessfulTime sets the LastSuccessfulTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastSuccessfulTime field is set to the value of the last call.
+func (b *RayCronJobStatusApplyConfiguration) WithLastSuccessfulTime(value v1.Time) *RayCronJobStatusApplyConfiguration {
+	b.LastSuccessfulTime = &value
+	return b
+}
+
+// WithActive adds the given value to the Active field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Active field.
+func (b *RayCronJobStatusApplyConfiguration) WithActive(values ...string) *RayCronJobStatusApplyConfiguration {
+	for i := range values {
+		b.Active = append(b.Active, values[i])
+	}
+	return b
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *RayCronJobStatusApplyConfiguration) WithObservedGeneration(value int64) *RayCronJobStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
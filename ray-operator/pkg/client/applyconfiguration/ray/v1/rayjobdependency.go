@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// RayJobDependencyApplyConfiguration represents an declarative configuration of the RayJobDependency type for use
+// with apply.
+type RayJobDependencyApplyConfiguration struct {
+	Name      *string                       `json:"name,omitempty"`
+	Condition *v1.RayJobDependencyCondition `json:"condition,omitempty"`
+}
+
+// RayJobDependencyApplyConfiguration constructs an declarative configuration of the RayJobDependency type for use with
+// apply.
+func RayJobDependency() *RayJobDependencyApplyConfiguration {
+	return &RayJobDependencyApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RayJobDependencyApplyConfiguration) WithName(value string) *RayJobDependencyApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithCondition sets the Condition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Condition field is set to the value of the last call.
+func (b *RayJobDependencyApplyConfiguration) WithCondition(value v1.RayJobDependencyCondition) *RayJobDependencyApplyConfiguration {
+	b.Condition = &value
+	return b
+}
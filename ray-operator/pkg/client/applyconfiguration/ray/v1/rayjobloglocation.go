@@ -0,0 +1,41 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RayJobLogLocationApplyConfiguration represents an declarative configuration of the RayJobLogLocation type for use
+// with apply.
+type RayJobLogLocationApplyConfiguration struct {
+	ConfigMapName             *string `json:"configMapName,omitempty"`
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+	Path                      *string `json:"path,omitempty"`
+}
+
+// RayJobLogLocationApplyConfiguration constructs an declarative configuration of the RayJobLogLocation type for use with
+// apply.
+func RayJobLogLocation() *RayJobLogLocationApplyConfiguration {
+	return &RayJobLogLocationApplyConfiguration{}
+}
+
+// WithConfigMapName sets the ConfigMapName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapName field is set to the value of the last call.
+func (b *RayJobLogLocationApplyConfiguration) WithConfigMapName(value string) *RayJobLogLocationApplyConfiguration {
+	b.ConfigMapName = &value
+	return b
+}
+
+// WithPersistentVolumeClaimName sets the PersistentVolumeClaimName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PersistentVolumeClaimName field is set to the value of the last call.
+func (b *RayJobLogLocationApplyConfiguration) WithPersistentVolumeClaimName(value string) *RayJobLogLocationApplyConfiguration {
+	b.PersistentVolumeClaimName = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *RayJobLogLocationApplyConfiguration) WithPath(value string) *RayJobLogLocationApplyConfiguration {
+	b.Path = &value
+	return b
+}
@@ -0,0 +1,60 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
+// RayJobSetJobStatusApplyConfiguration represents an declarative configuration of the RayJobSetJobStatus type for use
+// with apply.
+type RayJobSetJobStatusApplyConfiguration struct {
+	Parameters          map[string]string       `json:"parameters,omitempty"`
+	Name                *string                 `json:"name,omitempty"`
+	JobStatus           *v1.JobStatus           `json:"jobStatus,omitempty"`
+	JobDeploymentStatus *v1.JobDeploymentStatus `json:"jobDeploymentStatus,omitempty"`
+}
+
+// RayJobSetJobStatusApplyConfiguration constructs an declarative configuration of the RayJobSetJobStatus type for use with
+// apply.
+func RayJobSetJobStatus() *RayJobSetJobStatusApplyConfiguration {
+	return &RayJobSetJobStatusApplyConfiguration{}
+}
+
+// WithParameters puts the entries into the Parameters field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Parameters field,
+// overwriting an existing map entries in Parameters field with the same key.
+func (b *RayJobSetJobStatusApplyConfiguration) WithParameters(entries map[string]string) *RayJobSetJobStatusApplyConfiguration {
+	if b.Parameters == nil && len(entries) > 0 {
+		b.Parameters = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Parameters[k] = v
+	}
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *RayJobSetJobStatusApplyConfiguration) WithName(value string) *RayJobSetJobStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithJobStatus sets the JobStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JobStatus field is set to the value of the last call.
+func (b *RayJobSetJobStatusApplyConfiguration) WithJobStatus(value v1.JobStatus) *RayJobSetJobStatusApplyConfiguration {
+	b.JobStatus = &value
+	return b
+}
+
+// WithJobDeploymentStatus sets the JobDeploymentStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the JobDeploymentStatus field is set to the value of the last call.
+func (b *RayJobSetJobStatusApplyConfiguration) WithJobDeploymentStatus(value v1.JobDeploymentStatus) *RayJobSetJobStatusApplyConfiguration {
+	b.JobDeploymentStatus = &value
+	return b
+}
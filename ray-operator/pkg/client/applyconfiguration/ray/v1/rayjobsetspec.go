@@ -0,0 +1,59 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// RayJobSetSpecApplyConfiguration represents an declarative configuration of the RayJobSetSpec type for use
+// with apply.
+type RayJobSetSpecApplyConfiguration struct {
+	Parallelism   *int32                                    `json:"parallelism,omitempty"`
+	SuccessPolicy *RayJobSetSuccessPolicyApplyConfiguration `json:"successPolicy,omitempty"`
+	FailurePolicy *RayJobSetFailurePolicyApplyConfiguration `json:"failurePolicy,omitempty"`
+	Parameters    *RayJobSetParametersApplyConfiguration    `json:"parameters,omitempty"`
+	Template      *RayJobSpecApplyConfiguration             `json:"template,omitempty"`
+}
+
+// RayJobSetSpecApplyConfiguration constructs an declarative configuration of the RayJobSetSpec type for use with
+// apply.
+func RayJobSetSpec() *RayJobSetSpecApplyConfiguration {
+	return &RayJobSetSpecApplyConfiguration{}
+}
+
+// WithParallelism sets the Parallelism field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parallelism field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithParallelism(value int32) *RayJobSetSpecApplyConfiguration {
+	b.Parallelism = &value
+	return b
+}
+
+// WithSuccessPolicy sets the SuccessPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuccessPolicy field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithSuccessPolicy(value *RayJobSetSuccessPolicyApplyConfiguration) *RayJobSetSpecApplyConfiguration {
+	b.SuccessPolicy = value
+	return b
+}
+
+// WithFailurePolicy sets the FailurePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FailurePolicy field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithFailurePolicy(value *RayJobSetFailurePolicyApplyConfiguration) *RayJobSetSpecApplyConfiguration {
+	b.FailurePolicy = value
+	return b
+}
+
+// WithParameters sets the Parameters field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Parameters field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithParameters(value *RayJobSetParametersApplyConfiguration) *RayJobSetSpecApplyConfiguration {
+	b.Parameters = value
+	return b
+}
+
+// WithTemplate sets the Template field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Template field is set to the value of the last call.
+func (b *RayJobSetSpecApplyConfiguration) WithTemplate(value *RayJobSpecApplyConfiguration) *RayJobSetSpecApplyConfiguration {
+	b.Template = value
+	return b
+}
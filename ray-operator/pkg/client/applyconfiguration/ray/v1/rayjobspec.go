@@ -3,30 +3,42 @@
 package v1
 
 import (
-	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	apicorev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	corev1 "k8s.io/client-go/applyconfigurations/core/v1"
 )
 
 // RayJobSpecApplyConfiguration represents an declarative configuration of the RayJobSpec type for use
 // with apply.
 type RayJobSpecApplyConfiguration struct {
-	ActiveDeadlineSeconds    *int32                                    `json:"activeDeadlineSeconds,omitempty"`
-	BackoffLimit             *int32                                    `json:"backoffLimit,omitempty"`
-	RayClusterSpec           *RayClusterSpecApplyConfiguration         `json:"rayClusterSpec,omitempty"`
-	SubmitterPodTemplate     *corev1.PodTemplateSpecApplyConfiguration `json:"submitterPodTemplate,omitempty"`
-	Metadata                 map[string]string                         `json:"metadata,omitempty"`
-	ClusterSelector          map[string]string                         `json:"clusterSelector,omitempty"`
-	SubmitterConfig          *SubmitterConfigApplyConfiguration        `json:"submitterConfig,omitempty"`
-	Entrypoint               *string                                   `json:"entrypoint,omitempty"`
-	RuntimeEnvYAML           *string                                   `json:"runtimeEnvYAML,omitempty"`
-	JobId                    *string                                   `json:"jobId,omitempty"`
-	SubmissionMode           *rayv1.JobSubmissionMode                  `json:"submissionMode,omitempty"`
-	EntrypointResources      *string                                   `json:"entrypointResources,omitempty"`
-	EntrypointNumCpus        *float32                                  `json:"entrypointNumCpus,omitempty"`
-	EntrypointNumGpus        *float32                                  `json:"entrypointNumGpus,omitempty"`
-	TTLSecondsAfterFinished  *int32                                    `json:"ttlSecondsAfterFinished,omitempty"`
-	ShutdownAfterJobFinishes *bool                                     `json:"shutdownAfterJobFinishes,omitempty"`
-	Suspend                  *bool                                     `json:"suspend,omitempty"`
+	ActiveDeadlineSeconds         *int32                                    `json:"activeDeadlineSeconds,omitempty"`
+	InitializationDeadlineSeconds *int32                                    `json:"initializationDeadlineSeconds,omitempty"`
+	SuspendGracePeriodSeconds     *int32                                    `json:"suspendGracePeriodSeconds,omitempty"`
+	BackoffLimit                  *int32                                    `json:"backoffLimit,omitempty"`
+	RetryPolicy                   *v1.RayJobRetryPolicy                     `json:"retryPolicy,omitempty"`
+	RayClusterSpec                *RayClusterSpecApplyConfiguration         `json:"rayClusterSpec,omitempty"`
+	SubmitterPodTemplate          *corev1.PodTemplateSpecApplyConfiguration `json:"submitterPodTemplate,omitempty"`
+	Metadata                      map[string]string                         `json:"metadata,omitempty"`
+	ClusterSelector               map[string]string                         `json:"clusterSelector,omitempty"`
+	ClusterPoolSelector           *metav1.LabelSelector                     `json:"clusterPoolSelector,omitempty"`
+	MaxConcurrentJobsPerCluster   *int32                                    `json:"maxConcurrentJobsPerCluster,omitempty"`
+	SubmitterConfig               *SubmitterConfigApplyConfiguration        `json:"submitterConfig,omitempty"`
+	LogRetention                  *LogRetentionApplyConfiguration           `json:"logRetention,omitempty"`
+	DeletionPolicy                *DeletionPolicyApplyConfiguration         `json:"deletionPolicy,omitempty"`
+	RuntimeEnvFrom                *apicorev1.ConfigMapKeySelector           `json:"runtimeEnvFrom,omitempty"`
+	Entrypoint                    *string                                   `json:"entrypoint,omitempty"`
+	RuntimeEnvYAML                *string                                   `json:"runtimeEnvYAML,omitempty"`
+	JobId                         *string                                   `json:"jobId,omitempty"`
+	SubmissionMode                *v1.JobSubmissionMode                     `json:"submissionMode,omitempty"`
+	EntrypointResources           *string                                   `json:"entrypointResources,omitempty"`
+	RayClusterPoolName            *string                                   `json:"rayClusterPoolName,omitempty"`
+	DependsOn                     []RayJobDependencyApplyConfiguration      `json:"dependsOn,omitempty"`
+	EntrypointNumCpus             *float32                                  `json:"entrypointNumCpus,omitempty"`
+	EntrypointNumGpus             *float32                                  `json:"entrypointNumGpus,omitempty"`
+	TTLSecondsAfterFinished       *int32                                    `json:"ttlSecondsAfterFinished,omitempty"`
+	ShutdownAfterJobFinishes      *bool                                     `json:"shutdownAfterJobFinishes,omitempty"`
+	Suspend                       *bool                                     `json:"suspend,omitempty"`
 }
 
 // RayJobSpecApplyConfiguration constructs an declarative configuration of the RayJobSpec type for use with
@@ -43,6 +55,22 @@ func (b *RayJobSpecApplyConfiguration) WithActiveDeadlineSeconds(value int32) *R
 	return b
 }
 
+// WithInitializationDeadlineSeconds sets the InitializationDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InitializationDeadlineSeconds field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithInitializationDeadlineSeconds(value int32) *RayJobSpecApplyConfiguration {
+	b.InitializationDeadlineSeconds = &value
+	return b
+}
+
+// WithSuspendGracePeriodSeconds sets the SuspendGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendGracePeriodSeconds field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithSuspendGracePeriodSeconds(value int32) *RayJobSpecApplyConfiguration {
+	b.SuspendGracePeriodSeconds = &value
+	return b
+}
+
 // WithBackoffLimit sets the BackoffLimit field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the BackoffLimit field is set to the value of the last call.
@@ -51,6 +79,14 @@ func (b *RayJobSpecApplyConfiguration) WithBackoffLimit(value int32) *RayJobSpec
 	return b
 }
 
+// WithRetryPolicy sets the RetryPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RetryPolicy field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithRetryPolicy(value v1.RayJobRetryPolicy) *RayJobSpecApplyConfiguration {
+	b.RetryPolicy = &value
+	return b
+}
+
 // WithRayClusterSpec sets the RayClusterSpec field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RayClusterSpec field is set to the value of the last call.
@@ -95,6 +131,22 @@ func (b *RayJobSpecApplyConfiguration) WithClusterSelector(entries map[string]st
 	return b
 }
 
+// WithClusterPoolSelector sets the ClusterPoolSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ClusterPoolSelector field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithClusterPoolSelector(value metav1.LabelSelector) *RayJobSpecApplyConfiguration {
+	b.ClusterPoolSelector = &value
+	return b
+}
+
+// WithMaxConcurrentJobsPerCluster sets the MaxConcurrentJobsPerCluster field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxConcurrentJobsPerCluster field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithMaxConcurrentJobsPerCluster(value int32) *RayJobSpecApplyConfiguration {
+	b.MaxConcurrentJobsPerCluster = &value
+	return b
+}
+
 // WithSubmitterConfig sets the SubmitterConfig field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SubmitterConfig field is set to the value of the last call.
@@ -103,6 +155,30 @@ func (b *RayJobSpecApplyConfiguration) WithSubmitterConfig(value *SubmitterConfi
 	return b
 }
 
+// WithLogRetention sets the LogRetention field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogRetention field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithLogRetention(value *LogRetentionApplyConfiguration) *RayJobSpecApplyConfiguration {
+	b.LogRetention = value
+	return b
+}
+
+// WithDeletionPolicy sets the DeletionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionPolicy field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithDeletionPolicy(value *DeletionPolicyApplyConfiguration) *RayJobSpecApplyConfiguration {
+	b.DeletionPolicy = value
+	return b
+}
+
+// WithRuntimeEnvFrom sets the RuntimeEnvFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RuntimeEnvFrom field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithRuntimeEnvFrom(value apicorev1.ConfigMapKeySelector) *RayJobSpecApplyConfiguration {
+	b.RuntimeEnvFrom = &value
+	return b
+}
+
 // WithEntrypoint sets the Entrypoint field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Entrypoint field is set to the value of the last call.
@@ -130,7 +206,7 @@ func (b *RayJobSpecApplyConfiguration) WithJobId(value string) *RayJobSpecApplyC
 // WithSubmissionMode sets the SubmissionMode field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the SubmissionMode field is set to the value of the last call.
-func (b *RayJobSpecApplyConfiguration) WithSubmissionMode(value rayv1.JobSubmissionMode) *RayJobSpecApplyConfiguration {
+func (b *RayJobSpecApplyConfiguration) WithSubmissionMode(value v1.JobSubmissionMode) *RayJobSpecApplyConfiguration {
 	b.SubmissionMode = &value
 	return b
 }
@@ -143,6 +219,27 @@ func (b *RayJobSpecApplyConfiguration) WithEntrypointResources(value string) *Ra
 	return b
 }
 
+// WithRayClusterPoolName sets the RayClusterPoolName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RayClusterPoolName field is set to the value of the last call.
+func (b *RayJobSpecApplyConfiguration) WithRayClusterPoolName(value string) *RayJobSpecApplyConfiguration {
+	b.RayClusterPoolName = &value
+	return b
+}
+
+// WithDependsOn adds the given value to the DependsOn field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DependsOn field.
+func (b *RayJobSpecApplyConfiguration) WithDependsOn(values ...*RayJobDependencyApplyConfiguration) *RayJobSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDependsOn")
+		}
+		b.DependsOn = append(b.DependsOn, *values[i])
+	}
+	return b
+}
+
 // WithEntrypointNumCpus sets the EntrypointNumCpus field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the EntrypointNumCpus field is set to the value of the last call.
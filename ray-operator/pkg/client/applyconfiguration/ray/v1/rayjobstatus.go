@@ -3,26 +3,30 @@
 package v1
 
 import (
-	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // RayJobStatusApplyConfiguration represents an declarative configuration of the RayJobStatus type for use
 // with apply.
 type RayJobStatusApplyConfiguration struct {
-	JobId               *string                             `json:"jobId,omitempty"`
-	RayClusterName      *string                             `json:"rayClusterName,omitempty"`
-	DashboardURL        *string                             `json:"dashboardURL,omitempty"`
-	JobStatus           *v1.JobStatus                       `json:"jobStatus,omitempty"`
-	JobDeploymentStatus *v1.JobDeploymentStatus             `json:"jobDeploymentStatus,omitempty"`
-	Reason              *v1.JobFailedReason                 `json:"reason,omitempty"`
-	Message             *string                             `json:"message,omitempty"`
-	StartTime           *metav1.Time                        `json:"startTime,omitempty"`
-	EndTime             *metav1.Time                        `json:"endTime,omitempty"`
-	Succeeded           *int32                              `json:"succeeded,omitempty"`
-	Failed              *int32                              `json:"failed,omitempty"`
-	RayClusterStatus    *RayClusterStatusApplyConfiguration `json:"rayClusterStatus,omitempty"`
-	ObservedGeneration  *int64                              `json:"observedGeneration,omitempty"`
+	Attempts            []RayJobAttemptApplyConfiguration    `json:"attempts,omitempty"`
+	Conditions          []metav1.Condition                   `json:"conditions,omitempty"`
+	JobId               *string                              `json:"jobId,omitempty"`
+	RayClusterName      *string                              `json:"rayClusterName,omitempty"`
+	DashboardURL        *string                              `json:"dashboardURL,omitempty"`
+	JobStatus           *rayv1.JobStatus                     `json:"jobStatus,omitempty"`
+	JobDeploymentStatus *rayv1.JobDeploymentStatus           `json:"jobDeploymentStatus,omitempty"`
+	Reason              *rayv1.JobFailedReason               `json:"reason,omitempty"`
+	Message             *string                              `json:"message,omitempty"`
+	StartTime           *metav1.Time                         `json:"startTime,omitempty"`
+	EndTime             *metav1.Time                         `json:"endTime,omitempty"`
+	SuspendStartTime    *metav1.Time                         `json:"suspendStartTime,omitempty"`
+	LogLocation         *RayJobLogLocationApplyConfiguration `json:"logLocation,omitempty"`
+	Succeeded           *int32                               `json:"succeeded,omitempty"`
+	Failed              *int32                               `json:"failed,omitempty"`
+	RayClusterStatus    *RayClusterStatusApplyConfiguration  `json:"rayClusterStatus,omitempty"`
+	ObservedGeneration  *int64                               `json:"observedGeneration,omitempty"`
 }
 
 // RayJobStatusApplyConfiguration constructs an declarative configuration of the RayJobStatus type for use with
@@ -31,6 +35,29 @@ func RayJobStatus() *RayJobStatusApplyConfiguration {
 	return &RayJobStatusApplyConfiguration{}
 }
 
+// WithAttempts adds the given value to the Attempts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Attempts field.
+func (b *RayJobStatusApplyConfiguration) WithAttempts(values ...*RayJobAttemptApplyConfiguration) *RayJobStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAttempts")
+		}
+		b.Attempts = append(b.Attempts, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RayJobStatusApplyConfiguration) WithConditions(values ...metav1.Condition) *RayJobStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
 // WithJobId sets the JobId field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the JobId field is set to the value of the last call.
@@ -58,7 +85,7 @@ func (b *RayJobStatusApplyConfiguration) WithDashboardURL(value string) *RayJobS
 // WithJobStatus sets the JobStatus field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the JobStatus field is set to the value of the last call.
-func (b *RayJobStatusApplyConfiguration) WithJobStatus(value v1.JobStatus) *RayJobStatusApplyConfiguration {
+func (b *RayJobStatusApplyConfiguration) WithJobStatus(value rayv1.JobStatus) *RayJobStatusApplyConfiguration {
 	b.JobStatus = &value
 	return b
 }
@@ -66,7 +93,7 @@ func (b *RayJobStatusApplyConfiguration) WithJobStatus(value v1.JobStatus) *RayJ
 // WithJobDeploymentStatus sets the JobDeploymentStatus field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the JobDeploymentStatus field is set to the value of the last call.
-func (b *RayJobStatusApplyConfiguration) WithJobDeploymentStatus(value v1.JobDeploymentStatus) *RayJobStatusApplyConfiguration {
+func (b *RayJobStatusApplyConfiguration) WithJobDeploymentStatus(value rayv1.JobDeploymentStatus) *RayJobStatusApplyConfiguration {
 	b.JobDeploymentStatus = &value
 	return b
 }
@@ -74,7 +101,7 @@ func (b *RayJobStatusApplyConfiguration) WithJobDeploymentStatus(value v1.JobDep
 // WithReason sets the Reason field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Reason field is set to the value of the last call.
-func (b *RayJobStatusApplyConfiguration) WithReason(value v1.JobFailedReason) *RayJobStatusApplyConfiguration {
+func (b *RayJobStatusApplyConfiguration) WithReason(value rayv1.JobFailedReason) *RayJobStatusApplyConfiguration {
 	b.Reason = &value
 	return b
 }
@@ -103,6 +130,22 @@ func (b *RayJobStatusApplyConfiguration) WithEndTime(value metav1.Time) *RayJobS
 	return b
 }
 
+// WithSuspendStartTime sets the SuspendStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendStartTime field is set to the value of the last call.
+func (b *RayJobStatusApplyConfiguration) WithSuspendStartTime(value metav1.Time) *RayJobStatusApplyConfiguration {
+	b.SuspendStartTime = &value
+	return b
+}
+
+// WithLogLocation sets the LogLocation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLocation field is set to the value of the last call.
+func (b *RayJobStatusApplyConfiguration) WithLogLocation(value *RayJobLogLocationApplyConfiguration) *RayJobStatusApplyConfiguration {
+	b.LogLocation = value
+	return b
+}
+
 // WithSucceeded sets the Succeeded field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Succeeded field is set to the value of the last call.
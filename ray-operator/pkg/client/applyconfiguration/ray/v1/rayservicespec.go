@@ -10,12 +10,16 @@ import (
 // RayServiceSpecApplyConfiguration represents an declarative configuration of the RayServiceSpec type for use
 // with apply.
 type RayServiceSpecApplyConfiguration struct {
-	ServiceUnhealthySecondThreshold    *int32                            `json:"serviceUnhealthySecondThreshold,omitempty"`
-	DeploymentUnhealthySecondThreshold *int32                            `json:"deploymentUnhealthySecondThreshold,omitempty"`
-	ServeService                       *v1.Service                       `json:"serveService,omitempty"`
-	UpgradeStrategy                    *rayv1.RayServiceUpgradeStrategy  `json:"upgradeStrategy,omitempty"`
-	ServeConfigV2                      *string                           `json:"serveConfigV2,omitempty"`
-	RayClusterSpec                     *RayClusterSpecApplyConfiguration `json:"rayClusterConfig,omitempty"`
+	ServiceUnhealthySecondThreshold    *int32                                       `json:"serviceUnhealthySecondThreshold,omitempty"`
+	DeploymentUnhealthySecondThreshold *int32                                       `json:"deploymentUnhealthySecondThreshold,omitempty"`
+	ServeService                       *v1.Service                                  `json:"serveService,omitempty"`
+	UpgradeStrategy                    *rayv1.RayServiceUpgradeStrategy             `json:"upgradeStrategy,omitempty"`
+	IncrementalUpgradeOptions          *IncrementalUpgradeOptionsApplyConfiguration `json:"incrementalUpgradeOptions,omitempty"`
+	WorkerGroupUpgradeStrategy         *rayv1.RayServiceWorkerGroupUpgradeStrategy  `json:"workerGroupUpgradeStrategy,omitempty"`
+	UpgradeTimeoutSeconds              *int32                                       `json:"upgradeTimeoutSeconds,omitempty"`
+	ServeConfigV2From                  *v1.ConfigMapKeySelector                     `json:"serveConfigV2From,omitempty"`
+	ServeConfigV2                      *string                                      `json:"serveConfigV2,omitempty"`
+	RayClusterSpec                     *RayClusterSpecApplyConfiguration            `json:"rayClusterConfig,omitempty"`
 }
 
 // RayServiceSpecApplyConfiguration constructs an declarative configuration of the RayServiceSpec type for use with
@@ -56,6 +60,38 @@ func (b *RayServiceSpecApplyConfiguration) WithUpgradeStrategy(value rayv1.RaySe
 	return b
 }
 
+// WithIncrementalUpgradeOptions sets the IncrementalUpgradeOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IncrementalUpgradeOptions field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithIncrementalUpgradeOptions(value *IncrementalUpgradeOptionsApplyConfiguration) *RayServiceSpecApplyConfiguration {
+	b.IncrementalUpgradeOptions = value
+	return b
+}
+
+// WithWorkerGroupUpgradeStrategy sets the WorkerGroupUpgradeStrategy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WorkerGroupUpgradeStrategy field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithWorkerGroupUpgradeStrategy(value rayv1.RayServiceWorkerGroupUpgradeStrategy) *RayServiceSpecApplyConfiguration {
+	b.WorkerGroupUpgradeStrategy = &value
+	return b
+}
+
+// WithUpgradeTimeoutSeconds sets the UpgradeTimeoutSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UpgradeTimeoutSeconds field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithUpgradeTimeoutSeconds(value int32) *RayServiceSpecApplyConfiguration {
+	b.UpgradeTimeoutSeconds = &value
+	return b
+}
+
+// WithServeConfigV2From sets the ServeConfigV2From field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServeConfigV2From field is set to the value of the last call.
+func (b *RayServiceSpecApplyConfiguration) WithServeConfigV2From(value v1.ConfigMapKeySelector) *RayServiceSpecApplyConfiguration {
+	b.ServeConfigV2From = &value
+	return b
+}
+
 // WithServeConfigV2 sets the ServeConfigV2 field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServeConfigV2 field is set to the value of the last call.
@@ -2,12 +2,19 @@
 
 package v1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // RayServiceStatusApplyConfiguration represents an declarative configuration of the RayServiceStatus type for use
 // with apply.
 type RayServiceStatusApplyConfiguration struct {
-	Applications     map[string]AppStatusApplyConfiguration `json:"applicationStatuses,omitempty"`
-	RayClusterName   *string                                `json:"rayClusterName,omitempty"`
-	RayClusterStatus *RayClusterStatusApplyConfiguration    `json:"rayClusterStatus,omitempty"`
+	Applications            map[string]AppStatusApplyConfiguration `json:"applicationStatuses,omitempty"`
+	TrafficRoutedPercent    *int32                                 `json:"trafficRoutedPercent,omitempty"`
+	TargetTrafficPercent    *int32                                 `json:"targetTrafficPercent,omitempty"`
+	LastTrafficMigratedTime *metav1.Time                           `json:"lastTrafficMigratedTime,omitempty"`
+	RayClusterName          *string                                `json:"rayClusterName,omitempty"`
+	RayClusterStatus        *RayClusterStatusApplyConfiguration    `json:"rayClusterStatus,omitempty"`
 }
 
 // RayServiceStatusApplyConfiguration constructs an declarative configuration of the RayServiceStatus type for use with
@@ -30,6 +37,30 @@ func (b *RayServiceStatusApplyConfiguration) WithApplications(entries map[string
 	return b
 }
 
+// WithTrafficRoutedPercent sets the TrafficRoutedPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TrafficRoutedPercent field is set to the value of the last call.
+func (b *RayServiceStatusApplyConfiguration) WithTrafficRoutedPercent(value int32) *RayServiceStatusApplyConfiguration {
+	b.TrafficRoutedPercent = &value
+	return b
+}
+
+// WithTargetTrafficPercent sets the TargetTrafficPercent field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetTrafficPercent field is set to the value of the last call.
+func (b *RayServiceStatusApplyConfiguration) WithTargetTrafficPercent(value int32) *RayServiceStatusApplyConfiguration {
+	b.TargetTrafficPercent = &value
+	return b
+}
+
+// WithLastTrafficMigratedTime sets the LastTrafficMigratedTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTrafficMigratedTime field is set to the value of the last call.
+func (b *RayServiceStatusApplyConfiguration) WithLastTrafficMigratedTime(value metav1.Time) *RayServiceStatusApplyConfiguration {
+	b.LastTrafficMigratedTime = &value
+	return b
+}
+
 // WithRayClusterName sets the RayClusterName field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RayClusterName field is set to the value of the last call.
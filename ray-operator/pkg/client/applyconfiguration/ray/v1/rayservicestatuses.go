@@ -10,12 +10,16 @@ import (
 // RayServiceStatusesApplyConfiguration represents an declarative configuration of the RayServiceStatuses type for use
 // with apply.
 type RayServiceStatusesApplyConfiguration struct {
-	LastUpdateTime       *v1.Time                            `json:"lastUpdateTime,omitempty"`
-	ServiceStatus        *rayv1.ServiceStatus                `json:"serviceStatus,omitempty"`
-	ActiveServiceStatus  *RayServiceStatusApplyConfiguration `json:"activeServiceStatus,omitempty"`
-	PendingServiceStatus *RayServiceStatusApplyConfiguration `json:"pendingServiceStatus,omitempty"`
-	NumServeEndpoints    *int32                              `json:"numServeEndpoints,omitempty"`
-	ObservedGeneration   *int64                              `json:"observedGeneration,omitempty"`
+	LastUpdateTime               *v1.Time                            `json:"lastUpdateTime,omitempty"`
+	Conditions                   []v1.Condition                      `json:"conditions,omitempty"`
+	ServiceStatus                *rayv1.ServiceStatus                `json:"serviceStatus,omitempty"`
+	LastFailedSpec               *string                             `json:"lastFailedSpec,omitempty"`
+	PreviousActiveRayClusterName *string                             `json:"previousActiveRayClusterName,omitempty"`
+	RayClusterDeletionTimestamps map[string]v1.Time                  `json:"rayClusterDeletionTimestamps,omitempty"`
+	ActiveServiceStatus          *RayServiceStatusApplyConfiguration `json:"activeServiceStatus,omitempty"`
+	PendingServiceStatus         *RayServiceStatusApplyConfiguration `json:"pendingServiceStatus,omitempty"`
+	NumServeEndpoints            *int32                              `json:"numServeEndpoints,omitempty"`
+	ObservedGeneration           *int64                              `json:"observedGeneration,omitempty"`
 }
 
 // RayServiceStatusesApplyConfiguration constructs an declarative configuration of the RayServiceStatuses type for use with
@@ -32,6 +36,16 @@ func (b *RayServiceStatusesApplyConfiguration) WithLastUpdateTime(value v1.Time)
 	return b
 }
 
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RayServiceStatusesApplyConfiguration) WithConditions(values ...v1.Condition) *RayServiceStatusesApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
+
 // WithServiceStatus sets the ServiceStatus field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ServiceStatus field is set to the value of the last call.
@@ -40,6 +54,36 @@ func (b *RayServiceStatusesApplyConfiguration) WithServiceStatus(value rayv1.Ser
 	return b
 }
 
+// WithLastFailedSpec sets the LastFailedSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFailedSpec field is set to the value of the last call.
+func (b *RayServiceStatusesApplyConfiguration) WithLastFailedSpec(value string) *RayServiceStatusesApplyConfiguration {
+	b.LastFailedSpec = &value
+	return b
+}
+
+// WithPreviousActiveRayClusterName sets the PreviousActiveRayClusterName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousActiveRayClusterName field is set to the value of the last call.
+func (b *RayServiceStatusesApplyConfiguration) WithPreviousActiveRayClusterName(value string) *RayServiceStatusesApplyConfiguration {
+	b.PreviousActiveRayClusterName = &value
+	return b
+}
+
+// WithRayClusterDeletionTimestamps puts the entries into the RayClusterDeletionTimestamps field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the RayClusterDeletionTimestamps field,
+// overwriting an existing map entries in RayClusterDeletionTimestamps field with the same key.
+func (b *RayServiceStatusesApplyConfiguration) WithRayClusterDeletionTimestamps(entries map[string]v1.Time) *RayServiceStatusesApplyConfiguration {
+	if b.RayClusterDeletionTimestamps == nil && len(entries) > 0 {
+		b.RayClusterDeletionTimestamps = make(map[string]v1.Time, len(entries))
+	}
+	for k, v := range entries {
+		b.RayClusterDeletionTimestamps[k] = v
+	}
+	return b
+}
+
 // WithActiveServiceStatus sets the ActiveServiceStatus field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ActiveServiceStatus field is set to the value of the last call.
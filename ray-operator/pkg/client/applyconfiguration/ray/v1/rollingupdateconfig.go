@@ -0,0 +1,36 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// RollingUpdateConfigApplyConfiguration represents an declarative configuration of the RollingUpdateConfig type for use
+// with apply.
+type RollingUpdateConfigApplyConfiguration struct {
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	MaxSurge       *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// RollingUpdateConfigApplyConfiguration constructs an declarative configuration of the RollingUpdateConfig type for use with
+// apply.
+func RollingUpdateConfig() *RollingUpdateConfigApplyConfiguration {
+	return &RollingUpdateConfigApplyConfiguration{}
+}
+
+// WithMaxUnavailable sets the MaxUnavailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxUnavailable field is set to the value of the last call.
+func (b *RollingUpdateConfigApplyConfiguration) WithMaxUnavailable(value intstr.IntOrString) *RollingUpdateConfigApplyConfiguration {
+	b.MaxUnavailable = &value
+	return b
+}
+
+// WithMaxSurge sets the MaxSurge field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxSurge field is set to the value of the last call.
+func (b *RollingUpdateConfigApplyConfiguration) WithMaxSurge(value intstr.IntOrString) *RollingUpdateConfigApplyConfiguration {
+	b.MaxSurge = &value
+	return b
+}
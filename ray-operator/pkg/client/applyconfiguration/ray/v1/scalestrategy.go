@@ -2,10 +2,16 @@
 
 package v1
 
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+)
+
 // ScaleStrategyApplyConfiguration represents an declarative configuration of the ScaleStrategy type for use
 // with apply.
 type ScaleStrategyApplyConfiguration struct {
-	WorkersToDelete []string `json:"workersToDelete,omitempty"`
+	DrainGracePeriodSeconds *int32                    `json:"drainGracePeriodSeconds,omitempty"`
+	VictimSelectionPolicy   *v1.VictimSelectionPolicy `json:"victimSelectionPolicy,omitempty"`
+	WorkersToDelete         []string                  `json:"workersToDelete,omitempty"`
 }
 
 // ScaleStrategyApplyConfiguration constructs an declarative configuration of the ScaleStrategy type for use with
@@ -14,6 +20,22 @@ func ScaleStrategy() *ScaleStrategyApplyConfiguration {
 	return &ScaleStrategyApplyConfiguration{}
 }
 
+// WithDrainGracePeriodSeconds sets the DrainGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DrainGracePeriodSeconds field is set to the value of the last call.
+func (b *ScaleStrategyApplyConfiguration) WithDrainGracePeriodSeconds(value int32) *ScaleStrategyApplyConfiguration {
+	b.DrainGracePeriodSeconds = &value
+	return b
+}
+
+// WithVictimSelectionPolicy sets the VictimSelectionPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VictimSelectionPolicy field is set to the value of the last call.
+func (b *ScaleStrategyApplyConfiguration) WithVictimSelectionPolicy(value v1.VictimSelectionPolicy) *ScaleStrategyApplyConfiguration {
+	b.VictimSelectionPolicy = &value
+	return b
+}
+
 // WithWorkersToDelete adds the given value to the WorkersToDelete field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the WorkersToDelete field.
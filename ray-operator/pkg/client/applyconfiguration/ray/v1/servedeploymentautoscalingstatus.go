@@ -0,0 +1,32 @@
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1
+
+// ServeDeploymentAutoscalingStatusApplyConfiguration represents an declarative configuration of the ServeDeploymentAutoscalingStatus type for use
+// with apply.
+type ServeDeploymentAutoscalingStatusApplyConfiguration struct {
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// ServeDeploymentAutoscalingStatusApplyConfiguration constructs an declarative configuration of the ServeDeploymentAutoscalingStatus type for use with
+// apply.
+func ServeDeploymentAutoscalingStatus() *ServeDeploymentAutoscalingStatusApplyConfiguration {
+	return &ServeDeploymentAutoscalingStatusApplyConfiguration{}
+}
+
+// WithMinReplicas sets the MinReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinReplicas field is set to the value of the last call.
+func (b *ServeDeploymentAutoscalingStatusApplyConfiguration) WithMinReplicas(value int32) *ServeDeploymentAutoscalingStatusApplyConfiguration {
+	b.MinReplicas = &value
+	return b
+}
+
+// WithMaxReplicas sets the MaxReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxReplicas field is set to the value of the last call.
+func (b *ServeDeploymentAutoscalingStatusApplyConfiguration) WithMaxReplicas(value int32) *ServeDeploymentAutoscalingStatusApplyConfiguration {
+	b.MaxReplicas = &value
+	return b
+}
@@ -9,9 +9,13 @@ import (
 // ServeDeploymentStatusApplyConfiguration represents an declarative configuration of the ServeDeploymentStatus type for use
 // with apply.
 type ServeDeploymentStatusApplyConfiguration struct {
-	HealthLastUpdateTime *v1.Time `json:"healthLastUpdateTime,omitempty"`
-	Status               *string  `json:"status,omitempty"`
-	Message              *string  `json:"message,omitempty"`
+	HealthLastUpdateTime *v1.Time                                            `json:"healthLastUpdateTime,omitempty"`
+	Autoscaling          *ServeDeploymentAutoscalingStatusApplyConfiguration `json:"autoscaling,omitempty"`
+	ReplicaStates        map[string]int32                                    `json:"replicaStates,omitempty"`
+	TargetReplicas       *int32                                              `json:"targetReplicas,omitempty"`
+	RunningReplicas      *int32                                              `json:"runningReplicas,omitempty"`
+	Status               *string                                             `json:"status,omitempty"`
+	Message              *string                                             `json:"message,omitempty"`
 }
 
 // ServeDeploymentStatusApplyConfiguration constructs an declarative configuration of the ServeDeploymentStatus type for use with
@@ -28,6 +32,44 @@ func (b *ServeDeploymentStatusApplyConfiguration) WithHealthLastUpdateTime(value
 	return b
 }
 
+// WithAutoscaling sets the Autoscaling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Autoscaling field is set to the value of the last call.
+func (b *ServeDeploymentStatusApplyConfiguration) WithAutoscaling(value *ServeDeploymentAutoscalingStatusApplyConfiguration) *ServeDeploymentStatusApplyConfiguration {
+	b.Autoscaling = value
+	return b
+}
+
+// WithReplicaStates puts the entries into the ReplicaStates field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ReplicaStates field,
+// overwriting an existing map entries in ReplicaStates field with the same key.
+func (b *ServeDeploymentStatusApplyConfiguration) WithReplicaStates(entries map[string]int32) *ServeDeploymentStatusApplyConfiguration {
+	if b.ReplicaStates == nil && len(entries) > 0 {
+		b.ReplicaStates = make(map[string]int32, len(entries))
+	}
+	for k, v := range entries {
+		b.ReplicaStates[k] = v
+	}
+	return b
+}
+
+// WithTargetReplicas sets the TargetReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TargetReplicas field is set to the value of the last call.
+func (b *ServeDeploymentStatusApplyConfiguration) WithTargetReplicas(value int32) *ServeDeploymentStatusApplyConfiguration {
+	b.TargetReplicas = &value
+	return b
+}
+
+// WithRunningReplicas sets the RunningReplicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RunningReplicas field is set to the value of the last call.
+func (b *ServeDeploymentStatusApplyConfiguration) WithRunningReplicas(value int32) *ServeDeploymentStatusApplyConfiguration {
+	b.RunningReplicas = &value
+	return b
+}
+
 // WithStatus sets the Status field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Status field is set to the value of the last call.
@@ -17,18 +17,62 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &rayv1.AppStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("AutoscalerOptions"):
 		return &rayv1.AutoscalerOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ConfigMapLogRetention"):
+		return &rayv1.ConfigMapLogRetentionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("DeletionPolicy"):
+		return &rayv1.DeletionPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeadGroupSpec"):
 		return &rayv1.HeadGroupSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("HeadInfo"):
 		return &rayv1.HeadInfoApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("IncrementalUpgradeOptions"):
+		return &rayv1.IncrementalUpgradeOptionsApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("LogRetention"):
+		return &rayv1.LogRetentionApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("PersistentVolumeClaimLogRetention"):
+		return &rayv1.PersistentVolumeClaimLogRetentionApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayCluster"):
 		return &rayv1.RayClusterApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayClusterPool"):
+		return &rayv1.RayClusterPoolApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayClusterPoolSpec"):
+		return &rayv1.RayClusterPoolSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayClusterPoolStatus"):
+		return &rayv1.RayClusterPoolStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayClusterSpec"):
 		return &rayv1.RayClusterSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayClusterStatus"):
 		return &rayv1.RayClusterStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayClusterUpgradeStrategy"):
+		return &rayv1.RayClusterUpgradeStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayCronJob"):
+		return &rayv1.RayCronJobApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayCronJobSpec"):
+		return &rayv1.RayCronJobSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayCronJobStatus"):
+		return &rayv1.RayCronJobStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayJob"):
 		return &rayv1.RayJobApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobAttempt"):
+		return &rayv1.RayJobAttemptApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobDependency"):
+		return &rayv1.RayJobDependencyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobLogLocation"):
+		return &rayv1.RayJobLogLocationApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSet"):
+		return &rayv1.RayJobSetApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetFailurePolicy"):
+		return &rayv1.RayJobSetFailurePolicyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetJobStatus"):
+		return &rayv1.RayJobSetJobStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetParameters"):
+		return &rayv1.RayJobSetParametersApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetSpec"):
+		return &rayv1.RayJobSetSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetStatus"):
+		return &rayv1.RayJobSetStatusApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RayJobSetSuccessPolicy"):
+		return &rayv1.RayJobSetSuccessPolicyApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayJobSpec"):
 		return &rayv1.RayJobSpecApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayJobStatus"):
@@ -41,14 +85,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &rayv1.RayServiceStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("RayServiceStatuses"):
 		return &rayv1.RayServiceStatusesApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("RollingUpdateConfig"):
+		return &rayv1.RollingUpdateConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ScaleStrategy"):
 		return &rayv1.ScaleStrategyApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("ServeDeploymentAutoscalingStatus"):
+		return &rayv1.ServeDeploymentAutoscalingStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("ServeDeploymentStatus"):
 		return &rayv1.ServeDeploymentStatusApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("SubmitterConfig"):
 		return &rayv1.SubmitterConfigApplyConfiguration{}
 	case v1.SchemeGroupVersion.WithKind("WorkerGroupSpec"):
 		return &rayv1.WorkerGroupSpecApplyConfiguration{}
+	case v1.SchemeGroupVersion.WithKind("WorkerGroupStatus"):
+		return &rayv1.WorkerGroupStatusApplyConfiguration{}
 
 	}
 	return nil
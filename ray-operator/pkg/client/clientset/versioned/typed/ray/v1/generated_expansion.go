@@ -4,6 +4,12 @@ package v1
 
 type RayClusterExpansion interface{}
 
+type RayClusterPoolExpansion interface{}
+
+type RayCronJobExpansion interface{}
+
 type RayJobExpansion interface{}
 
+type RayJobSetExpansion interface{}
+
 type RayServiceExpansion interface{}
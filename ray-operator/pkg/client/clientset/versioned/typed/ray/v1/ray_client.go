@@ -13,7 +13,10 @@ import (
 type RayV1Interface interface {
 	RESTClient() rest.Interface
 	RayClustersGetter
+	RayClusterPoolsGetter
+	RayCronJobsGetter
 	RayJobsGetter
+	RayJobSetsGetter
 	RayServicesGetter
 }
 
@@ -26,10 +29,22 @@ func (c *RayV1Client) RayClusters(namespace string) RayClusterInterface {
 	return newRayClusters(c, namespace)
 }
 
+func (c *RayV1Client) RayClusterPools(namespace string) RayClusterPoolInterface {
+	return newRayClusterPools(c, namespace)
+}
+
+func (c *RayV1Client) RayCronJobs(namespace string) RayCronJobInterface {
+	return newRayCronJobs(c, namespace)
+}
+
 func (c *RayV1Client) RayJobs(namespace string) RayJobInterface {
 	return newRayJobs(c, namespace)
 }
 
+func (c *RayV1Client) RayJobSets(namespace string) RayJobSetInterface {
+	return newRayJobSets(c, namespace)
+}
+
 func (c *RayV1Client) RayServices(namespace string) RayServiceInterface {
 	return newRayServices(c, namespace)
 }
@@ -0,0 +1,240 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	json "encoding/json"
+	"fmt"
+	"time"
+
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	rayv1 "github.com/ray-project/kuberay/ray-operator/pkg/client/applyconfiguration/ray/v1"
+	scheme "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned/scheme"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RayClusterPoolsGetter has a method to return a RayClusterPoolInterface.
+// A group's client should implement this interface.
+type RayClusterPoolsGetter interface {
+	RayClusterPools(namespace string) RayClusterPoolInterface
+}
+
+// RayClusterPoolInterface has methods to work with RayClusterPool resources.
+type RayClusterPoolInterface interface {
+	Create(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.CreateOptions) (*v1.RayClusterPool, error)
+	Update(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.UpdateOptions) (*v1.RayClusterPool, error)
+	UpdateStatus(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.UpdateOptions) (*v1.RayClusterPool, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.RayClusterPool, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.RayClusterPoolList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayClusterPool, err error)
+	Apply(ctx context.Context, rayClusterPool *rayv1.RayClusterPoolApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayClusterPool, err error)
+	ApplyStatus(ctx context.Context, rayClusterPool *rayv1.RayClusterPoolApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayClusterPool, err error)
+	RayClusterPoolExpansion
+}
+
+// rayClusterPools implements RayClusterPoolInterface
+type rayClusterPools struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRayClusterPools returns a RayClusterPools
+func newRayClusterPools(c *RayV1Client, namespace string) *rayClusterPools {
+	return &rayClusterPools{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the rayClusterPool, and returns the corresponding rayClusterPool object, and an error if there is any.
+func (c *rayClusterPools) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.RayClusterPool, err error) {
+	result = &v1.RayClusterPool{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RayClusterPools that match those selectors.
+func (c *rayClusterPools) List(ctx context.Context, opts metav1.ListOptions) (result *v1.RayClusterPoolList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.RayClusterPoolList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested rayClusterPools.
+func (c *rayClusterPools) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a rayClusterPool and creates it.  Returns the server's representation of the rayClusterPool, and an error, if there is any.
+func (c *rayClusterPools) Create(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.CreateOptions) (result *v1.RayClusterPool, err error) {
+	result = &v1.RayClusterPool{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayClusterPool).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a rayClusterPool and updates it. Returns the server's representation of the rayClusterPool, and an error, if there is any.
+func (c *rayClusterPools) Update(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.UpdateOptions) (result *v1.RayClusterPool, err error) {
+	result = &v1.RayClusterPool{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		Name(rayClusterPool.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayClusterPool).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *rayClusterPools) UpdateStatus(ctx context.Context, rayClusterPool *v1.RayClusterPool, opts metav1.UpdateOptions) (result *v1.RayClusterPool, err error) {
+	result = &v1.RayClusterPool{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		Name(rayClusterPool.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rayClusterPool).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the rayClusterPool and deletes it. Returns an error if one occurs.
+func (c *rayClusterPools) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *rayClusterPools) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched rayClusterPool.
+func (c *rayClusterPools) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.RayClusterPool, err error) {
+	result = &v1.RayClusterPool{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied rayClusterPool.
+func (c *rayClusterPools) Apply(ctx context.Context, rayClusterPool *rayv1.RayClusterPoolApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayClusterPool, err error) {
+	if rayClusterPool == nil {
+		return nil, fmt.Errorf("rayClusterPool provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(rayClusterPool)
+	if err != nil {
+		return nil, err
+	}
+	name := rayClusterPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayClusterPool.Name must be provided to Apply")
+	}
+	result = &v1.RayClusterPool{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		Name(*name).
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *rayClusterPools) ApplyStatus(ctx context.Context, rayClusterPool *rayv1.RayClusterPoolApplyConfiguration, opts metav1.ApplyOptions) (result *v1.RayClusterPool, err error) {
+	if rayClusterPool == nil {
+		return nil, fmt.Errorf("rayClusterPool provided to Apply must not be nil")
+	}
+	patchOpts := opts.ToPatchOptions()
+	data, err := json.Marshal(rayClusterPool)
+	if err != nil {
+		return nil, err
+	}
+
+	name := rayClusterPool.Name
+	if name == nil {
+		return nil, fmt.Errorf("rayClusterPool.Name must be provided to Apply")
+	}
+
+	result = &v1.RayClusterPool{}
+	err = c.client.Patch(types.ApplyPatchType).
+		Namespace(c.ns).
+		Resource("rayclusterpools").
+		Name(*name).
+		SubResource("status").
+		VersionedParams(&patchOpts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -39,8 +39,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=ray.io, Version=v1
 	case v1.SchemeGroupVersion.WithResource("rayclusters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ray().V1().RayClusters().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("rayclusterpools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ray().V1().RayClusterPools().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("raycronjobs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ray().V1().RayCronJobs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("rayjobs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ray().V1().RayJobs().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("rayjobsets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ray().V1().RayJobSets().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("rayservices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ray().V1().RayServices().Informer()}, nil
 
@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	rayv1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	versioned "github.com/ray-project/kuberay/ray-operator/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/ray-project/kuberay/ray-operator/pkg/client/informers/externalversions/internalinterfaces"
+	v1 "github.com/ray-project/kuberay/ray-operator/pkg/client/listers/ray/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RayCronJobInformer provides access to a shared informer and lister for
+// RayCronJobs.
+type RayCronJobInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.RayCronJobLister
+}
+
+type rayCronJobInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewRayCronJobInformer constructs a new informer for RayCronJob type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewRayCronJobInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredRayCronJobInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredRayCronJobInformer constructs a new informer for RayCronJob type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredRayCronJobInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayCronJobs(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.RayV1().RayCronJobs(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&rayv1.RayCronJob{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *rayCronJobInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredRayCronJobInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *rayCronJobInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&rayv1.RayCronJob{}, f.defaultInformer)
+}
+
+func (f *rayCronJobInformer) Lister() v1.RayCronJobLister {
+	return v1.NewRayCronJobLister(f.Informer().GetIndexer())
+}
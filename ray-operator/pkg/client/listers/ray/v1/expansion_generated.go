@@ -10,6 +10,22 @@ type RayClusterListerExpansion interface{}
 // RayClusterNamespaceLister.
 type RayClusterNamespaceListerExpansion interface{}
 
+// RayClusterPoolListerExpansion allows custom methods to be added to
+// RayClusterPoolLister.
+type RayClusterPoolListerExpansion interface{}
+
+// RayClusterPoolNamespaceListerExpansion allows custom methods to be added to
+// RayClusterPoolNamespaceLister.
+type RayClusterPoolNamespaceListerExpansion interface{}
+
+// RayCronJobListerExpansion allows custom methods to be added to
+// RayCronJobLister.
+type RayCronJobListerExpansion interface{}
+
+// RayCronJobNamespaceListerExpansion allows custom methods to be added to
+// RayCronJobNamespaceLister.
+type RayCronJobNamespaceListerExpansion interface{}
+
 // RayJobListerExpansion allows custom methods to be added to
 // RayJobLister.
 type RayJobListerExpansion interface{}
@@ -18,6 +34,14 @@ type RayJobListerExpansion interface{}
 // RayJobNamespaceLister.
 type RayJobNamespaceListerExpansion interface{}
 
+// RayJobSetListerExpansion allows custom methods to be added to
+// RayJobSetLister.
+type RayJobSetListerExpansion interface{}
+
+// RayJobSetNamespaceListerExpansion allows custom methods to be added to
+// RayJobSetNamespaceLister.
+type RayJobSetNamespaceListerExpansion interface{}
+
 // RayServiceListerExpansion allows custom methods to be added to
 // RayServiceLister.
 type RayServiceListerExpansion interface{}
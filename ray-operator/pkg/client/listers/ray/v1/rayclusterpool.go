@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RayClusterPoolLister helps list RayClusterPools.
+// All objects returned here must be treated as read-only.
+type RayClusterPoolLister interface {
+	// List lists all RayClusterPools in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayClusterPool, err error)
+	// RayClusterPools returns an object that can list and get RayClusterPools.
+	RayClusterPools(namespace string) RayClusterPoolNamespaceLister
+	RayClusterPoolListerExpansion
+}
+
+// rayClusterPoolLister implements the RayClusterPoolLister interface.
+type rayClusterPoolLister struct {
+	indexer cache.Indexer
+}
+
+// NewRayClusterPoolLister returns a new RayClusterPoolLister.
+func NewRayClusterPoolLister(indexer cache.Indexer) RayClusterPoolLister {
+	return &rayClusterPoolLister{indexer: indexer}
+}
+
+// List lists all RayClusterPools in the indexer.
+func (s *rayClusterPoolLister) List(selector labels.Selector) (ret []*v1.RayClusterPool, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayClusterPool))
+	})
+	return ret, err
+}
+
+// RayClusterPools returns an object that can list and get RayClusterPools.
+func (s *rayClusterPoolLister) RayClusterPools(namespace string) RayClusterPoolNamespaceLister {
+	return rayClusterPoolNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RayClusterPoolNamespaceLister helps list and get RayClusterPools.
+// All objects returned here must be treated as read-only.
+type RayClusterPoolNamespaceLister interface {
+	// List lists all RayClusterPools in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayClusterPool, err error)
+	// Get retrieves the RayClusterPool from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.RayClusterPool, error)
+	RayClusterPoolNamespaceListerExpansion
+}
+
+// rayClusterPoolNamespaceLister implements the RayClusterPoolNamespaceLister
+// interface.
+type rayClusterPoolNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RayClusterPools in the indexer for a given namespace.
+func (s rayClusterPoolNamespaceLister) List(selector labels.Selector) (ret []*v1.RayClusterPool, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayClusterPool))
+	})
+	return ret, err
+}
+
+// Get retrieves the RayClusterPool from the indexer for a given namespace and name.
+func (s rayClusterPoolNamespaceLister) Get(name string) (*v1.RayClusterPool, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("rayclusterpool"), name)
+	}
+	return obj.(*v1.RayClusterPool), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RayCronJobLister helps list RayCronJobs.
+// All objects returned here must be treated as read-only.
+type RayCronJobLister interface {
+	// List lists all RayCronJobs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayCronJob, err error)
+	// RayCronJobs returns an object that can list and get RayCronJobs.
+	RayCronJobs(namespace string) RayCronJobNamespaceLister
+	RayCronJobListerExpansion
+}
+
+// rayCronJobLister implements the RayCronJobLister interface.
+type rayCronJobLister struct {
+	indexer cache.Indexer
+}
+
+// NewRayCronJobLister returns a new RayCronJobLister.
+func NewRayCronJobLister(indexer cache.Indexer) RayCronJobLister {
+	return &rayCronJobLister{indexer: indexer}
+}
+
+// List lists all RayCronJobs in the indexer.
+func (s *rayCronJobLister) List(selector labels.Selector) (ret []*v1.RayCronJob, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayCronJob))
+	})
+	return ret, err
+}
+
+// RayCronJobs returns an object that can list and get RayCronJobs.
+func (s *rayCronJobLister) RayCronJobs(namespace string) RayCronJobNamespaceLister {
+	return rayCronJobNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RayCronJobNamespaceLister helps list and get RayCronJobs.
+// All objects returned here must be treated as read-only.
+type RayCronJobNamespaceLister interface {
+	// List lists all RayCronJobs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayCronJob, err error)
+	// Get retrieves the RayCronJob from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.RayCronJob, error)
+	RayCronJobNamespaceListerExpansion
+}
+
+// rayCronJobNamespaceLister implements the RayCronJobNamespaceLister
+// interface.
+type rayCronJobNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RayCronJobs in the indexer for a given namespace.
+func (s rayCronJobNamespaceLister) List(selector labels.Selector) (ret []*v1.RayCronJob, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayCronJob))
+	})
+	return ret, err
+}
+
+// Get retrieves the RayCronJob from the indexer for a given namespace and name.
+func (s rayCronJobNamespaceLister) Get(name string) (*v1.RayCronJob, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("raycronjob"), name)
+	}
+	return obj.(*v1.RayCronJob), nil
+}
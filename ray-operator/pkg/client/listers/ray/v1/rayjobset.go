@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/ray-project/kuberay/ray-operator/apis/ray/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RayJobSetLister helps list RayJobSets.
+// All objects returned here must be treated as read-only.
+type RayJobSetLister interface {
+	// List lists all RayJobSets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayJobSet, err error)
+	// RayJobSets returns an object that can list and get RayJobSets.
+	RayJobSets(namespace string) RayJobSetNamespaceLister
+	RayJobSetListerExpansion
+}
+
+// rayJobSetLister implements the RayJobSetLister interface.
+type rayJobSetLister struct {
+	indexer cache.Indexer
+}
+
+// NewRayJobSetLister returns a new RayJobSetLister.
+func NewRayJobSetLister(indexer cache.Indexer) RayJobSetLister {
+	return &rayJobSetLister{indexer: indexer}
+}
+
+// List lists all RayJobSets in the indexer.
+func (s *rayJobSetLister) List(selector labels.Selector) (ret []*v1.RayJobSet, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayJobSet))
+	})
+	return ret, err
+}
+
+// RayJobSets returns an object that can list and get RayJobSets.
+func (s *rayJobSetLister) RayJobSets(namespace string) RayJobSetNamespaceLister {
+	return rayJobSetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RayJobSetNamespaceLister helps list and get RayJobSets.
+// All objects returned here must be treated as read-only.
+type RayJobSetNamespaceLister interface {
+	// List lists all RayJobSets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.RayJobSet, err error)
+	// Get retrieves the RayJobSet from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.RayJobSet, error)
+	RayJobSetNamespaceListerExpansion
+}
+
+// rayJobSetNamespaceLister implements the RayJobSetNamespaceLister
+// interface.
+type rayJobSetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RayJobSets in the indexer for a given namespace.
+func (s rayJobSetNamespaceLister) List(selector labels.Selector) (ret []*v1.RayJobSet, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.RayJobSet))
+	})
+	return ret, err
+}
+
+// Get retrieves the RayJobSet from the indexer for a given namespace and name.
+func (s rayJobSetNamespaceLister) Get(name string) (*v1.RayJobSet, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("rayjobset"), name)
+	}
+	return obj.(*v1.RayJobSet), nil
+}
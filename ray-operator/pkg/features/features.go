@@ -17,6 +17,26 @@ const (
 	//
 	// Enables new conditions in RayCluster status
 	RayClusterStatusConditions featuregate.Feature = "RayClusterStatusConditions"
+
+	// alpha: v1.2
+	//
+	// Enables the RayCronJob controller to create RayJobs on a cron schedule
+	RayCronJob featuregate.Feature = "RayCronJob"
+
+	// alpha: v1.2
+	//
+	// Enables the RayJobSet controller to run a RayJob for each set of parameters
+	RayJobSet featuregate.Feature = "RayJobSet"
+
+	// alpha: v1.2
+	//
+	// Enables the RayClusterPool controller to keep idle RayClusters that RayJobs can claim
+	RayClusterPool featuregate.Feature = "RayClusterPool"
+
+	// alpha: v1.2
+	//
+	// Enables conditions in RayJob and RayService status
+	RayJobAndRayServiceStatusConditions featuregate.Feature = "RayJobAndRayServiceStatusConditions"
 )
 
 func init() {
@@ -24,7 +44,11 @@ func init() {
 }
 
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-	RayClusterStatusConditions: {Default: false, PreRelease: featuregate.Alpha},
+	RayClusterStatusConditions:          {Default: false, PreRelease: featuregate.Alpha},
+	RayCronJob:                          {Default: false, PreRelease: featuregate.Alpha},
+	RayJobSet:                           {Default: false, PreRelease: featuregate.Alpha},
+	RayClusterPool:                      {Default: false, PreRelease: featuregate.Alpha},
+	RayJobAndRayServiceStatusConditions: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // SetFeatureGateDuringTest is a helper method to override feature gates in tests.